@@ -0,0 +1,108 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// reCallout описывает маркер выделенного блока в начале цитаты: > [!NOTE].
+var reCallout = regexp.MustCompile(`^\s*\[!(\w+)\][ \t]*\n?`)
+
+// admonitions заменяет цитаты, начинающиеся с маркера [!NOTE], [!TIP],
+// [!WARNING] и т.д., на выделенные блоки. Текст после маркера в той же строке
+// используется как заголовок блока. Возвращает true, если хотя бы одна цитата
+// была заменена.
+func (pub *EPUBCompiler) admonitions(parent *html.Node) bool {
+	var result bool
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		if node.Data != "blockquote" {
+			if pub.admonitions(node) {
+				result = true
+			}
+			continue
+		}
+		var para = firstElement(node, "p")
+		if para == nil || para.FirstChild == nil || para.FirstChild.Type != html.TextNode {
+			continue
+		}
+		var text = para.FirstChild
+		var m = reCallout.FindStringSubmatch(text.Data)
+		if m == nil {
+			continue
+		}
+		var name = strings.ToLower(m[1])
+		if container, ok := pub.config.Containers[name]; !ok || container.Heading == "" {
+			continue
+		}
+		text.Data = text.Data[len(m[0]):]
+		// Заголовок указан в той же строке, что и маркер
+		var title string
+		if !strings.HasSuffix(m[0], "\n") {
+			if i := strings.IndexByte(text.Data, '\n'); i >= 0 {
+				title, text.Data = strings.TrimSpace(text.Data[:i]), text.Data[i+1:]
+			} else if text.NextSibling == nil {
+				title, text.Data = strings.TrimSpace(text.Data), ""
+			}
+		}
+		// Удаляем опустевший после маркера абзац
+		if strings.TrimSpace(text.Data) == "" {
+			para.RemoveChild(text)
+			if para.FirstChild == nil || para.FirstChild.Type == html.ElementNode &&
+				para.FirstChild.Data == "br" && para.FirstChild == para.LastChild {
+				node.RemoveChild(para)
+			}
+		}
+		var aside = pub.container(name, title, children(node))
+		parent.InsertBefore(aside, node)
+		parent.RemoveChild(node)
+		node = aside
+		result = true
+	}
+	return result
+}
+
+// hasAdmonitions возвращает true, если среди директив есть выделенные блоки.
+func (pub *EPUBCompiler) hasAdmonitions(dirs directives) bool {
+	for _, dir := range dirs {
+		if container, ok := pub.config.Containers[dir.Name]; ok && container.Heading != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// admonitionsCSSFile задает имя файла со стилями выделенных блоков.
+const admonitionsCSSFile = "_admonitions.css"
+
+// admonitionsCSS содержит стили выделенных блоков.
+const admonitionsCSS = `aside.admonition {
+  margin: 1em 0;
+  padding: 0.5em 1em;
+  border-left: 0.3em solid #999;
+  background: #f6f6f6;
+}
+aside.admonition > p.admonition-title {
+  margin: 0 0 0.3em;
+  font-weight: bold;
+  text-indent: 0;
+}
+aside.note { border-color: #0969da; }
+aside.note > p.admonition-title { color: #0969da; }
+aside.tip { border-color: #1a7f37; }
+aside.tip > p.admonition-title { color: #1a7f37; }
+aside.important { border-color: #8250df; }
+aside.important > p.admonition-title { color: #8250df; }
+aside.warning { border-color: #9a6700; }
+aside.warning > p.admonition-title { color: #9a6700; }
+aside.caution, aside.danger { border-color: #cf222e; }
+aside.caution > p.admonition-title, aside.danger > p.admonition-title { color: #cf222e; }
+@media (monochrome) {
+  aside.admonition { background: none; border: 1px solid #000; border-left-width: 0.3em; }
+  aside.admonition > p.admonition-title { color: #000; }
+}
+`
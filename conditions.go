@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Названия блоков-контейнеров с условным содержимым:
+//
+//	::: only kindle kobo
+//	::: except print
+const (
+	conditionOnly   = "only"
+	conditionExcept = "except"
+)
+
+// active возвращает true, если одно из перечисленных имен совпадает с
+// названием целевой сборки или одним из установленных флагов. Имена
+// разделяются пробелами или запятыми.
+func (config *Config) active(names ...string) bool {
+	for _, list := range names {
+		for _, name := range strings.FieldsFunc(list, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		}) {
+			if name == config.Target {
+				return true
+			}
+			for _, flag := range config.Flags {
+				if name == flag {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// included возвращает true, если файл с указанными метаданными должен войти
+// в сборку с учетом ключей only и except.
+func (config *Config) included(only, except []string) bool {
+	if len(only) > 0 && !config.active(only...) {
+		return false
+	}
+	return !config.active(except...)
+}
+
+// conditions удаляет из исходного текста условные блоки, не относящиеся к
+// текущей сборке, и строки, открывающие и закрывающие оставшиеся. Остальные
+// блоки-контейнеры отслеживаются только для того, чтобы правильно определить
+// конец условного блока.
+func (config *Config) conditions(data []byte) []byte {
+	var (
+		buf   = new(bytes.Buffer)
+		stack []bool // Открытые контейнеры: true для условных блоков
+		skip  = 0    // Количество открытых исключенных блоков
+		skips []bool // Исключен ли соответствующий условный блок
+	)
+	scanLines(data, func(line []byte, code bool) {
+		if m := reContainer.FindSubmatch(line); !code && m != nil {
+			switch name := string(m[1]); {
+			case name == conditionOnly || name == conditionExcept:
+				var excluded = config.active(string(m[2])) == (name == conditionExcept)
+				if excluded {
+					skip++
+				}
+				stack = append(stack, true)
+				skips = append(skips, excluded)
+				return
+			case name != "":
+				stack = append(stack, false)
+			case len(stack) > 0:
+				var conditional = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if conditional {
+					if skips[len(skips)-1] {
+						skip--
+					}
+					skips = skips[:len(skips)-1]
+					return
+				}
+			}
+		}
+		if skip == 0 {
+			buf.Write(line)
+		}
+	})
+	return buf.Bytes()
+}
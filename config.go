@@ -1,21 +1,149 @@
 package main
 
+import (
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
 // Config описывает конфигурацию для публикации.
 type Config struct {
-	Lang     string   // Язык публикации по умолчанию
-	Title    string   // Название публикации по умолчанию
-	Metadata []string // Список имен файлов с метаинформацией
-	Markdown []string // Список расширений файлов в формате Markdown
-	Covers   []string // Список имен файлов с обложкой
-	CSSFile  string   // Имя файла со стилем
+	Lang         string                 `yaml:"lang"`         // Язык публикации по умолчанию
+	Title        string                 `yaml:"title"`        // Название публикации по умолчанию
+	Settings     []string               `yaml:"-"`            // Список имен файлов с настройками проекта
+	Metadata     []string               `yaml:"metadata"`     // Список имен файлов с метаинформацией
+	Markdown     []string               `yaml:"markdown"`     // Список расширений файлов в формате Markdown
+	Covers       []string               `yaml:"covers"`       // Список имен файлов с обложкой
+	CSS          Stylesheets            `yaml:"css"`          // Стилевые файлы публикации
+	Containers   map[string]*Container  `yaml:"containers"`   // Описание блоков-контейнеров
+	Figures      string                 `yaml:"figures"`      // Нумерация иллюстраций: chapter или book
+	Math         bool                   `yaml:"math"`         // Преобразование формул TeX в MathML
+	Highlight    bool                   `yaml:"highlight"`    // Подсветка синтаксиса в блоках кода
+	CodeTheme    string                 `yaml:"codetheme"`    // Тема подсветки: light, contrast или auto
+	LineNumbers  bool                   `yaml:"linenumbers"`  // Нумерация строк в блоках кода
+	Typography   map[string]*Typography `yaml:"typography"`   // Переопределение правил типографики по языкам
+	Hyphenate    bool                   `yaml:"hyphenate"`    // Расстановка мягких переносов
+	Hyphenation  map[string][]string    `yaml:"hyphenation"`  // Файлы с шаблонами и исключениями переносов по языкам
+	NoHyphenate  []string               `yaml:"nohyphenate"`  // Селекторы элементов без переносов
+	Dialogue     bool                   `yaml:"dialogue"`     // Нормализация реплик диалогов
+	CriticMarkup string                 `yaml:"criticmarkup"` // Обработка правок CriticMarkup: accept, reject или show
+	Draft        bool                   `yaml:"draft"`        // Черновая сборка
+	Variables    bool                   `yaml:"variables"`    // Подстановка переменных из метаданных в текст
+	Target       string                 `yaml:"target"`       // Название целевой сборки: kindle, kobo, print
+	Flags        []string               `yaml:"flags"`        // Дополнительные флаги для условных блоков
+	Shortcodes   string                 `yaml:"shortcodes"`   // Каталог с шаблонами шорткодов
+	Templates    string                 `yaml:"templates"`    // Каталог с шаблонами, заменяющими встроенные
+	TitlePage    bool                   `yaml:"titlepage"`    // Создавать титульный лист из метаданных
+	Copyright    string                 `yaml:"copyright"`    // Страница с выходными данными: front или back
+	CoverLinear  bool                   `yaml:"coverlinear"`  // Включать страницу обложки в основной порядок чтения
+	CoverLayout  string                 `yaml:"coverlayout"`  // Оформление создаваемой обложки: classic, modern, minimal или none
+	Theme        string                 `yaml:"theme"`        // Встроенная тема оформления: classic, technical, poetry, eink или none
+	Numbered     bool                   `yaml:"numbered"`     // Нумеровать главы
+	Numbering    map[string]*Numbering  `yaml:"numbering"`    // Нумерация глав по типам
+	Layouts      map[string]string      `yaml:"layouts"`      // Шаблоны страниц по типам глав
+	Explicit     map[string]bool        `yaml:"-"`            // Параметры, явно заданные в командной строке
+}
+
+// Container описывает блок-контейнер, задаваемый в тексте Markdown в виде
+// `::: name` ... `:::`.
+type Container struct {
+	Tag         string `yaml:"tag"`         // Элемент HTML: section, aside, blockquote
+	Type        string `yaml:"type"`        // Значение атрибута epub:type
+	Class       string `yaml:"class"`       // Класс CSS
+	Attribution bool   `yaml:"attribution"` // Оформлять подпись в конце блока
+	Heading     string `yaml:"heading"`     // Заголовок блока или ключ его перевода
+	Verse       bool   `yaml:"verse"`       // Сохранять строки и строфы стихов
 }
 
 // DefaultConfig описывает используемую по умолчанию конфигурацию.
 var DefaultConfig = &Config{
-	Lang:     "en",
-	Title:    "Untitle",
-	Metadata: []string{"metadata.yaml", "metadata.yml", "metadata.json"},
-	Markdown: []string{".md", ".mdown", ",markdown"},
-	Covers:   []string{"cover.png", "cover.svg", "cover.jpeg", "cover.jpg", "cover.gif"},
-	CSSFile:  "style.css",
+	Lang:       "en",
+	Title:      "Untitle",
+	Settings:   []string{"md2epub.yaml", "md2epub.yml"},
+	Metadata:   []string{"metadata.yaml", "metadata.yml", "metadata.json"},
+	Markdown:   []string{".md", ".mdown", ",markdown"},
+	Covers:     []string{"cover.png", "cover.svg", "cover.jpeg", "cover.jpg", "cover.gif"},
+	CSS:        Stylesheets{{File: "style.css"}},
+	Shortcodes: "shortcodes",
+	Templates:  "templates",
+	Containers: map[string]*Container{
+		"epigraph":   {Tag: "blockquote", Type: "epigraph", Class: "epigraph", Attribution: true},
+		"dedication": {Tag: "section", Type: "dedication", Class: "dedication"},
+		"sidebar":    {Tag: "aside", Type: "sidebar", Class: "sidebar"},
+		"verse":      {Tag: "section", Type: "z3998:verse", Class: "verse", Verse: true},
+		"note":       {Tag: "aside", Type: "notice", Class: "admonition note", Heading: "note"},
+		"tip":        {Tag: "aside", Type: "tip", Class: "admonition tip", Heading: "tip"},
+		"important":  {Tag: "aside", Type: "notice", Class: "admonition important", Heading: "important"},
+		"warning":    {Tag: "aside", Type: "notice", Class: "admonition warning", Heading: "warning"},
+		"caution":    {Tag: "aside", Type: "notice", Class: "admonition caution", Heading: "caution"},
+		"danger":     {Tag: "aside", Type: "notice", Class: "admonition danger", Heading: "danger"},
+	},
+	Figures:      "chapter",
+	Highlight:    true,
+	CodeTheme:    "auto",
+	CriticMarkup: CriticAccept,
+	CoverLinear:  true,
+	CoverLayout:  "classic",
+	Layouts: map[string]string{
+		"part": "part-divider",
+	},
+	Numbering: map[string]*Numbering{
+		"chapter":  {Scheme: NumberArabic, Label: "label-chapter", Reset: []string{"part"}},
+		"part":     {Scheme: NumberRoman, Label: "label-part"},
+		"appendix": {Scheme: NumberLetters, Label: "label-appendix"},
+	},
+	NoHyphenate: []string{"pre", "code", "kbd", "samp", "var", "math", "script", "style",
+		"h1", "h2", "h3", "h4", "h5", "h6", "a", ".nohyphenate"},
+}
+
+// loadConfig возвращает копию конфигурации, дополненную настройками из файла
+// проекта, если такой файл есть в текущем каталоге.
+func loadConfig(config *Config) (*Config, error) {
+	var result = *config
+	// Копируем словари, чтобы не изменить конфигурацию по умолчанию
+	result.Containers = make(map[string]*Container, len(config.Containers))
+	for name, container := range config.Containers {
+		result.Containers[name] = container
+	}
+	result.Typography = make(map[string]*Typography, len(config.Typography))
+	for lang, rules := range config.Typography {
+		result.Typography[lang] = rules
+	}
+	result.Numbering = make(map[string]*Numbering, len(config.Numbering))
+	for typ, numbering := range config.Numbering {
+		result.Numbering[typ] = numbering
+	}
+	result.Layouts = make(map[string]string, len(config.Layouts))
+	for typ, layout := range config.Layouts {
+		result.Layouts[typ] = layout
+	}
+	for _, name := range config.Settings {
+		fi, err := os.Stat(name)
+		if err != nil || fi.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		result.Flags = nil
+		if err := yaml.Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		// Цель и флаги сборки, переданные явно, дополняют настройки проекта
+		if config.Target != "" {
+			result.Target = config.Target
+		}
+		result.Flags = append(result.Flags, config.Flags...)
+		// Явно заданные параметры командной строки важнее настроек проекта
+		if config.Explicit["critic"] {
+			result.CriticMarkup = config.CriticMarkup
+		}
+		if config.Explicit["draft"] {
+			result.Draft = config.Draft
+		}
+		break
+	}
+	return &result, nil
 }
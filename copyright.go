@@ -0,0 +1,113 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+)
+
+// copyrightFile задает имя файла страницы с выходными данными.
+const copyrightFile = "_copyright.xhtml"
+
+// Расположение страницы с выходными данными в публикации.
+const (
+	CopyrightFront = "front" // После титульного листа
+	CopyrightBack  = "back"  // В конце публикации
+)
+
+// contributors описывает участников издания с одной ролью.
+type contributors struct {
+	Role  string   // Локализованное название роли
+	Names []string // Имена участников
+}
+
+// copyrightPage возвращает страницу с выходными данными издания: авторскими
+// правами, ISBN, изданием, издательством, датой и участниками с их ролями.
+// В черновой сборке на ней также указывается время сборки.
+func (pub *EPUBCompiler) copyrightPage() *page {
+	var pubmeta = pub.writer.Metadata
+	var meta = metadata.Metadata{
+		"lang":         pub.lang,
+		"title":        localize(pub.lang, "copyright-page"),
+		"booktitle":    elementValue(pubmeta.Title, "title"),
+		"authors":      elementValues(pubmeta.Creator),
+		"copyright":    elementValue(pubmeta.Rights, "copyright"),
+		"rights":       elementValue(pubmeta.Rights, "rights"),
+		"isbn":         pub.bookmeta.Get("isbn"),
+		"edition":      elementValue(pubmeta.Title, "edition"),
+		"publisher":    elementValues(pubmeta.Publisher),
+		"contributors": pub.contributors(),
+	}
+	if pubmeta.Date != nil {
+		meta["date"] = pub.formatDate(pubmeta.Date.Value)
+	}
+	pub.globalStyles(meta, copyrightFile)
+	return &page{
+		Filename:    copyrightFile,
+		Template:    "copyright",
+		ContentType: epub.Primary,
+		Meta:        meta,
+		Body:        newElement("body"),
+	}
+}
+
+// contributors возвращает участников издания, сгруппированных по ролям.
+// Участники без указания роли или с неизвестной ролью перечисляются последними.
+func (pub *EPUBCompiler) contributors() []*contributors {
+	var (
+		result []*contributors
+		others []string
+	)
+	var pubmeta = pub.writer.Metadata
+	for _, role := range contributorRoles {
+		var names []string
+		for _, elem := range pubmeta.Contributor {
+			if elem.ID != "" && refinedValue(pubmeta, "#"+elem.ID, "role") == role.Code {
+				names = append(names, elem.Value)
+			}
+		}
+		if len(names) > 0 {
+			result = append(result, &contributors{
+				Role:  localize(pub.lang, role.Key),
+				Names: names,
+			})
+		}
+	}
+	for _, elem := range pubmeta.Contributor {
+		if elem.ID == "" || !knownRole(refinedValue(pubmeta, "#"+elem.ID, "role")) {
+			others = append(others, elem.Value)
+		}
+	}
+	if len(others) > 0 {
+		result = append(result, &contributors{
+			Role:  localize(pub.lang, "contributor"),
+			Names: others,
+		})
+	}
+	return result
+}
+
+// knownRole возвращает true, если роль участника есть в списке известных ролей.
+func knownRole(code string) bool {
+	for _, role := range contributorRoles {
+		if role.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDate возвращает дату издания на языке публикации. Если в дате указан
+// только год или месяц, то она возвращается без изменений.
+func (pub *EPUBCompiler) formatDate(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) < len("2006-01-02") {
+		return value
+	}
+	t, err := toTime(value)
+	if err != nil {
+		return value
+	}
+	return formatDate(t, pub.lang)
+}
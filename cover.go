@@ -0,0 +1,271 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"hash/crc32"
+	"html"
+	"image"
+	_ "image/gif"  // Размеры обложки в формате GIF
+	_ "image/jpeg" // Размеры обложки в формате JPEG
+	_ "image/png"  // Размеры обложки в формате PNG
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+)
+
+// coverFile задает имя файла страницы с обложкой.
+const coverFile = "cover.xhtml"
+
+// Размеры создаваемой обложки. Они же используются, если размеры изображения
+// обложки не удалось определить по файлу.
+const (
+	coverWidth  = 1200
+	coverHeight = 1800
+)
+
+// coverPage возвращает страницу с изображением обложки. Изображение вписывается
+// в страницу с сохранением пропорций с помощью SVG, для чего используются его
+// настоящие размеры. Если в публикации уже есть страница с таким именем, то
+// страница не создается.
+func (pub *EPUBCompiler) coverPage() *page {
+	if pub.coverImage == "" {
+		return nil
+	}
+	for _, page := range pub.pages {
+		if page.Filename == coverFile {
+			return nil
+		}
+	}
+	var width, height = coverWidth, coverHeight
+	if pub.coverImage != generatedCoverFile {
+		var err error
+		if width, height, err = imageSize(pub.coverImage); err != nil {
+			pub.warnf("%s: image size: %v; using %dx%d", pub.coverImage, err,
+				coverWidth, coverHeight)
+			width, height = coverWidth, coverHeight
+		}
+	}
+	var meta = metadata.Metadata{
+		"lang":   pub.lang,
+		"title":  localize(pub.lang, "cover"),
+		"class":  "cover",
+		"image":  filepath.ToSlash(pub.coverImage),
+		"width":  width,
+		"height": height,
+	}
+	pub.globalStyles(meta, coverFile)
+	var ct = epub.Primary
+	if !pub.config.CoverLinear {
+		ct = epub.Auxiliary
+	}
+	pub.addLandmark("cover", coverFile)
+	return &page{
+		Filename:    coverFile,
+		Template:    "cover",
+		ContentType: ct,
+		Properties:  []string{"svg"},
+		Meta:        meta,
+		Body:        newElement("body"),
+	}
+}
+
+// reManifestHref описывает ссылку на файл в манифесте публикации.
+var reManifestHref = regexp.MustCompile(`<item\s[^>]*href="([^"]*)"`)
+
+// reRootFile описывает ссылку на файл пакета публикации в container.xml.
+var reRootFile = regexp.MustCompile(`full-path="([^"]*)"`)
+
+// addCoverGuide добавляет в раздел guide файла пакета ссылку на страницу
+// с обложкой, которую используют читалки EPUB 2. Пакет формирует библиотека
+// epub3, не позволяющая добавить такую ссылку, поэтому публикация после записи
+// копируется во временный файл с исправленным файлом пакета, который затем
+// заменяет исходный. Остальные файлы копируются без повторного сжатия. Если
+// страницы с обложкой нет, то публикация не изменяется.
+func addCoverGuide(filename, title string) error {
+	reader, err := zip.OpenReader(filename)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	var files = make(map[string]*zip.File, len(reader.File))
+	for _, file := range reader.File {
+		files[file.Name] = file
+	}
+	if files["META-INF/container.xml"] == nil {
+		return nil
+	}
+	container, err := readZipFile(files["META-INF/container.xml"])
+	if err != nil {
+		return err
+	}
+	var rootfile = reRootFile.FindSubmatch(container)
+	if rootfile == nil {
+		return nil
+	}
+	var opfName = html.UnescapeString(string(rootfile[1]))
+	if files[opfName] == nil {
+		return nil
+	}
+	data, err := readZipFile(files[opfName])
+	if err != nil {
+		return err
+	}
+	var opf = string(data)
+	var href string
+	for _, m := range reManifestHref.FindAllStringSubmatch(opf, -1) {
+		if path.Base(m[1]) == coverFile {
+			href = m[1]
+			break
+		}
+	}
+	if href == "" {
+		return nil
+	}
+	var reference = `<reference type="cover" title="` + html.EscapeString(title) +
+		`" href="` + href + `"/>`
+	switch {
+	case strings.Contains(opf, `type="cover"`):
+		return nil // Ссылка на обложку уже есть
+	case strings.Contains(opf, "</guide>"):
+		opf = strings.Replace(opf, "</guide>", reference+"</guide>", 1)
+	case strings.Contains(opf, "<guide/>"):
+		opf = strings.Replace(opf, "<guide/>", "<guide>"+reference+"</guide>", 1)
+	default:
+		var end = strings.LastIndex(opf, "</package>")
+		if end < 0 {
+			return nil
+		}
+		opf = opf[:end] + "<guide>" + reference + "</guide>\n" + opf[end:]
+	}
+	// Исходный файл заменяется только после успешной записи временного, так
+	// что при ошибке публикация остается целой, хотя и без ссылки
+	temp, err := ioutil.TempFile(filepath.Dir(filename), ".epub")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if err = copyEPUB(temp, reader.File, opfName, []byte(opf)); err != nil {
+		temp.Close()
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+	if fi, err := os.Stat(filename); err == nil {
+		os.Chmod(temp.Name(), fi.Mode())
+	}
+	return os.Rename(temp.Name(), filename)
+}
+
+// epubMimetype задает содержимое файла mimetype публикации.
+const epubMimetype = "application/epub+zip"
+
+// copyEPUB записывает файлы публикации, заменяя содержимое файла с указанным
+// именем. Файл mimetype записывается первым, без сжатия и без дескриптора
+// данных, как того требует формат EPUB.
+func copyEPUB(w io.Writer, files []*zip.File, name string, data []byte) error {
+	var writer = zip.NewWriter(w)
+	mimetype, err := writer.CreateRaw(&zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE([]byte(epubMimetype)),
+		CompressedSize64:   uint64(len(epubMimetype)),
+		UncompressedSize64: uint64(len(epubMimetype)),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(mimetype, epubMimetype); err != nil {
+		return err
+	}
+	for _, file := range files {
+		switch file.Name {
+		case "mimetype":
+		case name:
+			w, err := writer.CreateHeader(&zip.FileHeader{
+				Name:     name,
+				Method:   zip.Deflate,
+				Modified: file.Modified,
+			})
+			if err != nil {
+				return err
+			}
+			if _, err = w.Write(data); err != nil {
+				return err
+			}
+		default:
+			if err = writer.Copy(file); err != nil {
+				return err
+			}
+		}
+	}
+	return writer.Close()
+}
+
+// readZipFile возвращает содержимое файла из архива.
+func readZipFile(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// imageSize возвращает размеры изображения в пикселях. Для SVG используются
+// атрибуты width и height корневого элемента или его viewBox.
+func imageSize(filename string) (width, height int, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+	if strings.ToLower(filepath.Ext(filename)) != ".svg" {
+		config, _, err := image.DecodeConfig(file)
+		if err != nil {
+			return 0, 0, err
+		}
+		return config.Width, config.Height, nil
+	}
+	var svg struct {
+		Width   string `xml:"width,attr"`
+		Height  string `xml:"height,attr"`
+		ViewBox string `xml:"viewBox,attr"`
+	}
+	if err = xml.NewDecoder(file).Decode(&svg); err != nil {
+		return 0, 0, err
+	}
+	width, height = svgLength(svg.Width), svgLength(svg.Height)
+	if box := strings.Fields(strings.Replace(svg.ViewBox, ",", " ", -1)); len(box) == 4 &&
+		(width == 0 || height == 0) {
+		width, height = svgLength(box[2]), svgLength(box[3])
+	}
+	if width == 0 || height == 0 {
+		return 0, 0, os.ErrInvalid
+	}
+	return width, height, nil
+}
+
+// svgLength возвращает длину в пикселях, отбрасывая единицы измерения.
+// Относительные длины в процентах не учитываются.
+func svgLength(value string) int {
+	value = strings.TrimSpace(value)
+	if strings.HasSuffix(value, "%") {
+		return 0
+	}
+	value = strings.TrimRight(value, "abcdefghijklmnopqrstuvwxyz")
+	length, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return int(length + 0.5)
+}
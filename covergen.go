@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+)
+
+// generatedCoverFile задает имя файла создаваемой обложки.
+const generatedCoverFile = "_cover.svg"
+
+// coverLayoutNone отключает создание обложки.
+const coverLayoutNone = "none"
+
+// generateCover создает обложку в формате SVG из названия, подзаголовка,
+// авторов и серии публикации. Обложка оформляется шаблоном "cover-<layout>",
+// поэтому кроме встроенных вариантов classic, modern и minimal можно добавить
+// свой шаблон в каталог шаблонов проекта. Цвета обложки вычисляются по
+// названию, так что у каждой книги они свои, но постоянные. Растровый вариант
+// обложки не создается: для вывода текста понадобились бы шрифты, которых нет
+// в стандартной библиотеке.
+func (pub *EPUBCompiler) generateCover() error {
+	var layout = pub.config.CoverLayout
+	if layout == "" || layout == coverLayoutNone {
+		return nil
+	}
+	var tmpl = pub.templates.Lookup("cover-" + layout)
+	if tmpl == nil {
+		return fmt.Errorf("unknown cover layout %q", layout)
+	}
+	var (
+		pubmeta  = pub.writer.Metadata
+		title    = elementValue(pubmeta.Title, "title")
+		sequence = refinedValue(pubmeta, "#collection", "group-position")
+		series   = elementValue(pubmeta.Title, "collection")
+	)
+	if title == "" {
+		title = pub.config.Title
+	}
+	if series != "" && sequence != "" {
+		series += " · " + sequence
+	}
+	// Крупный шрифт для коротких названий, мелкий — для длинных
+	var titleSize, titleWidth = 120, 16
+	if utf8.RuneCountInString(title) > 48 {
+		titleSize, titleWidth = 84, 24
+	}
+	var hue = titleHue(title)
+	var meta = metadata.Metadata{
+		"lang":       pub.lang,
+		"width":      coverWidth,
+		"height":     coverHeight,
+		"title":      wrapText(title, titleWidth),
+		"titleSize":  titleSize,
+		"subtitle":   wrapText(elementValue(pubmeta.Title, "subtitle"), 36),
+		"authors":    strings.Join(elementValues(pubmeta.Creator), ", "),
+		"series":     series,
+		"background": hslColor(hue, 0.45, 0.25),
+		"accent":     hslColor(hue+40, 0.55, 0.60),
+		"light":      hslColor(hue, 0.30, 0.94),
+		"dark":       hslColor(hue, 0.40, 0.15),
+	}
+	var buf = new(bytes.Buffer)
+	if err := tmpl.Execute(buf, meta); err != nil {
+		return err
+	}
+	if err := pub.writer.Add(generatedCoverFile, epub.Media, buf, "cover-image"); err != nil {
+		return err
+	}
+	pub.setCover = true
+	pub.coverImage = generatedCoverFile
+	return nil
+}
+
+// titleHue возвращает оттенок цвета в градусах, вычисленный по названию.
+func titleHue(title string) float64 {
+	var h = fnv.New32a()
+	h.Write([]byte(title))
+	return float64(h.Sum32() % 360)
+}
+
+// hslColor возвращает цвет, заданный оттенком, насыщенностью и яркостью, в
+// шестнадцатеричной записи CSS.
+func hslColor(hue, saturation, lightness float64) string {
+	hue = math.Mod(hue, 360) / 60
+	var (
+		c = (1 - math.Abs(2*lightness-1)) * saturation
+		x = c * (1 - math.Abs(math.Mod(hue, 2)-1))
+		m = lightness - c/2
+	)
+	var r, g, b float64
+	switch int(hue) {
+	case 0:
+		r, g, b = c, x, 0
+	case 1:
+		r, g, b = x, c, 0
+	case 2:
+		r, g, b = 0, c, x
+	case 3:
+		r, g, b = 0, x, c
+	case 4:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return fmt.Sprintf("#%02x%02x%02x",
+		int((r+m)*255+0.5), int((g+m)*255+0.5), int((b+m)*255+0.5))
+}
+
+// wrapText разбивает текст на строки, длина которых по возможности не
+// превышает указанного количества символов. SVG не переносит текст сам,
+// поэтому строки выводятся отдельными элементами.
+func wrapText(text string, width int) []string {
+	var (
+		lines []string
+		line  string
+	)
+	for _, word := range strings.Fields(text) {
+		if line != "" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+
+	"golang.org/x/net/html"
+)
+
+// Режимы обработки правок CriticMarkup.
+const (
+	CriticAccept = "accept" // Принять все правки
+	CriticReject = "reject" // Отклонить все правки
+	CriticShow   = "show"   // Показать правки для рецензирования
+)
+
+// reCritic описывает правки CriticMarkup: {++ ++}, {-- --}, {~~ ~> ~~}, {== ==}
+// и {>> <<}.
+var reCritic = regexp.MustCompile(`(?s)\{\+\+(.*?)\+\+\}|\{--(.*?)--\}|\{~~(.*?)~>(.*?)~~\}|\{==(.*?)==\}|\{>>(.*?)<<\}`)
+
+// criticMarkup обрабатывает правки CriticMarkup в исходном тексте в соответствии
+// с режимом из настроек. Блоки кода и строчный код не изменяются. Если сборка не
+// черновая, то о каждой правке выводится предупреждение независимо от режима:
+// в готовом издании их быть не должно.
+func (pub *EPUBCompiler) criticMarkup(filename string, data []byte) []byte {
+	var (
+		buf  = new(bytes.Buffer)
+		text []byte // Накопленный текст вне блоков кода
+		line = 1    // Номер первой строки накопленного текста
+	)
+	var flush = func() {
+		var last = 0
+		var spans = codeSpans(text)
+		for _, m := range reCritic.FindAllSubmatchIndex(text, -1) {
+			if inSpans(m[0], m[1], spans) {
+				continue // Пример разметки в строчном коде
+			}
+			buf.Write(text[last:m[0]])
+			last = m[1]
+			if !pub.config.Draft {
+				pub.warnf("%s:%d: unresolved CriticMarkup %q", filename,
+					line+bytes.Count(text[:m[0]], []byte("\n")), text[m[0]:m[1]])
+			}
+			var group = func(i int) []byte {
+				if m[i*2] < 0 {
+					return nil
+				}
+				return text[m[i*2]:m[i*2+1]]
+			}
+			buf.Write(criticChange(pub.config.CriticMarkup,
+				group(1), group(2), group(3), group(4), group(5), group(6)))
+		}
+		buf.Write(text[last:])
+		line += bytes.Count(text, []byte("\n"))
+		text = text[:0]
+	}
+	scanLines(data, func(src []byte, code bool) {
+		if code {
+			flush()
+			buf.Write(src)
+			line++
+			return
+		}
+		text = append(text, src...)
+	})
+	flush()
+	return buf.Bytes()
+}
+
+// criticChange возвращает результат обработки одной правки. Из всех
+// аргументов с текстом задан только тот, что соответствует виду правки.
+func criticChange(mode string, insert, remove, from, to, mark, comment []byte) []byte {
+	switch mode {
+	case CriticShow:
+		switch {
+		case insert != nil:
+			return criticTag("ins", insert)
+		case remove != nil:
+			return criticTag("del", remove)
+		case from != nil:
+			return append(criticTag("del", from), criticTag("ins", to)...)
+		case mark != nil:
+			return criticTag("mark", mark)
+		case comment != nil:
+			return []byte(`<span class="critic-comment">` + html.EscapeString(string(comment)) + `</span>`)
+		}
+	case CriticReject:
+		switch {
+		case remove != nil:
+			return remove
+		case from != nil:
+			return from
+		case mark != nil:
+			return mark
+		}
+	default:
+		switch {
+		case insert != nil:
+			return insert
+		case to != nil:
+			return to
+		case mark != nil:
+			return mark
+		}
+	}
+	return nil
+}
+
+// criticTag возвращает текст, обернутый в элемент HTML.
+func criticTag(tag string, text []byte) []byte {
+	return []byte("<" + tag + ">" + string(text) + "</" + tag + ">")
+}
+
+// criticComments выносит комментарии рецензентов из абзацев и заголовков
+// в отдельные блоки <aside>, следующие сразу за ними.
+func criticComments(parent *html.Node) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		switch node.Data {
+		case "p", "h1", "h2", "h3", "h4", "h5", "h6":
+			var comments []*html.Node
+			collectComments(node, &comments)
+			for i := len(comments) - 1; i >= 0; i-- {
+				var comment = comments[i]
+				comment.Parent.RemoveChild(comment)
+				comment.Data = "aside"
+				parent.InsertBefore(comment, node.NextSibling)
+			}
+		default:
+			criticComments(node)
+		}
+	}
+}
+
+// collectComments собирает все комментарии рецензентов внутри элемента.
+func collectComments(node *html.Node, comments *[]*html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode {
+			continue
+		}
+		if child.Data == "span" && getAttr(child, "class") == "critic-comment" {
+			*comments = append(*comments, child)
+			continue
+		}
+		collectComments(child, comments)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// reDialogue описывает дефис, два дефиса или тире в начале реплики.
+var reDialogue = regexp.MustCompile(`^(?:-{1,3}|–|—)[ \t\x{a0}]+`)
+
+// dialogueDash задает начало реплики: тире и неразрывный пробел.
+const dialogueDash = "—" + nbsp
+
+// dialogue заменяет в начале абзацев дефисы и тире, с которых начинаются
+// реплики, на тире с неразрывным пробелом. Без этого Markdown принял бы такие
+// абзацы за списки. Абзац, начинающийся с одного дефиса, считается репликой,
+// только если он занимает больше одной строки и в нем нет других пунктов
+// списка или вложенных в них строк: маркированные списки остаются списками.
+// Горизонтальные линии не изменяются.
+func dialogue(data []byte) []byte {
+	var (
+		buf   = new(bytes.Buffer)
+		block [][]byte // Строки текущего абзаца
+	)
+	var flush = func() {
+		if len(block) == 0 {
+			return
+		}
+		if loc := reDialogue.FindIndex(block[0]); loc != nil && !isListBlock(block) {
+			buf.WriteString(dialogueDash)
+			block[0] = block[0][loc[1]:]
+		}
+		for _, line := range block {
+			buf.Write(line)
+		}
+		block = block[:0]
+	}
+	scanLines(data, func(line []byte, code bool) {
+		if code || len(bytes.TrimSpace(line)) == 0 {
+			flush()
+			buf.Write(line)
+			return
+		}
+		block = append(block, line)
+	})
+	flush()
+	return buf.Bytes()
+}
+
+// isListBlock возвращает true, если абзац, начинающийся с дефиса, является
+// списком или горизонтальной линией: он состоит из одной строки, в нем есть
+// другие пункты или строки, вложенные в пункт.
+func isListBlock(block [][]byte) bool {
+	if reThematicBreak.Match(block[0]) {
+		return true
+	}
+	if block[0][0] != '-' || bytes.HasPrefix(block[0], []byte("--")) {
+		return false // Два дефиса и тире не начинают список
+	}
+	if len(block) == 1 {
+		return true // Пункт списка, за которым следует пустая строка
+	}
+	for _, line := range block[1:] {
+		if reListMarker.Match(line) || bytes.HasPrefix(line, []byte("  ")) {
+			return true
+		}
+	}
+	return false
+}
+
+// reThematicBreak описывает горизонтальную линию из дефисов: --- или - - -.
+var reThematicBreak = regexp.MustCompile(`^ {0,3}(?:-[ \t]*){3,}\r?\n?$`)
+
+// reRemark описывает тире между репликой и словами автора.
+var reRemark = regexp.MustCompile(`[ \t\x{a0}]+(?:-{1,3}|–|—)[ \t\x{a0}]+`)
+
+// dialogueParagraphs добавляет класс dialogue абзацам с репликами и исправляет
+// в них тире перед словами автора.
+func dialogueParagraphs(parent *html.Node) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		switch node.Data {
+		case "p":
+			var text = node.FirstChild
+			if text == nil || text.Type != html.TextNode || !strings.HasPrefix(text.Data, dialogueDash) {
+				continue
+			}
+			if class := getAttr(node, "class"); class != "" {
+				setAttr(node, "class", class+" dialogue")
+			} else {
+				setAttr(node, "class", "dialogue")
+			}
+			remarks(node)
+		case "pre", "code", "math":
+		default:
+			dialogueParagraphs(node)
+		}
+	}
+}
+
+// remarks исправляет тире перед словами автора в тексте реплики.
+func remarks(parent *html.Node) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		switch {
+		case node.Type == html.TextNode:
+			node.Data = reRemark.ReplaceAllString(node.Data, nbsp+"— ")
+		case node.Type == html.ElementNode && node.Data != "code" && node.Data != "math":
+			remarks(node)
+		}
+	}
+}
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// directive описывает конструкцию, которая вырезается из исходного текста
+// Markdown до преобразования, а после преобразования восстанавливается уже в
+// дереве HTML. В тексте директива заменяется HTML-комментарием со ссылкой на ее
+// порядковый номер, который Markdown оставляет без изменений.
+type directive struct {
+	Name  string // Название директивы
+	Args  string // Параметры
+	block bool   // Флаг блочной директивы с закрывающим комментарием
+}
+
+// directives описывает список директив одного файла.
+type directives []*directive
+
+// directivePrefix используется как префикс комментария с директивой.
+const directivePrefix = "md2epub:"
+
+// Названия внутренних директив начинаются с двоеточия, которое не может
+// встретиться в названии блока-контейнера, поэтому они не пересекаются с
+// контейнерами, заданными в тексте.
+const (
+	mathDirective        = ":math"        // Формула в строке
+	displayMathDirective = ":displaymath" // Выключная формула
+	indentDirective      = ":indent"      // Отступ строки стиха
+	shortcodeDirective   = ":shortcode"   // Шорткод с параметрами
+)
+
+// add добавляет новую директиву в список и возвращает ее номер.
+func (d *directives) add(name, args string, block bool) int {
+	*d = append(*d, &directive{Name: name, Args: args, block: block})
+	return len(*d) - 1
+}
+
+// inline возвращает комментарий со строчной директивой.
+func (d *directives) inline(id int) string {
+	return "<!--" + directivePrefix + strconv.Itoa(id) + "-->"
+}
+
+// begin возвращает комментарий, открывающий блочную директиву.
+func (d *directives) begin(id int) string {
+	return "\n<!--" + directivePrefix + strconv.Itoa(id) + "-->\n\n"
+}
+
+// end возвращает комментарий, закрывающий блочную директиву.
+func (d *directives) end(id int) string {
+	return "\n<!--" + directivePrefix + "/" + strconv.Itoa(id) + "-->\n\n"
+}
+
+// lookup возвращает директиву, на которую ссылается комментарий.
+func (d directives) lookup(node *html.Node) *directive {
+	if node.Type != html.CommentNode || !strings.HasPrefix(node.Data, directivePrefix) {
+		return nil
+	}
+	id, err := strconv.Atoi(node.Data[len(directivePrefix):])
+	if err != nil || id < 0 || id >= len(d) {
+		return nil
+	}
+	return d[id]
+}
+
+// isEnd возвращает true, если комментарий закрывает директиву с указанным
+// номером.
+func (d directives) isEnd(node *html.Node, dir *directive) bool {
+	if node.Type != html.CommentNode || !strings.HasPrefix(node.Data, directivePrefix+"/") {
+		return false
+	}
+	id, err := strconv.Atoi(node.Data[len(directivePrefix)+1:])
+	return err == nil && id >= 0 && id < len(d) && d[id] == dir
+}
+
+// reCodeFence описывает строку, открывающую или закрывающую блок кода.
+var reCodeFence = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+
+// scanLines разбивает исходный текст на строки и вызывает для каждой из них
+// функцию, сообщая, относится ли строка к блоку кода. Строки передаются вместе с
+// символом перевода строки.
+func scanLines(data []byte, fn func(line []byte, code bool)) {
+	var fence []byte // Открывающая блок кода последовательность
+	for len(data) > 0 {
+		var line = data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line = data[:i+1]
+		}
+		data = data[len(line):]
+		if m := reCodeFence.FindSubmatch(line); m != nil {
+			switch {
+			case fence == nil:
+				fence = m[1]
+				fn(line, true)
+				continue
+			case m[1][0] == fence[0] && len(m[1]) >= len(fence) &&
+				len(bytes.TrimSpace(line[len(m[0]):])) == 0:
+				fence = nil
+				fn(line, true)
+				continue
+			}
+		}
+		fn(line, fence != nil)
+	}
+}
+
+// reListMarker описывает строку, начинающую пункт списка.
+var reListMarker = regexp.MustCompile(`^[ \t]*(?:[-*+]|\d+[.)])[ \t]`)
+
+// scanCodeLines работает так же, как scanLines, но относит к блокам кода и
+// блоки, выделенные отступом в четыре пробела или табуляцией. Строки с таким
+// отступом внутри списков продолжают пункты списка и к коду не относятся.
+// Не подходит для стихов, где отступы строк сохраняются.
+func scanCodeLines(data []byte, fn func(line []byte, code bool)) {
+	var (
+		blank    = true  // Предыдущая строка пустая
+		indented = false // Строка внутри блока кода с отступом
+		inList   = false // Строка внутри списка
+	)
+	scanLines(data, func(line []byte, code bool) {
+		var empty = len(bytes.TrimSpace(line)) == 0
+		switch {
+		case code:
+			indented, inList = false, false
+		case empty:
+			// Пустые строки не прерывают блок кода с отступом
+		case bytes.HasPrefix(line, []byte("    ")) || line[0] == '\t':
+			indented = indented || blank && !inList
+		default:
+			indented = false
+			if reListMarker.Match(line) {
+				inList = true
+			} else if blank {
+				inList = false
+			}
+		}
+		blank = empty
+		fn(line, code || indented)
+	})
+}
+
+// codeSpans возвращает положение строчного кода, выделенного обратными
+// апострофами, в тексте без блоков кода.
+func codeSpans(text []byte) [][]int {
+	var spans [][]int
+	for i := 0; i < len(text); {
+		switch text[i] {
+		case '\\':
+			i += 2 // Экранированный символ
+		case '`':
+			var n = 1
+			for i+n < len(text) && text[i+n] == '`' {
+				n++
+			}
+			var end = bytes.Index(text[i+n:], text[i:i+n])
+			if end < 0 {
+				i += n
+				break
+			}
+			end += i + n*2
+			spans = append(spans, []int{i, end})
+			i = end
+		default:
+			i++
+		}
+	}
+	return spans
+}
+
+// inSpans возвращает true, если фрагмент текста пересекается с одним из
+// указанных фрагментов.
+func inSpans(start, end int, spans [][]int) bool {
+	for _, span := range spans {
+		if start < span[1] && end > span[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// reContainer описывает строку, открывающую (`::: name`) или закрывающую (`:::`)
+// блок-контейнер.
+var reContainer = regexp.MustCompile(`^:{3,}[ \t]*([\w-]*)[ \t]*(.*?)\s*$`)
+
+// containers заменяет в исходном тексте блоки-контейнеры на директивы. Строки
+// внутри контейнеров для стихов подготавливаются так, чтобы Markdown сохранил
+// разбиение на строки.
+func (d *directives) containers(data []byte, config *Config) []byte {
+	var (
+		buf     = new(bytes.Buffer)
+		stack   []int  // Номера открытых контейнеров
+		inVerse = 0    // Количество открытых контейнеров для стихов
+		lines   verses // Строки стихов
+	)
+	var isVerse = func(id int) bool {
+		var container = config.Containers[(*d)[id].Name]
+		return container != nil && container.Verse
+	}
+	var closeContainer = func() {
+		var id = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if isVerse(id) {
+			inVerse--
+		}
+		lines.flush(buf)
+		buf.WriteString(d.end(id))
+	}
+	scanLines(data, func(line []byte, code bool) {
+		if m := reContainer.FindSubmatch(line); !code && m != nil {
+			if len(m[1]) > 0 {
+				var id = d.add(string(m[1]), string(m[2]), true)
+				lines.flush(buf)
+				stack = append(stack, id)
+				if isVerse(id) {
+					inVerse++
+				}
+				buf.WriteString(d.begin(id))
+				return
+			}
+			if len(stack) > 0 {
+				closeContainer()
+				return
+			}
+		}
+		if inVerse > 0 && !code {
+			lines.add(buf, line, d)
+			return
+		}
+		lines.flush(buf)
+		buf.Write(line)
+	})
+	// Закрываем все незакрытые контейнеры
+	for len(stack) > 0 {
+		closeContainer()
+	}
+	lines.flush(buf)
+	return buf.Bytes()
+}
+
+// expand заменяет комментарии с директивами в дереве HTML на результат их
+// обработки. Содержимое блочных директив обрабатывается раньше самой директивы.
+func (pub *EPUBCompiler) expand(parent *html.Node, d directives) error {
+	for node := parent.FirstChild; node != nil; {
+		var next = node.NextSibling
+		var dir = d.lookup(node)
+		if dir == nil {
+			if node.Type == html.ElementNode {
+				if err := pub.expand(node, d); err != nil {
+					return err
+				}
+			}
+			node = next
+			continue
+		}
+		// Переносим содержимое блочной директивы во временный элемент
+		var holder = &html.Node{Type: html.ElementNode, Data: "div"}
+		if dir.block {
+			for next != nil && !d.isEnd(next, dir) {
+				var child = next
+				next = next.NextSibling
+				parent.RemoveChild(child)
+				holder.AppendChild(child)
+			}
+			if next != nil {
+				var end = next
+				next = next.NextSibling
+				parent.RemoveChild(end)
+			}
+			if err := pub.expand(holder, d); err != nil {
+				return err
+			}
+		}
+		result, err := pub.directive(dir, children(holder))
+		if err != nil {
+			return err
+		}
+		for _, child := range result {
+			if child.Parent != nil {
+				child.Parent.RemoveChild(child)
+			}
+			parent.InsertBefore(child, node)
+		}
+		parent.RemoveChild(node)
+		node = next
+	}
+	return nil
+}
+
+// directive возвращает результат обработки директивы.
+func (pub *EPUBCompiler) directive(dir *directive, content []*html.Node) ([]*html.Node, error) {
+	switch dir.Name {
+	case mathDirective:
+		return []*html.Node{mathML(dir.Args, false)}, nil
+	case displayMathDirective:
+		return []*html.Node{mathML(dir.Args, true)}, nil
+	case shortcodeDirective:
+		return pub.shortcode(dir.Args, content)
+	case indentDirective:
+		// Отступ строки стиха переносится на саму строку при оформлении стихов
+		return []*html.Node{newElement("span", "class", "indent-"+dir.Args)}, nil
+	}
+	return []*html.Node{pub.container(dir.Name, dir.Args, content)}, nil
+}
+
+// container возвращает элемент блока-контейнера с указанным содержимым.
+// Для неописанных в конфигурации контейнеров используется элемент div
+// с классом, совпадающим с названием контейнера. Если для контейнера задан
+// заголовок, то параметры директивы заменяют его.
+func (pub *EPUBCompiler) container(name, title string, content []*html.Node) *html.Node {
+	container, ok := pub.config.Containers[name]
+	if !ok {
+		container = &Container{Tag: "div", Class: name}
+	}
+	var elem = newElement(container.Tag)
+	if container.Type != "" {
+		setAttr(elem, "epub:type", container.Type)
+	}
+	if container.Class != "" {
+		setAttr(elem, "class", container.Class)
+	}
+	if container.Heading != "" {
+		if title == "" {
+			title = localize(pub.lang, container.Heading)
+		}
+		var heading = newElement("p", "class", "admonition-title")
+		heading.AppendChild(&html.Node{Type: html.TextNode, Data: title})
+		elem.AppendChild(heading)
+	}
+	for _, child := range content {
+		if child.Parent != nil {
+			child.Parent.RemoveChild(child)
+		}
+		elem.AppendChild(child)
+	}
+	if container.Attribution {
+		attribution(elem)
+	}
+	if container.Verse {
+		verse(elem)
+	}
+	return elem
+}
+
+// reAttribution описывает тире или дефисы в начале подписи.
+var reAttribution = regexp.MustCompile(`^\s*(?:—|–|-{1,3})\s*`)
+
+// attribution заменяет последний абзац блока, начинающийся с тире, на подпись
+// в виде <footer><cite>...</cite></footer>.
+func attribution(elem *html.Node) {
+	var para = elem.LastChild
+	for para != nil && para.Type == html.TextNode && strings.TrimSpace(para.Data) == "" {
+		para = para.PrevSibling
+	}
+	if para == nil || para.Type != html.ElementNode || para.Data != "p" {
+		return
+	}
+	var text = para.FirstChild
+	if text == nil || text.Type != html.TextNode {
+		return
+	}
+	var loc = reAttribution.FindStringIndex(text.Data)
+	if loc == nil {
+		return
+	}
+	text.Data = text.Data[loc[1]:]
+	var footer, cite = newElement("footer"), newElement("cite")
+	for _, child := range children(para) {
+		para.RemoveChild(child)
+		cite.AppendChild(child)
+	}
+	footer.AppendChild(cite)
+	elem.InsertBefore(footer, para)
+	elem.RemoveChild(para)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+	"golang.org/x/net/html"
+)
+
+// draftFile задает имя файла страницы с отметкой о черновике.
+const draftFile = "_draft.xhtml"
+
+// draftPage возвращает страницу с отметкой о том, что публикация является
+// черновиком, и временем сборки.
+func (pub *EPUBCompiler) draftPage() *page {
+	var meta = metadata.Metadata{
+		"lang":  pub.lang,
+		"title": localize(pub.lang, "draft"),
+	}
+	pub.globalStyles(meta, draftFile)
+	return &page{
+		Filename:    draftFile,
+		Template:    "draft",
+		ContentType: epub.Primary,
+		Meta:        meta,
+		Body:        newElement("body"),
+	}
+}
+
+// todos обрабатывает комментарии вида <!-- TODO: ... -->. В черновике они
+// заменяются видимыми отметками, а в окончательной сборке удаляются.
+func todos(parent *html.Node, draft bool) {
+	for node := parent.FirstChild; node != nil; {
+		var next = node.NextSibling
+		switch node.Type {
+		case html.ElementNode:
+			todos(node, draft)
+		case html.CommentNode:
+			var text = strings.TrimSpace(node.Data)
+			if !strings.HasPrefix(text, "TODO") {
+				break
+			}
+			if draft {
+				// Комментарий между блоками становится абзацем
+				var tag = "span"
+				if isBlockContainer(parent) {
+					tag = "p"
+				}
+				var mark = newElement(tag, "class", "todo")
+				mark.AppendChild(&html.Node{Type: html.TextNode, Data: text})
+				parent.InsertBefore(mark, node)
+			}
+			parent.RemoveChild(node)
+		}
+		node = next
+	}
+}
+
+// isBlockContainer возвращает true для элементов, содержащих блоки, а не текст.
+func isBlockContainer(node *html.Node) bool {
+	switch node.Data {
+	case "body", "section", "aside", "blockquote", "div", "article", "header", "footer":
+		return true
+	}
+	return false
+}
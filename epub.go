@@ -3,11 +3,14 @@ package main
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"html/template"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/mdigger/epub3"
 	"github.com/mdigger/metadata"
@@ -30,34 +33,103 @@ func Compile(sourcePath, outputFilename string, config *Config) error {
 		return err
 	}
 	defer os.Chdir(currentPath)
+	// Дополняем конфигурацию настройками проекта
+	if config, err = loadConfig(config); err != nil {
+		return err
+	}
 	// Загружаем и разбираем метаданные публикации
-	pubmeta, err := loadMetadata(config)
+	pubmeta, bookmeta, err := loadMetadata(config)
 	if err != nil {
 		return err
 	}
 	// Создаем упаковщик в формат EPUB
-	writer, err := epub.Create(filepath.Join(currentPath, outputFilename))
+	var filename = filepath.Join(currentPath, outputFilename)
+	writer, err := epub.Create(filename)
 	if err != nil {
 		return err
 	}
-	defer writer.Close()
+	// При ошибке закрываем публикацию, не дополняя ее
+	defer func() {
+		if writer != nil {
+			writer.Close()
+		}
+	}()
 	writer.Metadata = pubmeta
 	// Инициализируем компилятор
 	var pub = &EPUBCompiler{
 		config:    config,
 		writer:    writer,
-		templates: templates,
 		lang:      pubmeta.Language[0].Value, // Язык публикации
 		nav:       make(Navigaton, 0),
+		labels:    make(map[string]*label),
+		buildTime: time.Now(),
+		bookmeta:  bookmeta,
+	}
+	// Загружаем шаблоны с учетом переопределенных в проекте
+	if pub.templates, pub.layouts, err = loadTemplates(config, pub.funcs()); err != nil {
+		return err
+	}
+	// Ищем стилевые файлы публикации
+	pub.stylesheets = pub.existingStylesheets()
+	// Выбираем встроенную тему оформления и добавляем ее в публикацию
+	if pub.theme, err = pub.selectTheme(); err != nil {
+		return err
 	}
-	// Ищем файл со стилем
-	if _, err = os.Stat(config.CSSFile); err == nil {
-		pub.cssfile = config.CSSFile
+	if err = pub.addTheme(); err != nil {
+		return err
 	}
 	// Перебираем все файлы и подкаталоги в исходном каталоге
 	if err = filepath.Walk(".", pub.walk); err != nil {
 		return err
 	}
+	// Добавляем файлы публикации, кроме включенных в другие файлы
+	if err = pub.addSources(); err != nil {
+		return err
+	}
+	// Создаем обложку, если ее нет среди файлов публикации
+	if !pub.setCover {
+		if err = pub.generateCover(); err != nil {
+			return err
+		}
+	}
+	// Добавляем в начало публикации обложку, титульный лист, выходные данные
+	// и отметку о черновике
+	var front []*page
+	if cover := pub.coverPage(); cover != nil {
+		front = append(front, cover)
+	}
+	if config.TitlePage {
+		front = append(front, pub.titlePage())
+	}
+	if config.Copyright == CopyrightFront {
+		front = append(front, pub.copyrightPage())
+	}
+	if config.Draft {
+		front = append(front, pub.draftPage())
+	}
+	pub.pages = append(front, pub.pages...)
+	// Добавляем в конец публикации выходные данные, если это указано
+	if config.Copyright == CopyrightBack {
+		pub.pages = append(pub.pages, pub.copyrightPage())
+	}
+	// Записываем подготовленные страницы
+	if err = pub.flush(); err != nil {
+		return err
+	}
+	// Добавляем стили подсветки синтаксиса, если они используются
+	if pub.highlighted {
+		var css = strings.NewReader(highlightStylesheet(config.CodeTheme))
+		if err = writer.Add(highlightCSSFile, epub.Media, css); err != nil {
+			return err
+		}
+	}
+	// Добавляем стили выделенных блоков, если они используются
+	if pub.admonished {
+		var css = strings.NewReader(admonitionsCSS)
+		if err = writer.Add(admonitionsCSSFile, epub.Media, css); err != nil {
+			return err
+		}
+	}
 	// Генерируем оглавление, если его не добавили в виде файла
 	if !pub.setToc {
 		var buf = buffers.Get().(*bytes.Buffer)
@@ -66,37 +138,105 @@ func Compile(sourcePath, outputFilename string, config *Config) error {
 		buf.WriteString(xml.Header) // добавляем XML-заголовок
 		// Преобразуем по шаблону и записываем в публикацию.
 		var tdata = metadata.Metadata{
-			"lang":  pub.lang,
-			"title": "Оглавление",
-			"toc":   pub.nav,
-		}
-		// Добавляем ссылку на стилевой файл, если он определен
-		if pub.cssfile != "" {
-			// Здесь не нужен относительный путь, т.к. они на одном уровне
-			tdata["_globalcssfile_"] = pub.cssfile
+			"lang":       pub.lang,
+			"title":      "Оглавление",
+			"toc":        pub.nav,
+			"landmarks":  pub.landmarksFor("_toc.xhtml"),
+			"_filename_": "_toc.xhtml",
 		}
+		// Добавляем ссылки на стили темы и стилевой файл, если они определены
+		pub.globalStyles(tdata, "_toc.xhtml")
 		// Преобразуем по шаблону
 		if err = pub.templates.ExecuteTemplate(buf, "toc", tdata); err != nil {
 			return err
 		}
 		// Добавляем оглавление как скрытый (вспомогательный) файл
-		return writer.Add("_toc.xhtml", epub.Auxiliary, buf, "nav")
+		if err = writer.Add("_toc.xhtml", epub.Auxiliary, buf, "nav"); err != nil {
+			return err
+		}
 	}
-	return nil
+	// Закрываем публикацию и добавляем ссылку на обложку в guide для EPUB 2
+	err, writer = writer.Close(), nil
+	if err != nil {
+		return err
+	}
+	return addCoverGuide(filename, localize(pub.lang, "cover"))
 }
 
 // EPUBCompiler описывает комнилятор в формат epub3.
 type EPUBCompiler struct {
-	config    *Config            // Конфигурация параметров по умолчанию
-	writer    *epub.Writer       // EPUB
-	templates *template.Template // Шаблоны преобразования
-	setCover  bool               // Флаг, что обложка уже добавлена
-	setToc    bool               // Флаг, что файл с оглавлением уже добавлен
-	cssfile   string             // Имя файла со стилем
-	lang      string             // Язык публикации
-	nav       Navigaton          // Оглавление
+	config      *Config                       // Конфигурация параметров по умолчанию
+	writer      *epub.Writer                  // EPUB
+	templates   *template.Template            // Шаблоны преобразования
+	layouts     map[string]bool               // Шаблоны страниц
+	setCover    bool                          // Флаг, что обложка уже добавлена
+	setToc      bool                          // Флаг, что файл с оглавлением уже добавлен
+	stylesheets Stylesheets                   // Стилевые файлы публикации
+	lang        string                        // Язык публикации
+	nav         Navigaton                     // Оглавление
+	pages       []*page                       // Подготовленные к записи страницы
+	chapter     string                        // Номер текущей главы в номерах иллюстраций
+	chapters    int                           // Количество глав без нумерации
+	prefixes    map[string]string             // Номера последних глав по типам в номерах иллюстраций
+	figure      int                           // Номер последней иллюстрации
+	unnumbered  int                           // Номер последней иллюстрации в главах без номера
+	labels      map[string]*label             // Метки для перекрестных ссылок
+	highlighted bool                          // Флаг использования подсветки синтаксиса
+	admonished  bool                          // Флаг использования выделенных блоков
+	typography  map[string]*Typography        // Правила типографики по языкам
+	hyphenators map[string]*hyphenator        // Расстановщики переносов по языкам
+	buildTime   time.Time                     // Время сборки
+	sources     []string                      // Исходные файлы Markdown
+	media       []string                      // Остальные исходные файлы
+	shortcodes  map[string]*template.Template // Шаблоны шорткодов
+	landmarks   []*landmark                   // Ориентиры публикации
+	coverImage  string                        // Имя файла с изображением обложки
+	counters    map[string]int                // Счетчики глав по типам
+	theme       *theme                        // Встроенная тема оформления
+	bookmeta    metadata.Metadata             // Метаданные публикации из файла
 }
 
+// addSources добавляет в публикацию исходные файлы. Файлы, которые включены
+// в файлы Markdown, отдельно не добавляются: ни сами файлы Markdown, ни
+// примеры кода и другие фрагменты. Включения учитываются только в тех частях
+// текста, которые войдут в сборку.
+func (pub *EPUBCompiler) addSources() error {
+	var included = make(map[string]bool)
+	for _, filename := range pub.sources {
+		meta, data, err := metadata.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if pub.excluded(meta) {
+			continue
+		}
+		data = pub.config.conditions(data)
+		for _, target := range includeTargets(filename, data) {
+			included[target] = true
+		}
+	}
+	for _, filename := range pub.media {
+		if included[filepath.Clean(filename)] {
+			continue
+		}
+		if err := pub.addMedia(filename); err != nil {
+			return err
+		}
+	}
+	for _, filename := range pub.sources {
+		if included[filepath.Clean(filename)] {
+			continue
+		}
+		if err := pub.addMarkdown(filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// highlightCSSFile задает имя файла со стилями подсветки синтаксиса.
+const highlightCSSFile = "_highlight.css"
+
 // walk вызывается на каждый файл и каталог в исходных данных.
 func (pub *EPUBCompiler) walk(filename string, finfo os.FileInfo, err error) error {
 	// Игнорируем, если открытие файла произошло с ошибкой
@@ -108,6 +248,10 @@ func (pub *EPUBCompiler) walk(filename string, finfo os.FileInfo, err error) err
 		if filepath.Base(filename)[0] == '.' && len(filename) > 1 {
 			return filepath.SkipDir
 		}
+		// Шаблоны страниц и шорткодов не добавляются в публикацию
+		if isDirname(filename, pub.config.Templates, pub.config.Shortcodes) {
+			return filepath.SkipDir
+		}
 		// Не обрабатываем отдельно каталоги
 		return nil
 	}
@@ -115,17 +259,29 @@ func (pub *EPUBCompiler) walk(filename string, finfo os.FileInfo, err error) err
 	if ch := filepath.Base(filename)[0]; ch == '.' || ch == '~' {
 		return nil
 	}
-	// Игнорируем описание метаданных публикации, т.к. уже разобрали его
-	if isFilename(filename, pub.config.Metadata) {
+	// Игнорируем описание метаданных публикации и настройки проекта, т.к. уже
+	// разобрали их
+	if isFilename(filename, pub.config.Metadata) || isFilename(filename, pub.config.Settings) {
 		return nil
 	}
-	// Обрабатываем файлы в зависимости от расширения
-	switch ext := filepath.Ext(filename); {
-	case isFilename(ext, pub.config.Markdown):
-		return pub.addMarkdown(filename)
-	default:
-		return pub.addMedia(filename)
+	// Файлы обрабатываются после просмотра всех каталогов, когда станет
+	// известно, какие из них включены в другие файлы
+	if isFilename(filepath.Ext(filename), pub.config.Markdown) {
+		pub.sources = append(pub.sources, filename)
+	} else {
+		pub.media = append(pub.media, filename)
+	}
+	return nil
+}
+
+// excluded возвращает true, если глава не входит в сборку: черновые главы
+// добавляются только в черновую сборку, а ключи only и except ограничивают
+// сборки, в которые входит глава.
+func (pub *EPUBCompiler) excluded(meta metadata.Metadata) bool {
+	if meta.GetBool("draft") && !pub.config.Draft {
+		return true
 	}
+	return !pub.config.included(meta.GetQuickList("only"), meta.GetQuickList("except"))
 }
 
 var reMultiNewLines = regexp.MustCompile(`^\n{2,}$`)
@@ -137,6 +293,10 @@ func (pub *EPUBCompiler) addMarkdown(filename string) error {
 	if err != nil {
 		return err
 	}
+	// Пропускаем черновые главы и главы, не относящиеся к текущей сборке
+	if pub.excluded(meta) {
+		return nil
+	}
 	// Определяем язык файла
 	var lang = meta.Lang()
 	if lang == "" {
@@ -156,14 +316,52 @@ func (pub *EPUBCompiler) addMarkdown(filename string) error {
 	} else {
 		ct = epub.Primary
 	}
-	// Добавляем глобальный стилевой файл публикации
-	if pub.cssfile != "" {
-		if rel, err := filepath.Rel(filepath.Dir(filename), pub.cssfile); err == nil {
-			meta["_globalcssfile_"] = filepath.ToSlash(rel)
-		} else {
+	// Добавляем стили темы и глобальный стилевой файл публикации
+	pub.globalStyles(meta, filename)
+	// Убираем условные блоки, не относящиеся к текущей сборке
+	data = pub.config.conditions(data)
+	// Включаем содержимое других файлов
+	if data, err = pub.includes(filename, data, []string{filepath.Clean(filename)}); err != nil {
+		return err
+	}
+	// Обрабатываем правки рецензентов
+	data = pub.criticMarkup(filename, data)
+	// Заменяем шорткоды на директивы
+	var dirs directives
+	data = dirs.shortcodes(data)
+	// Подставляем значения переменных из метаданных
+	var withVariables = pub.config.Variables
+	if value, ok := meta["variables"].(bool); ok {
+		withVariables = value
+	}
+	if withVariables {
+		if data, err = pub.variables(filename, data, meta); err != nil {
 			return err
 		}
 	}
+	// Нормализуем реплики диалогов
+	var withDialogue = pub.config.Dialogue
+	if value, ok := meta["dialogue"].(bool); ok {
+		withDialogue = value
+	}
+	if withDialogue {
+		data = dialogue(data)
+	}
+	if meta.GetBool("verse") {
+		// Весь файл содержит стихи
+		data = append(append([]byte("::: verse\n"), data...), "\n:::\n"...)
+	}
+	// Заменяем блоки-контейнеры на директивы
+	data = dirs.containers(data, pub.config)
+	// Заменяем формулы на директивы, если это включено в настройках или в
+	// метаданных файла: иначе знаки доллара в тексте остаются как есть
+	var withMath = pub.config.Math
+	if value, ok := meta["math"].(bool); ok {
+		withMath = value
+	}
+	if withMath {
+		data = dirs.math(data)
+	}
 	// Преобразуем из Markdown в HTML
 	data = Markdown(data)
 	// Разбираем получившийся HTML для последующей нормализации
@@ -171,28 +369,47 @@ func (pub *EPUBCompiler) addMarkdown(filename string) error {
 	if err != nil {
 		return err
 	}
-	// Инициализируем внутренний пул для работы с информацией
-	var buf = buffers.Get().(*bytes.Buffer)
-	buf.Reset()
-	defer buffers.Put(buf)
-	// Избавляемся от пустых строк между тегами и воссоздаем нормализованный XHTML
+	// Собираем разобранные элементы в общий корневой элемент и восстанавливаем
+	// директивы
+	var body = newElement("body")
 	for _, node := range nodes {
-		if node.Type == html.TextNode && reMultiNewLines.MatchString(node.Data) {
-			buf.WriteByte('\n')
-			continue
-		}
-		// TODO: Убрать пустые строки во вложенных элементах
-		if err := html.Render(buf, node); err != nil {
-			return err
-		}
+		body.AppendChild(node)
+	}
+	if err = pub.expand(body, dirs); err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	if withDialogue {
+		dialogueParagraphs(body)
+	}
+	if pub.config.CriticMarkup == CriticShow {
+		criticComments(body)
+	}
+	todos(body, pub.config.Draft)
+	// Подсвечиваем синтаксис в блоках кода и подключаем стили подсветки
+	if pub.config.Highlight && pub.highlight(body) {
+		addStylesheet(meta, filename, Stylesheet{File: highlightCSSFile})
+		pub.highlighted = true
+	}
+	// Оформляем выделенные блоки и подключаем их стили
+	if pub.admonitions(body) || pub.hasAdmonitions(dirs) {
+		addStylesheet(meta, filename, Stylesheet{File: admonitionsCSSFile})
+		pub.admonished = true
+	}
+	// Подключаем стили, указанные для главы, последними
+	if err = pub.chapterStyles(meta, filename); err != nil {
+		return fmt.Errorf("%s: css: %v", filename, err)
 	}
-	// Сохраняем получившийся HTML в том же самом описании метаданных, чтобы не плодить сущности
-	meta["content"] = template.HTML(buf.String())
-	buf.Reset()                 // Сбрасываем буфер
-	buf.WriteString(xml.Header) // добавляем XML-заголовок
 	// Избавляемся от расширения файла
 	filename = filename[:len(filename)-len(filepath.Ext(filename))]
-	var templateName = "page" // Название шаблона для преобразования
+	// Выбираем шаблон для преобразования: указанный в метаданных, заданный
+	// в настройках для типа главы или шаблон по умолчанию
+	var templateName = meta.Get("layout")
+	if templateName == "" {
+		templateName = pub.config.Layouts[chapterType(meta)]
+	}
+	if templateName == "" {
+		templateName = "page"
+	}
 	var properties = meta.GetQuickList("properties")
 	for i, property := range properties {
 		switch property {
@@ -203,22 +420,113 @@ func (pub *EPUBCompiler) addMarkdown(filename string) error {
 			properties[i] = "cover" // Смухлюем и поправим недопустимое
 		}
 	}
-	// Осуществляем преобразование по шаблону для формирования полноценной страницы
-	if err = pub.templates.ExecuteTemplate(buf, templateName, meta); err != nil {
-		return err
+	if !pub.layouts[templateName] {
+		return fmt.Errorf("%s: unknown layout %q", filename, templateName)
+	}
+	// Отмечаем файлы с формулами
+	if dirs.has(mathDirective, displayMathDirective) {
+		properties = append(properties, "mathml")
 	}
 	// Добавляем расширение имени файла .xhtml
 	filename += ".xhtml"
+	// Нумеруем главы
+	if err = pub.number(meta, ct); err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	// Оформляем иллюстрации и ссылки на них
+	pub.figures(body, filename)
+	// Применяем правила типографики для языка файла и расставляем мягкие
+	// переносы последними, когда метки иллюстраций, ссылки на них и
+	// выделенные блоки уже распознаны в тексте
+	pub.typographyRules(lang).typeset(body, new(typesetState))
+	if hyphenate, ok := meta["hyphenate"].(bool); ok && hyphenate || !ok && pub.config.Hyphenate {
+		h, err := pub.hyphenator(lang)
+		if err != nil {
+			return err
+		}
+		if h != nil {
+			h.hyphenateText(body, pub.config.NoHyphenate)
+		}
+	}
 	// Добавляем информацию о файле в оглавление
 	pub.nav = append(pub.nav, &NavigationItem{
 		Title:       title,
 		Subtitle:    meta.Subtitle(),
+		Label:       meta.Get("label"),
 		Filename:    filename,
 		Level:       meta.GetInt("level"),
 		ContentType: ct,
 	})
-	// записываем содержимое файла
-	return pub.writer.Add(filename, ct, buf, properties...)
+	// Откладываем запись файла до окончания обработки всех исходных файлов
+	pub.pages = append(pub.pages, &page{
+		Filename:    filename,
+		Template:    templateName,
+		ContentType: ct,
+		Properties:  properties,
+		Meta:        meta,
+		Body:        body,
+	})
+	return nil
+}
+
+// page описывает страницу публикации, запись которой откладывается до окончания
+// обработки всех исходных файлов: только тогда можно разрешить ссылки между
+// ними.
+type page struct {
+	Filename    string            // Имя файла в публикации
+	Template    string            // Название шаблона
+	ContentType epub.ContentType  // Тип файла
+	Properties  []string          // Свойства файла
+	Meta        metadata.Metadata // Метаданные для шаблона
+	Body        *html.Node        // Содержимое страницы
+}
+
+// flush записывает в публикацию все отложенные страницы.
+func (pub *EPUBCompiler) flush() error {
+	// Инициализируем внутренний пул для работы с информацией
+	var buf = buffers.Get().(*bytes.Buffer)
+	defer buffers.Put(buf)
+	for _, page := range pub.pages {
+		// Разрешаем перекрестные ссылки
+		pub.resolveRefs(page)
+		buf.Reset()
+		// Избавляемся от пустых строк между тегами и воссоздаем нормализованный XHTML
+		for _, node := range children(page.Body) {
+			if node.Type == html.TextNode && reMultiNewLines.MatchString(node.Data) {
+				buf.WriteByte('\n')
+				continue
+			}
+			// TODO: Убрать пустые строки во вложенных элементах
+			if err := html.Render(buf, node); err != nil {
+				return err
+			}
+		}
+		// Сохраняем получившийся HTML в том же самом описании метаданных, чтобы не плодить сущности
+		page.Meta["content"] = template.HTML(buf.String())
+		page.Meta["_filename_"] = page.Filename
+		if page.Template == "nav" {
+			page.Meta["landmarks"] = pub.landmarksFor(page.Filename)
+		}
+		if pub.config.Draft {
+			page.Meta["_buildtime_"] = pub.buildTime.Format("2006-01-02 15:04:05 MST")
+		}
+		buf.Reset()                 // Сбрасываем буфер
+		buf.WriteString(xml.Header) // добавляем XML-заголовок
+		// Осуществляем преобразование по шаблону для формирования полноценной страницы
+		if err := pub.templates.ExecuteTemplate(buf, page.Template, page.Meta); err != nil {
+			return err
+		}
+		// записываем содержимое файла
+		if err := pub.writer.Add(page.Filename, page.ContentType, buf, page.Properties...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// warnf выводит предупреждение, не прерывающее компиляцию.
+func (pub *EPUBCompiler) warnf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
 }
 
 func (pub *EPUBCompiler) addMedia(filename string) error {
@@ -228,6 +536,7 @@ func (pub *EPUBCompiler) addMedia(filename string) error {
 		// Обложка публикации
 		properties = []string{"cover-image"}
 		pub.setCover = true // Обрабатываем только одну обложку
+		pub.coverImage = filename
 	}
 	// Добавляем файл в публикацию
 	return pub.writer.AddFile(filename, filename, epub.Media, properties...)
@@ -237,6 +546,7 @@ func (pub *EPUBCompiler) addMedia(filename string) error {
 type NavigationItem struct {
 	Title       string           // Заголовок
 	Subtitle    string           // Подзаголовок
+	Label       string           // Подпись с номером главы
 	Level       int              // Уровень заголовка
 	Filename    string           // Имя файла
 	ContentType epub.ContentType // Тип файла
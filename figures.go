@@ -0,0 +1,236 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// label описывает метку, на которую можно сослаться из текста в виде
+// @fig:name.
+type label struct {
+	Filename string // Имя файла в публикации
+	ID       string // Идентификатор элемента
+	Text     string // Текст ссылки, например "Рисунок 3.2"
+}
+
+// reLabel описывает метку иллюстрации, указанную сразу после изображения:
+// ![Подпись](map.png){#fig:map}.
+var reLabel = regexp.MustCompile(`^\s*\{#([\w:.-]+)\}\s*$`)
+
+// figures заменяет абзацы, состоящие из одного изображения, на иллюстрации
+// <figure> с подписью и номером. Подпись берется из заголовка изображения или
+// из определения, следующего сразу за изображением:
+//
+//	![Карта](map.png){#fig:map}
+//	: Карта окрестностей
+//
+// После этого в тексте заменяются ссылки на метки.
+func (pub *EPUBCompiler) figures(body *html.Node, filename string) {
+	if pub.config.Figures == "chapter" {
+		pub.figure = 0 // Нумерация иллюстраций в каждой главе своя
+		if pub.chapter == "" {
+			// Иллюстрации в главах без номера нумеруются сквозной нумерацией
+			pub.figure = pub.unnumbered
+			defer func() { pub.unnumbered = pub.figure }()
+		}
+	}
+	var walk func(*html.Node)
+	walk = func(parent *html.Node) {
+		for node := parent.FirstChild; node != nil; node = node.NextSibling {
+			if node.Type != html.ElementNode {
+				continue
+			}
+			switch node.Data {
+			case "p":
+				if img, id := standaloneImage(node); img != nil {
+					var figure = pub.newFigure(filename, img, id, nil)
+					parent.InsertBefore(figure, node)
+					parent.RemoveChild(node)
+					node = figure
+				}
+			case "dl":
+				// Изображение с подписью в виде определения
+				var dt, dd = firstElement(node, "dt"), firstElement(node, "dd")
+				if dt == nil || dd == nil || nextElement(dd) != nil {
+					break
+				}
+				if img, id := standaloneImage(dt); img != nil {
+					var figure = pub.newFigure(filename, img, id, children(dd))
+					parent.InsertBefore(figure, node)
+					parent.RemoveChild(node)
+					node = figure
+				}
+			case "pre", "code", "figure":
+			default:
+				walk(node)
+			}
+		}
+	}
+	walk(body)
+	crossRefs(body)
+}
+
+// standaloneImage возвращает изображение, если элемент не содержит ничего,
+// кроме него и, возможно, метки.
+func standaloneImage(elem *html.Node) (img *html.Node, id string) {
+	for node := elem.FirstChild; node != nil; node = node.NextSibling {
+		switch {
+		case node.Type == html.ElementNode && node.Data == "img" && img == nil:
+			img = node
+		case node.Type == html.TextNode && strings.TrimSpace(node.Data) == "":
+		case node.Type == html.TextNode && img != nil && id == "":
+			var m = reLabel.FindStringSubmatch(node.Data)
+			if m == nil {
+				return nil, ""
+			}
+			id = m[1]
+		default:
+			return nil, ""
+		}
+	}
+	return img, id
+}
+
+// newFigure возвращает элемент иллюстрации с изображением и подписью. Номер
+// присваивается только иллюстрациям с подписью или меткой.
+func (pub *EPUBCompiler) newFigure(filename string, img *html.Node, id string, caption []*html.Node) *html.Node {
+	var figure = newElement("figure")
+	img.Parent.RemoveChild(img)
+	figure.AppendChild(img)
+	if title := getAttr(img, "title"); len(caption) == 0 && title != "" {
+		caption = []*html.Node{{Type: html.TextNode, Data: title}}
+	}
+	if len(caption) == 0 && id == "" {
+		return figure
+	}
+	pub.figure++
+	var number = strconv.Itoa(pub.figure)
+	if pub.config.Figures == "chapter" && pub.chapter != "" {
+		number = pub.chapter + "." + number
+	}
+	var text = localize(pub.lang, "figure") + " " + number
+	if id != "" {
+		var elemID = strings.Replace(id, ":", "-", -1)
+		setAttr(figure, "id", elemID)
+		pub.labels[id] = &label{Filename: filename, ID: elemID, Text: text}
+	}
+	var figcaption = newElement("figcaption")
+	var span = newElement("span", "class", "label")
+	span.AppendChild(&html.Node{Type: html.TextNode, Data: text + "."})
+	figcaption.AppendChild(span)
+	if len(caption) > 0 {
+		figcaption.AppendChild(&html.Node{Type: html.TextNode, Data: " "})
+	}
+	for _, child := range caption {
+		if child.Parent != nil {
+			child.Parent.RemoveChild(child)
+		}
+		figcaption.AppendChild(child)
+	}
+	figure.AppendChild(figcaption)
+	return figure
+}
+
+// reCrossRef описывает ссылку на метку в тексте: @fig:map.
+var reCrossRef = regexp.MustCompile(`(^|[^\w@])@(fig:[\w.-]*\w)`)
+
+// crossRefs заменяет в тексте ссылки на метки временными ссылками <a class="xref">,
+// которые разрешаются после обработки всех файлов.
+func crossRefs(parent *html.Node) {
+	for node := parent.FirstChild; node != nil; {
+		var next = node.NextSibling
+		switch node.Type {
+		case html.ElementNode:
+			switch node.Data {
+			case "pre", "code", "a", "math":
+			default:
+				crossRefs(node)
+			}
+		case html.TextNode:
+			var text = node.Data
+			var matches = reCrossRef.FindAllStringSubmatchIndex(text, -1)
+			if matches == nil {
+				break
+			}
+			var last = 0
+			for _, m := range matches {
+				parent.InsertBefore(&html.Node{Type: html.TextNode, Data: text[last:m[3]]}, node)
+				var ref = text[m[4]:m[5]]
+				var a = newElement("a", "class", "xref", "href", "#"+ref)
+				a.AppendChild(&html.Node{Type: html.TextNode, Data: "@" + ref})
+				parent.InsertBefore(a, node)
+				last = m[1]
+			}
+			node.Data = text[last:]
+		}
+		node = next
+	}
+}
+
+// resolveRefs заменяет временные ссылки на метки ссылками на иллюстрации.
+func (pub *EPUBCompiler) resolveRefs(page *page) {
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for node := node.FirstChild; node != nil; node = node.NextSibling {
+			if node.Type != html.ElementNode {
+				continue
+			}
+			var href = getAttr(node, "href")
+			if node.Data != "a" || getAttr(node, "class") != "xref" || !strings.HasPrefix(href, "#") {
+				walk(node)
+				continue
+			}
+			var label = pub.labels[href[1:]]
+			if label == nil {
+				pub.warnf("%s: unknown reference @%s", page.Filename, href[1:])
+				continue
+			}
+			href = "#" + label.ID
+			if label.Filename != page.Filename {
+				href = relativePath(page.Filename, label.Filename) + href
+			}
+			setAttr(node, "href", href)
+			// Заменяем содержимое ссылки, которое в ссылке, заданной в HTML,
+			// может быть пустым
+			for node.FirstChild != nil {
+				node.RemoveChild(node.FirstChild)
+			}
+			node.AppendChild(&html.Node{Type: html.TextNode, Data: label.Text})
+		}
+	}
+	walk(page.Body)
+}
+
+// relativePath возвращает путь к файлу target относительно каталога файла from.
+// Оба пути указываются относительно корня публикации.
+func relativePath(from, target string) string {
+	rel, err := filepath.Rel(filepath.Dir(from), target)
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(rel)
+}
+
+// firstElement возвращает первый дочерний элемент с указанным именем.
+func firstElement(parent *html.Node, tag string) *html.Node {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type == html.ElementNode && node.Data == tag {
+			return node
+		}
+	}
+	return nil
+}
+
+// nextElement возвращает следующий за указанным элемент.
+func nextElement(node *html.Node) *html.Node {
+	for node = node.NextSibling; node != nil; node = node.NextSibling {
+		if node.Type == html.ElementNode {
+			return node
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mdigger/epub3"
+)
+
+// funcs возвращает библиотеку функций, доступных в шаблонах страниц и
+// шорткодов. Функции, зависящие от языка, принимают его последним
+// необязательным параметром; по умолчанию используется язык публикации.
+func (pub *EPUBCompiler) funcs() template.FuncMap {
+	var langOf = func(lang []string) string {
+		if len(lang) > 0 && lang[0] != "" {
+			return lang[0]
+		}
+		return pub.lang
+	}
+	return template.FuncMap{
+		// date форматирует дату: {{ date .date }} или {{ date .date "2006-01-02" }}.
+		// Вместо формата можно указать язык: {{ date .date "ru" }}.
+		"date": func(value interface{}, args ...string) (string, error) {
+			t, err := toTime(value)
+			if err != nil {
+				return "", err
+			}
+			if len(args) > 0 && strings.ContainsAny(args[0], "0123456789") {
+				return t.Format(args[0]), nil
+			}
+			return formatDate(t, langOf(args)), nil
+		},
+		// roman записывает число римскими цифрами: {{ roman 12 }} → XII.
+		"roman": func(value interface{}) (string, error) {
+			n, err := toInt(value)
+			if err != nil {
+				return "", err
+			}
+			return roman(n), nil
+		},
+		// words записывает число словами: {{ words 21 "de" }} → einundzwanzig.
+		"words": func(value interface{}, lang ...string) (string, error) {
+			n, err := toInt(value)
+			if err != nil {
+				return "", err
+			}
+			return words(n, langOf(lang)), nil
+		},
+		// ordinal записывает порядковое числительное: {{ ordinal 1 "ru" }} → первая.
+		"ordinal": func(value interface{}, lang ...string) (string, error) {
+			n, err := toInt(value)
+			if err != nil {
+				return "", err
+			}
+			return ordinal(n, langOf(lang)), nil
+		},
+		// letters записывает номер буквами: {{ letters 2 }} → B.
+		"letters": func(value interface{}) (string, error) {
+			n, err := toInt(value)
+			if err != nil {
+				return "", err
+			}
+			return letters(n), nil
+		},
+		// l10n возвращает локализованную строку: {{ l10n "figure" }}.
+		"l10n": func(key string, lang ...string) string {
+			return localize(langOf(lang), key)
+		},
+		"slugify": slugify,
+		// book возвращает метаданные публикации: {{ range book.Creator }}.
+		"book": func() *epub.Metadata {
+			return pub.writer.Metadata
+		},
+		// meta возвращает значение из файла метаданных: {{ meta "collection" }}.
+		"meta": func(key string) interface{} {
+			return pub.bookmeta[key]
+		},
+		// nav возвращает оглавление публикации.
+		"nav": func() Navigaton {
+			return pub.nav
+		},
+		// dict собирает словарь из пар ключ-значение для передачи во вложенный
+		// шаблон: {{ template "name" dict "x" 1 "lines" .title }}.
+		"dict": func(pairs ...interface{}) (map[string]interface{}, error) {
+			if len(pairs)%2 != 0 {
+				return nil, fmt.Errorf("dict: odd number of arguments")
+			}
+			var result = make(map[string]interface{}, len(pairs)/2)
+			for i := 0; i < len(pairs); i += 2 {
+				result[fmt.Sprint(pairs[i])] = pairs[i+1]
+			}
+			return result, nil
+		},
+		// relURL возвращает путь к файлу публикации относительно другого
+		// файла: {{ relURL ._filename_ "images/logo.png" }}.
+		"relURL": relativePath,
+	}
+}
+
+// toInt приводит значение из метаданных к целому числу.
+func toInt(value interface{}) (int, error) {
+	switch value := value.(type) {
+	case int:
+		return value, nil
+	case int64:
+		return int(value), nil
+	case float64:
+		return int(value), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(value))
+	}
+	return 0, fmt.Errorf("not a number: %v", value)
+}
+
+// dateLayouts содержит поддерживаемые форматы дат в метаданных.
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02", "2006-01", "2006"}
+
+// toTime приводит значение из метаданных к дате.
+func toTime(value interface{}) (time.Time, error) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, nil
+	case *epub.Element:
+		if value != nil {
+			return toTime(value.Value)
+		}
+	case string:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("not a date: %v", value)
+}
+
+// slugify возвращает строку, пригодную для использования в идентификаторах и
+// именах файлов: буквы переводятся в нижний регистр, а последовательности
+// остальных символов заменяются дефисом.
+func slugify(s string) string {
+	var (
+		result strings.Builder
+		dash   bool
+	)
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && result.Len() > 0 {
+				result.WriteByte('-')
+			}
+			dash = false
+			result.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		dash = true
+	}
+	return result.String()
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// syntax описывает правила подсветки синтаксиса языка программирования.
+type syntax struct {
+	keywords     []string  // Ключевые слова
+	builtins     []string  // Встроенные типы, функции и константы
+	lineComment  []string  // Начало строчного комментария
+	blockComment [2]string // Начало и конец блочного комментария
+	quotes       string    // Символы, ограничивающие строки
+	words        map[string]string
+}
+
+// class возвращает класс для идентификатора.
+func (s *syntax) class(word string) string {
+	if s.words == nil {
+		s.words = make(map[string]string, len(s.keywords)+len(s.builtins))
+		for _, name := range s.keywords {
+			s.words[name] = "hl-k"
+		}
+		for _, name := range s.builtins {
+			s.words[name] = "hl-t"
+		}
+	}
+	return s.words[word]
+}
+
+var (
+	cSyntax = &syntax{
+		keywords: strings.Fields(`auto break case const continue default do else enum extern
+			for goto if inline register restrict return sizeof static struct switch typedef
+			union volatile while class namespace template typename public private protected
+			virtual override new delete this throw try catch using operator nullptr true false`),
+		builtins: strings.Fields(`void char short int long float double signed unsigned
+			bool size_t int8_t int16_t int32_t int64_t uint8_t uint16_t uint32_t uint64_t
+			std string vector map NULL`),
+		lineComment:  []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       `"'`,
+	}
+	jsSyntax = &syntax{
+		keywords: strings.Fields(`async await break case catch class const continue debugger
+			default delete do else export extends finally for from function if import in
+			instanceof let new of return static super switch this throw try typeof var void
+			while with yield true false null undefined interface type implements enum as`),
+		builtins: strings.Fields(`Array Boolean Date Error JSON Map Math Number Object Promise
+			RegExp Set String Symbol console document window number string boolean any`),
+		lineComment:  []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+	}
+	shellSyntax = &syntax{
+		keywords: strings.Fields(`if then else elif fi for while until do done case esac
+			in function return break continue local export readonly select`),
+		builtins: strings.Fields(`echo printf cd pwd test read exit source set unset shift
+			eval exec trap alias cat grep sed awk ls mkdir rm cp mv`),
+		lineComment: []string{"#"},
+		quotes:      `"'`,
+	}
+	// syntaxes содержит поддерживаемые языки программирования.
+	syntaxes = map[string]*syntax{
+		"go": {
+			keywords: strings.Fields(`break case chan const continue default defer else
+				fallthrough for func go goto if import interface map package range return
+				select struct switch type var`),
+			builtins: strings.Fields(`bool byte complex64 complex128 error float32 float64
+				int int8 int16 int32 int64 rune string uint uint8 uint16 uint32 uint64 uintptr
+				true false iota nil append cap close complex copy delete imag len make new
+				panic print println real recover any`),
+			lineComment:  []string{"//"},
+			blockComment: [2]string{"/*", "*/"},
+			quotes:       "\"'`",
+		},
+		"c":    cSyntax,
+		"cpp":  cSyntax,
+		"c++":  cSyntax,
+		"h":    cSyntax,
+		"java": cSyntax,
+		"cs":   cSyntax,
+		"python": {
+			keywords: strings.Fields(`and as assert async await break class continue def del
+				elif else except finally for from global if import in is lambda nonlocal not
+				or pass raise return try while with yield True False None`),
+			builtins: strings.Fields(`print len range int str float list dict set tuple bool
+				open type isinstance enumerate zip map filter sorted self super object`),
+			lineComment: []string{"#"},
+			quotes:      `"'`,
+		},
+		"rust": {
+			keywords: strings.Fields(`as async await break const continue crate dyn else enum
+				extern false fn for if impl in let loop match mod move mut pub ref return self
+				Self static struct super trait true type unsafe use where while`),
+			builtins: strings.Fields(`bool char str i8 i16 i32 i64 i128 isize u8 u16 u32 u64
+				u128 usize f32 f64 String Vec Option Result Some None Ok Err Box`),
+			lineComment:  []string{"//"},
+			blockComment: [2]string{"/*", "*/"},
+			quotes:       `"`,
+		},
+		"javascript": jsSyntax,
+		"js":         jsSyntax,
+		"typescript": jsSyntax,
+		"ts":         jsSyntax,
+		"json": {
+			builtins: strings.Fields(`true false null`),
+			quotes:   `"`,
+		},
+		"sql": {
+			keywords: strings.Fields(`select from where and or not insert into values update
+				set delete create table drop alter index join left right inner outer on as
+				group by order having limit offset distinct union null is in like primary key
+				SELECT FROM WHERE AND OR NOT INSERT INTO VALUES UPDATE SET DELETE CREATE TABLE
+				DROP ALTER INDEX JOIN LEFT RIGHT INNER OUTER ON AS GROUP BY ORDER HAVING LIMIT
+				OFFSET DISTINCT UNION NULL IS IN LIKE PRIMARY KEY`),
+			builtins: strings.Fields(`int integer varchar text date boolean count sum avg min max
+				INT INTEGER VARCHAR TEXT DATE BOOLEAN COUNT SUM AVG MIN MAX`),
+			lineComment:  []string{"--"},
+			blockComment: [2]string{"/*", "*/"},
+			quotes:       `'"`,
+		},
+		"yaml": {
+			builtins:    strings.Fields(`true false null yes no on off`),
+			lineComment: []string{"#"},
+			quotes:      `"'`,
+		},
+		"css": {
+			blockComment: [2]string{"/*", "*/"},
+			quotes:       `"'`,
+		},
+		"sh":    shellSyntax,
+		"bash":  shellSyntax,
+		"shell": shellSyntax,
+		"zsh":   shellSyntax,
+	}
+)
+
+// token описывает фрагмент исходного текста с классом подсветки.
+type token struct {
+	class string // Класс CSS; пустой для текста без подсветки
+	text  string
+}
+
+// tokenize разбивает исходный текст на фрагменты для подсветки.
+func (s *syntax) tokenize(src string) []token {
+	var tokens []token
+	var plain strings.Builder // Накопленный текст без подсветки
+	var emit = func(class, text string) {
+		if plain.Len() > 0 {
+			tokens = append(tokens, token{text: plain.String()})
+			plain.Reset()
+		}
+		tokens = append(tokens, token{class: class, text: text})
+	}
+next:
+	for i := 0; i < len(src); {
+		var rest = src[i:]
+		// Комментарии
+		for _, prefix := range s.lineComment {
+			if strings.HasPrefix(rest, prefix) {
+				var end = strings.IndexByte(rest, '\n')
+				if end < 0 {
+					end = len(rest)
+				}
+				emit("hl-c", rest[:end])
+				i += end
+				continue next
+			}
+		}
+		if start := s.blockComment[0]; start != "" && strings.HasPrefix(rest, start) {
+			var end = strings.Index(rest[len(start):], s.blockComment[1])
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += len(start) + len(s.blockComment[1])
+			}
+			emit("hl-c", rest[:end])
+			i += end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(rest)
+		switch {
+		case strings.IndexByte(s.quotes, rest[0]) >= 0:
+			// Строки с учетом экранирования
+			var quote = rest[0]
+			var end = 1
+			for end < len(rest) && rest[end] != quote {
+				if rest[end] == '\n' && quote != '`' {
+					break // Незакрытая строка
+				}
+				if rest[end] == '\\' && quote != '`' && end+1 < len(rest) {
+					end++
+				}
+				end++
+			}
+			if end < len(rest) && rest[end] == quote {
+				end++
+			}
+			emit("hl-s", rest[:end])
+			i += end
+		case r >= '0' && r <= '9':
+			var end = 1
+			for end < len(rest) && (isWordByte(rest[end]) || rest[end] == '.') {
+				end++
+			}
+			emit("hl-n", rest[:end])
+			i += end
+		case r == '_' || unicode.IsLetter(r):
+			var end = size
+			for end < len(rest) {
+				r, size := utf8.DecodeRuneInString(rest[end:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				end += size
+			}
+			if class := s.class(rest[:end]); class != "" {
+				emit(class, rest[:end])
+			} else {
+				plain.WriteString(rest[:end])
+			}
+			i += end
+		default:
+			plain.WriteString(rest[:size])
+			i += size
+		}
+	}
+	if plain.Len() > 0 {
+		tokens = append(tokens, token{text: plain.String()})
+	}
+	return tokens
+}
+
+// isWordByte возвращает true для латинских букв, цифр и подчеркивания.
+func isWordByte(ch byte) bool {
+	return ch == '_' || ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z'
+}
+
+// highlight подсвечивает синтаксис в блоках кода <pre><code class="language-go">.
+// Возвращает true, если хотя бы один блок был подсвечен. Дополнительные слова
+// в описании блока кода после названия языка позволяют отключить подсветку
+// (nohighlight) или включить и отключить нумерацию строк (linenos, nolinenos).
+func (pub *EPUBCompiler) highlight(parent *html.Node) bool {
+	var result bool
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		if node.Data != "pre" {
+			if pub.highlight(node) {
+				result = true
+			}
+			continue
+		}
+		var code = node.FirstChild
+		if code == nil || code.Type != html.ElementNode || code.Data != "code" ||
+			code.FirstChild == nil || code.FirstChild != code.LastChild ||
+			code.FirstChild.Type != html.TextNode {
+			continue
+		}
+		var (
+			lang     string
+			linenos  = pub.config.LineNumbers
+			disabled bool
+		)
+		for _, class := range strings.Fields(getAttr(code, "class")) {
+			switch {
+			case strings.HasPrefix(class, "language-"):
+				lang = strings.ToLower(class[len("language-"):])
+			case class == "nohighlight":
+				disabled = true
+			case class == "linenos":
+				linenos = true
+			case class == "nolinenos":
+				linenos = false
+			}
+		}
+		var syntax = syntaxes[lang]
+		if syntax == nil || disabled {
+			continue
+		}
+		var src = code.FirstChild.Data
+		code.RemoveChild(code.FirstChild)
+		var line = 1
+		var newLine = func() {
+			if linenos {
+				var ln = newElement("span", "class", "hl-ln")
+				ln.AppendChild(&html.Node{Type: html.TextNode, Data: strconv.Itoa(line)})
+				code.AppendChild(ln)
+			}
+			line++
+		}
+		newLine()
+		for _, tok := range syntax.tokenize(strings.TrimSuffix(src, "\n")) {
+			// Фрагменты разбиваем по строкам, чтобы пронумеровать каждую
+			for i, text := range strings.Split(tok.text, "\n") {
+				if i > 0 {
+					code.AppendChild(&html.Node{Type: html.TextNode, Data: "\n"})
+					newLine()
+				}
+				if text == "" {
+					continue
+				}
+				var elem = &html.Node{Type: html.TextNode, Data: text}
+				if tok.class != "" {
+					var span = newElement("span", "class", tok.class)
+					span.AppendChild(elem)
+					elem = span
+				}
+				code.AppendChild(elem)
+			}
+		}
+		code.AppendChild(&html.Node{Type: html.TextNode, Data: "\n"})
+		setAttr(node, "class", "highlight")
+		result = true
+	}
+	return result
+}
+
+// highlightCSS содержит стили подсветки синтаксиса для разных тем оформления.
+var highlightCSS = map[string]string{
+	"light": `pre.highlight .hl-k { color: #a626a4; }
+pre.highlight .hl-t { color: #0184bc; }
+pre.highlight .hl-s { color: #50a14f; }
+pre.highlight .hl-n { color: #986801; }
+pre.highlight .hl-c { color: #8e908c; font-style: italic; }
+`,
+	"contrast": `pre.highlight .hl-k { color: #000; font-weight: bold; }
+pre.highlight .hl-t { color: #000; font-weight: bold; }
+pre.highlight .hl-s { color: #000; }
+pre.highlight .hl-n { color: #000; }
+pre.highlight .hl-c { color: #000; font-style: italic; }
+`,
+}
+
+// highlightStylesheet возвращает содержимое файла стилей подсветки синтаксиса.
+// Для темы auto используется светлая тема, которая заменяется контрастной на
+// монохромных экранах электронных книг.
+func highlightStylesheet(theme string) string {
+	const lineNumbers = `pre.highlight .hl-ln { display: inline-block; min-width: 2em; ` +
+		`margin-right: 1em; text-align: right; color: #999; -webkit-user-select: none; user-select: none; }
+`
+	if css, ok := highlightCSS[theme]; ok {
+		return css + lineNumbers
+	}
+	return highlightCSS["light"] + lineNumbers +
+		"@media (monochrome) {\n" + highlightCSS["contrast"] + "}\n"
+}
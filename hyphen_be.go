@@ -0,0 +1,264 @@
+package main
+
+// hyphenBE содержит шаблоны переноса для белорусского языка из проекта hyph-utf8
+// (hyph-be). Авторы шаблонов и условия их распространения указаны в
+// исходном файле: https://github.com/hyphenation/tex-hyphen.
+const hyphenBE = `\patterns{
+.а8 .аб3б6 .аб3в6 .аб3г6 .аб3д6 .аб3ж6 .аб3з6 .аб3к6 .аб3л6 .аб3м6 .аб3н6
+.аб3п6 .аб3р6 .аб3с6 .аб3т6 .аб3ф6 .аб3х6 .аб3ц6 .аб3ч6 .аб3ш6 .аб3ґ6 .ад3б6
+.ад3в6 .ад3г6 .ад3д6 .ад7жал .ад7жар .ад7жат .ад7жац .ад7жаў .ад7жлукц
+.ад7жлукч .ад7жыв .ад7жыл .ад7жын .ад7жыт .ад7жыць .ад7жыў .ад7зав .ад7зван
+.ад7звон .ад7знак .ад7знац .ад7знач .ад7зыва .ад7зыўн .ад3к6 .ад3л6 .ад3м6
+.ад3н6 .ад3п6 .ад3р6 .ад3с6 .ад3т6 .ад3ф6 .ад3х6 .ад3ц6 .ад3ч6 .ад3ш6 .ад3ґ6
+.б8 .б'8 .б’8 .бб8 .бв8 .бг8 .бд8 .бе2з3б6 .бе2з3в6 .бе2з3г6 .бе2з3д6 .бе2з3ж6
+.бе2з3з6 .бе2з3к6 .бе2з3л6 .бе2з3м6 .бе2з3н6 .бе2з3п6 .бе2з3р6 .бе2з3с6
+.бе2з3т6 .бе2з3ф6 .бе2з3х6 .бе2з3ц6 .бе2з3ч6 .бе2з3ш6 .бе2з3ґ6 .бе2с3б6
+.бе2с3в6 .бе2с3г6 .бе2с3д6 .бе2с3ж6 .бе2с3з6 .бе2с3к6 .бе2с3л6 .бе2с3м6
+.бе2с3н6 .бе2с3п6 .бе2с3р6 .бе2с3с6 .бе2с3т6 .бе2с3ф6 .бе2с3х6 .бе2с3ц6
+.бе2с3ч6 .бе2с3ш6 .бе2с3ґ6 .бж8 .бз8 .бк8 .бл8 .бм8 .бн8 .бп8 .бр8 .бс8 .бт8
+.бф8 .бх8 .бц8 .бч8 .бш8 .бь8 .бя2з3б6 .бя2з3в6 .бя2з3г6 .бя2з3д6 .бя2з3ж6
+.бя2з3з6 .бя2з3к6 .бя2з3л6 .бя2з3м6 .бя2з3н6 .бя2з3п6 .бя2з3р6 .бя2з3с6
+.бя2з3т6 .бя2з3ф6 .бя2з3х6 .бя2з3ц6 .бя2з3ч6 .бя2з3ш6 .бя2з3ґ6 .бя2с3б6
+.бя2с3в6 .бя2с3г6 .бя2с3д6 .бя2с3ж6 .бя2с3з6 .бя2с3к6 .бя2с3л6 .бя2с3м6
+.бя2с3н6 .бя2с3п6 .бя2с3р6 .бя2с3с6 .бя2с3т6 .бя2с3ф6 .бя2с3х6 .бя2с3ц6
+.бя2с3ч6 .бя2с3ш6 .бя2с3ґ6 .бґ8 .в8 .в'8 .в’8 .вб8 .вв8 .вг8 .вд8 .вж8 .вз8
+.вк8 .вл8 .вм8 .вн8 .вп8 .вр8 .вс8 .вт8 .вф8 .вх8 .вц8 .вч8 .вш8 .вы1к8люч
+.вь8 .вґ8 .г8 .г'8 .г’8 .гб8 .гв8 .гг8 .гд8 .гж8 .гз8 .гк8 .гл8 .гм8 .гн8 .гп8
+.гр8 .гс8 .гт8 .гф8 .гх8 .гц8 .гч8 .гш8 .гь8 .гґ8 .д8 .д'8 .д’8 .дб8 .дв8
+.дву8х3 .дг8 .дд8 .дж8 .дз8 .дк8 .дл8 .дм8 .дн8 .дп8 .др8 .дс8 .дт8 .дф8 .дх8
+.дц8 .дч8 .дш8 .дґ8 .е8 .ж8 .ж'8 .ж’8 .жб8 .жв8 .жг8 .жд8 .жж8 .жз8 .жк8 .жл8
+.жм8 .жн8 .жп8 .жр8 .жс8 .жт8 .жф8 .жх8 .жц8 .жч8 .жш8 .жґ8 .з8 .з'8 .з’8 .зб8
+.зв8 .зг8 .зд8 .зж8 .зз8 .зк8 .зл8 .зм8 .зн8 .зп8 .зр8 .зс8 .зт8 .зф8 .зх8
+.зц8 .зч8 .зш8 .зь8 .зґ8 .й8 .к8 .к'8 .к’8 .кб8 .кв8 .кг8 .кд8 .кж8 .кз8 .кк8
+.кл8 .км8 .кн8 .кп8 .кр8 .кс8 .кт8 .кф8 .кх8 .кц8 .кч8 .кш8 .кь8 .кґ8 .л8 .л'8
+.л’8 .лб8 .лв8 .лг8 .лд8 .лж8 .лз8 .лк8 .лл8 .лм8 .лн8 .лп8 .лр8 .лс8 .лт8
+.лф8 .лх8 .лц8 .лч8 .лш8 .ль8 .лґ8 .м8 .м'8 .м’8 .мб8 .мв8 .мг8 .мд8 .мж8 .мз8
+.мк8 .мл8 .мм8 .мн8 .мп8 .мр8 .мс8 .мт8 .мф8 .мх8 .мц8 .мч8 .мш8 .мь8 .мґ8 .н8
+.н'8 .н’8 .на2д3б6 .на2д3в6 .на2д3г6 .на2д3д6 .на8д7звычай .на8д7земн .на2д3к6
+.на2д3л6 .на2д3м6 .на2д3н6 .на2д3п6 .на2д3р6 .на2д3с6 .на2д3т6 .на2д3ф6
+.на2д3х6 .на2д3ц6 .на2д3ч6 .на2д3ш6 .на2д3ґ6 .нб8 .нв8 .нг8 .нд8 .не8 .нж8
+.нз8 .нк8 .нл8 .нм8 .нн8 .нп8 .нр8 .нс8 .нт8 .нф8 .нх8 .нц8 .нч8 .нш8 .нь8
+.ня8 .нґ8 .о8 .п8 .п'8 .п’8 .па3г8лядз .па2д3б6 .па2д3в6 .па2д3г6 .па2д3д6
+.па8д7жар .па8д7жыв .па8д7жыл .па8д7жыць .па8д7жыў .па8д7зав .па8д7загалова
+.па8д7загалоў .па8д7закус .па8д7закуш .па8д7зараб .па8д7зароб .па8д7земн
+.па8д7зор .па8д7зыва .па8д7зяме .па2д3к6 .па2д3л6 .па2д3м6 .па2д3н6 .па2д3п6
+.па2д3р6 .па2д3с6 .па2д3т6 .па2д3ф6 .па2д3х6 .па2д3ц6 .па2д3ч6 .па2д3ш6
+.па2д3ґ6 .пб8 .пв8 .пг8 .пд8 .пера2д3б6 .пера2д3в6 .пера2д3г6 .пера2д3д6
+.пера2д3к6 .пера2д3л6 .пера2д3м6 .пера2д3н6 .пера2д3п6 .пера2д3р6 .пера2д3с6
+.пера2д3т6 .пера2д3ф6 .пера2д3х6 .пера2д3ц6 .пера2д3ч6 .пера2д3ш6 .пера2д3ґ6
+.пж8 .пз8 .пк8 .пл8 .пм8 .пн8 .пп8 .пр8 .пра2з3б6 .пра2з3в6 .пра2з3г6
+.пра2з3д6 .пра2з3ж6 .пра2з3з6 .пра2з3к6 .пра2з3л6 .пра2з3м6 .пра2з3н6
+.пра2з3п6 .пра2з3р6 .пра2з3с6 .пра2з3т6 .пра2з3ф6 .пра2з3х6 .пра2з3ц6
+.пра2з3ч6 .пра2з3ш6 .пра2з3ґ6 .пс8 .пт8 .пф8 .пх8 .пц8 .пч8 .пш8 .пь8 .пґ8 .р8
+.р'8 .р’8 .ра2з3б6 .ра2з3в6 .ра2з3г6 .ра2з3д6 .ра2з3ж6 .ра2з3з6 .ра2з3к6
+.ра2з3л6 .ра2з3м6 .ра2з3н6 .ра2з3п6 .ра2з3р6 .ра2з3с6 .ра2з3т6 .ра2з3ф6
+.ра2з3х6 .ра2з3ц6 .ра2з3ч6 .ра2з3ш6 .ра2з3ґ6 .ра2с3б6 .ра2с3в6 .ра2с3г6
+.ра2с3д6 .ра2с3ж6 .ра2с3з6 .ра2с3к6 .ра2с3л6 .ра2с3м6 .ра2с3н6 .ра2с3п6
+.ра2с3р6 .ра2с3с6 .ра2с3т6 .ра2с3ф6 .ра2с3х6 .ра2с3ц6 .ра2с3ч6 .ра2с3ш6
+.ра2с3ґ6 .рб8 .рв8 .рг8 .рд8 .рж8 .рз8 .рк8 .рл8 .рм8 .рн8 .ро2з3б6 .ро2з3в6
+.ро2з3г6 .ро2з3д6 .ро2з3ж6 .ро2з3з6 .ро2з3к6 .ро2з3л6 .ро2з3м6 .ро2з3н6
+.ро2з3п6 .ро2з3р6 .ро2з3с6 .ро2з3т6 .ро2з3ф6 .ро2з3х6 .ро2з3ц6 .ро2з3ч6
+.ро2з3ш6 .ро2з3ґ6 .ро2с3б6 .ро2с3в6 .ро2с3г6 .ро2с3д6 .ро2с3ж6 .ро2с3з6
+.ро2с3к6 .ро2с3л6 .ро2с3м6 .ро2с3н6 .ро2с3п6 .ро2с3р6 .ро2с3с6 .ро2с3т6
+.ро2с3ф6 .ро2с3х6 .ро2с3ц6 .ро2с3ч6 .ро2с3ш6 .ро2с3ґ6 .рп8 .рр8 .рс8 .рт8 .рф8
+.рх8 .рц8 .рч8 .рш8 .рґ8 .с8 .с'8 .с’8 .сб8 .св8 .сг8 .сд8 .сж8 .сз8 .ск8 .сл8
+.см8 .сн8 .сп8 .сп'8 .сп’8 .ср8 .сс8 .ст8 .сф8 .сх8 .сц8 .сч8 .сш8 .сь8 .сґ8
+.т8 .т'8 .т’8 .тб8 .тв8 .тг8 .тд8 .тж8 .тз8 .тк8 .тл8 .тм8 .тн8 .тп8 .тр8
+.тро8х3 .тс8 .тт8 .тф8 .тх8 .тц8 .тч8 .тш8 .тґ8 .у8 .уз3б6 .уз3в6 .уз3г6
+.уз3д6 .уз3ж6 .уз3з6 .уз3к6 .уз3л6 .уз3м6 .уз3н6 .уз3п6 .уз3р6 .уз3с6 .уз3т6
+.уз3ф6 .уз3х6 .уз3ц6 .уз3ч6 .уз3ш6 .уз3ґ6 .ус3б6 .ус3в6 .ус3г6 .ус3д6 .ус3ж6
+.ус3з6 .ус3к6 .ус3л6 .ус3м6 .ус3н6 .ус3п6 .ус3р6 .ус3с6 .ус3т6 .ус3ф6 .ус3х6
+.ус3ц6 .ус3ч6 .ус3ш6 .ус3ґ6 .ф8 .ф'8 .ф’8 .фб8 .фв8 .фг8 .фд8 .фж8 .фз8 .фк8
+.фл8 .фм8 .фн8 .фп8 .фр8 .фс8 .фт8 .фф8 .фх8 .фц8 .фч8 .фш8 .фь8 .фґ8 .х8 .х'8
+.х’8 .хб8 .хв8 .хг8 .хд8 .хж8 .хз8 .хк8 .хл8 .хм8 .хн8 .хп8 .хр8 .хс8 .хт8
+.хф8 .хх8 .хц8 .хч8 .хш8 .хь8 .хґ8 .ц8 .ц'8 .ц’8 .цб8 .цв8 .цг8 .цд8
+.цера2з3б6 .цера2з3в6 .цера2з3г6 .цера2з3д6 .цера2з3ж6 .цера2з3з6 .цера2з3к6
+.цера2з3л6 .цера2з3м6 .цера2з3н6 .цера2з3п6 .цера2з3р6 .цера2з3с6 .цера2з3т6
+.цера2з3ф6 .цера2з3х6 .цера2з3ц6 .цера2з3ч6 .цера2з3ш6 .цера2з3ґ6 .цера2с3б6
+.цера2с3в6 .цера2с3г6 .цера2с3д6 .цера2с3ж6 .цера2с3з6 .цера2с3к6 .цера2с3л6
+.цера2с3м6 .цера2с3н6 .цера2с3п6 .цера2с3р6 .цера2с3с6 .цера2с3т6 .цера2с3ф6
+.цера2с3х6 .цера2с3ц6 .цера2с3ч6 .цера2с3ш6 .цера2с3ґ6 .цж8 .цз8 .цк8 .цл8
+.цм8 .цн8 .цп8 .цр8 .цс8 .цт8 .цф8 .цх8 .цц8 .цч8 .цш8 .ць8 .цґ8 .ч8 .ч'8 .ч’8
+.чатыро8х3 .чб8 .чв8 .чг8 .чд8 .чж8 .чз8 .чк8 .чл8 .чм8 .чн8 .чп8 .чр8 .чс8
+.чт8 .чф8 .чх8 .чц8 .чч8 .чш8 .чґ8 .ш8 .ш'8 .ш’8 .шб8 .шв8 .шг8 .шд8 .шж8 .шз8
+.шк8 .шл8 .шм8 .шма8т1 .шн8 .шп8 .шр8 .шс8 .шт8 .шф8 .шх8 .шц8 .шч8 .шш8 .шґ8
+.ы8 .э8 .ю8 .я8 .ё8 .і8 .ў8 .ўз3б6 .ўз3в6 .ўз3г6 .ўз3д6 .ўз3ж6 .ўз3з6 .ўз3к6
+.ўз3л6 .ўз3м6 .ўз3н6 .ўз3п6 .ўз3р6 .ўз3с6 .ўз3т6 .ўз3ф6 .ўз3х6 .ўз3ц6 .ўз3ч6
+.ўз3ш6 .ўз3ґ6 .ўс3б6 .ўс3в6 .ўс3г6 .ўс3д6 .ўс3ж6 .ўс3з6 .ўс3к6 .ўс3л6 .ўс3м6
+.ўс3н6 .ўс3п6 .ўс3р6 .ўс3с6 .ўс3т6 .ўс3ф6 .ўс3х6 .ўс3ц6 .ўс3ч6 .ўс3ш6 .ўс3ґ6
+.ґ8 .ґ'8 .ґ’8 .ґб8 .ґв8 .ґг8 .ґд8 .ґж8 .ґз8 .ґк8 .ґл8 .ґм8 .ґн8 .ґп8 .ґр8 .ґс8
+.ґт8 .ґф8 .ґх8 .ґц8 .ґч8 .ґш8 .ґь8 .ґґ8 8-1 -а8 -аб3б6 -аб3в6 -аб3г6 -аб3д6
+-аб3ж6 -аб3з6 -аб3к6 -аб3л6 -аб3м6 -аб3н6 -аб3п6 -аб3р6 -аб3с6 -аб3т6 -аб3ф6
+-аб3х6 -аб3ц6 -аб3ч6 -аб3ш6 -аб3ґ6 -ад3б6 -ад3в6 -ад3г6 -ад3д6 -ад7жал -ад7жар
+-ад7жат -ад7жац -ад7жаў -ад7жлукц -ад7жлукч -ад7жыв -ад7жыл -ад7жын -ад7жыт
+-ад7жыць -ад7жыў -ад7зав -ад7зван -ад7звон -ад7знак -ад7знац -ад7знач -ад7зыва
+-ад7зыўн -ад3к6 -ад3л6 -ад3м6 -ад3н6 -ад3п6 -ад3р6 -ад3с6 -ад3т6 -ад3ф6 -ад3х6
+-ад3ц6 -ад3ч6 -ад3ш6 -ад3ґ6 -б8 -б'8 -б’8 -бб8 -бв8 -бг8 -бд8 -бе2з3б6
+-бе2з3в6 -бе2з3г6 -бе2з3д6 -бе2з3ж6 -бе2з3з6 -бе2з3к6 -бе2з3л6 -бе2з3м6
+-бе2з3н6 -бе2з3п6 -бе2з3р6 -бе2з3с6 -бе2з3т6 -бе2з3ф6 -бе2з3х6 -бе2з3ц6
+-бе2з3ч6 -бе2з3ш6 -бе2з3ґ6 -бе2с3б6 -бе2с3в6 -бе2с3г6 -бе2с3д6 -бе2с3ж6
+-бе2с3з6 -бе2с3к6 -бе2с3л6 -бе2с3м6 -бе2с3н6 -бе2с3п6 -бе2с3р6 -бе2с3с6
+-бе2с3т6 -бе2с3ф6 -бе2с3х6 -бе2с3ц6 -бе2с3ч6 -бе2с3ш6 -бе2с3ґ6 -бж8 -бз8 -бк8
+-бл8 -бм8 -бн8 -бп8 -бр8 -бс8 -бт8 -бф8 -бх8 -бц8 -бч8 -бш8 -бь8 -бя2з3б6
+-бя2з3в6 -бя2з3г6 -бя2з3д6 -бя2з3ж6 -бя2з3з6 -бя2з3к6 -бя2з3л6 -бя2з3м6
+-бя2з3н6 -бя2з3п6 -бя2з3р6 -бя2з3с6 -бя2з3т6 -бя2з3ф6 -бя2з3х6 -бя2з3ц6
+-бя2з3ч6 -бя2з3ш6 -бя2з3ґ6 -бя2с3б6 -бя2с3в6 -бя2с3г6 -бя2с3д6 -бя2с3ж6
+-бя2с3з6 -бя2с3к6 -бя2с3л6 -бя2с3м6 -бя2с3н6 -бя2с3п6 -бя2с3р6 -бя2с3с6
+-бя2с3т6 -бя2с3ф6 -бя2с3х6 -бя2с3ц6 -бя2с3ч6 -бя2с3ш6 -бя2с3ґ6 -бґ8 -в8 -в'8
+-в’8 -вб8 -вв8 -вг8 -вд8 -вж8 -вз8 -вк8 -вл8 -вм8 -вн8 -вп8 -вр8 -вс8 -вт8
+-вф8 -вх8 -вц8 -вч8 -вш8 -вы1к8люч -вь8 -вґ8 -г8 -г'8 -г’8 -гб8 -гв8 -гг8 -гд8
+-гж8 -гз8 -гк8 -гл8 -гм8 -гн8 -гп8 -гр8 -гс8 -гт8 -гф8 -гх8 -гц8 -гч8 -гш8
+-гь8 -гґ8 -д8 -д'8 -д’8 -дб8 -дв8 -дву8х3 -дг8 -дд8 -дж8 -дз8 -дк8 -дл8 -дм8
+-дн8 -дп8 -др8 -дс8 -дт8 -дф8 -дх8 -дц8 -дч8 -дш8 -дґ8 -е8 -ж8 -ж'8 -ж’8 -жб8
+-жв8 -жг8 -жд8 -жж8 -жз8 -жк8 -жл8 -жм8 -жн8 -жп8 -жр8 -жс8 -жт8 -жф8 -жх8
+-жц8 -жч8 -жш8 -жґ8 -з8 -з'8 -з’8 -зб8 -зв8 -зг8 -зд8 -зж8 -зз8 -зк8 -зл8 -зм8
+-зн8 -зп8 -зр8 -зс8 -зт8 -зф8 -зх8 -зц8 -зч8 -зш8 -зь8 -зґ8 -й8 -к8 -к'8 -к’8
+-кб8 -кв8 -кг8 -кд8 -кж8 -кз8 -кк8 -кл8 -км8 -кн8 -кп8 -кр8 -кс8 -кт8 -кф8
+-кх8 -кц8 -кч8 -кш8 -кь8 -кґ8 -л8 -л'8 -л’8 -лб8 -лв8 -лг8 -лд8 -лж8 -лз8 -лк8
+-лл8 -лм8 -лн8 -лп8 -лр8 -лс8 -лт8 -лф8 -лх8 -лц8 -лч8 -лш8 -ль8 -лґ8 -м8 -м'8
+-м’8 -мб8 -мв8 -мг8 -мд8 -мж8 -мз8 -мк8 -мл8 -мм8 -мн8 -мп8 -мр8 -мс8 -мт8
+-мф8 -мх8 -мц8 -мч8 -мш8 -мь8 -мґ8 -н8 -н'8 -н’8 -на2д3б6 -на2д3в6 -на2д3г6
+-на2д3д6 -на8д7звычай -на8д7земн -на2д3к6 -на2д3л6 -на2д3м6 -на2д3н6 -на2д3п6
+-на2д3р6 -на2д3с6 -на2д3т6 -на2д3ф6 -на2д3х6 -на2д3ц6 -на2д3ч6 -на2д3ш6
+-на2д3ґ6 -нб8 -нв8 -нг8 -нд8 -не8 -нж8 -нз8 -нк8 -нл8 -нм8 -нн8 -нп8 -нр8 -нс8
+-нт8 -нф8 -нх8 -нц8 -нч8 -нш8 -нь8 -ня8 -нґ8 -о8 -п8 -п'8 -п’8 -па3г8лядз
+-па2д3б6 -па2д3в6 -па2д3г6 -па2д3д6 -па8д7жар -па8д7жыв -па8д7жыл -па8д7жыць
+-па8д7жыў -па8д7зав -па8д7загалова -па8д7загалоў -па8д7закус -па8д7закуш
+-па8д7зараб -па8д7зароб -па8д7земн -па8д7зор -па8д7зыва -па8д7зяме -па2д3к6
+-па2д3л6 -па2д3м6 -па2д3н6 -па2д3п6 -па2д3р6 -па2д3с6 -па2д3т6 -па2д3ф6
+-па2д3х6 -па2д3ц6 -па2д3ч6 -па2д3ш6 -па2д3ґ6 -пб8 -пв8 -пг8 -пд8 -пера2д3б6
+-пера2д3в6 -пера2д3г6 -пера2д3д6 -пера2д3к6 -пера2д3л6 -пера2д3м6 -пера2д3н6
+-пера2д3п6 -пера2д3р6 -пера2д3с6 -пера2д3т6 -пера2д3ф6 -пера2д3х6 -пера2д3ц6
+-пера2д3ч6 -пера2д3ш6 -пера2д3ґ6 -пж8 -пз8 -пк8 -пл8 -пм8 -пн8 -пп8 -пр8
+-пра2з3б6 -пра2з3в6 -пра2з3г6 -пра2з3д6 -пра2з3ж6 -пра2з3з6 -пра2з3к6
+-пра2з3л6 -пра2з3м6 -пра2з3н6 -пра2з3п6 -пра2з3р6 -пра2з3с6 -пра2з3т6
+-пра2з3ф6 -пра2з3х6 -пра2з3ц6 -пра2з3ч6 -пра2з3ш6 -пра2з3ґ6 -пс8 -пт8 -пф8
+-пх8 -пц8 -пч8 -пш8 -пь8 -пґ8 -р8 -р'8 -р’8 -ра2з3б6 -ра2з3в6 -ра2з3г6
+-ра2з3д6 -ра2з3ж6 -ра2з3з6 -ра2з3к6 -ра2з3л6 -ра2з3м6 -ра2з3н6 -ра2з3п6
+-ра2з3р6 -ра2з3с6 -ра2з3т6 -ра2з3ф6 -ра2з3х6 -ра2з3ц6 -ра2з3ч6 -ра2з3ш6
+-ра2з3ґ6 -ра2с3б6 -ра2с3в6 -ра2с3г6 -ра2с3д6 -ра2с3ж6 -ра2с3з6 -ра2с3к6
+-ра2с3л6 -ра2с3м6 -ра2с3н6 -ра2с3п6 -ра2с3р6 -ра2с3с6 -ра2с3т6 -ра2с3ф6
+-ра2с3х6 -ра2с3ц6 -ра2с3ч6 -ра2с3ш6 -ра2с3ґ6 -рб8 -рв8 -рг8 -рд8 -рж8 -рз8
+-рк8 -рл8 -рм8 -рн8 -ро2з3б6 -ро2з3в6 -ро2з3г6 -ро2з3д6 -ро2з3ж6 -ро2з3з6
+-ро2з3к6 -ро2з3л6 -ро2з3м6 -ро2з3н6 -ро2з3п6 -ро2з3р6 -ро2з3с6 -ро2з3т6
+-ро2з3ф6 -ро2з3х6 -ро2з3ц6 -ро2з3ч6 -ро2з3ш6 -ро2з3ґ6 -ро2с3б6 -ро2с3в6
+-ро2с3г6 -ро2с3д6 -ро2с3ж6 -ро2с3з6 -ро2с3к6 -ро2с3л6 -ро2с3м6 -ро2с3н6
+-ро2с3п6 -ро2с3р6 -ро2с3с6 -ро2с3т6 -ро2с3ф6 -ро2с3х6 -ро2с3ц6 -ро2с3ч6
+-ро2с3ш6 -ро2с3ґ6 -рп8 -рр8 -рс8 -рт8 -рф8 -рх8 -рц8 -рч8 -рш8 -рґ8 -с8 -с'8
+-с’8 -сб8 -св8 -сг8 -сд8 -сж8 -сз8 -ск8 -сл8 -см8 -сн8 -сп8 -сп'8 -сп’8 -ср8
+-сс8 -ст8 -сф8 -сх8 -сц8 -сч8 -сш8 -сь8 -сґ8 -т8 -т'8 -т’8 -тб8 -тв8 -тг8 -тд8
+-тж8 -тз8 -тк8 -тл8 -тм8 -тн8 -тп8 -тр8 -тро8х3 -тс8 -тт8 -тф8 -тх8 -тц8 -тч8
+-тш8 -тґ8 -у8 -уз3б6 -уз3в6 -уз3г6 -уз3д6 -уз3ж6 -уз3з6 -уз3к6 -уз3л6 -уз3м6
+-уз3н6 -уз3п6 -уз3р6 -уз3с6 -уз3т6 -уз3ф6 -уз3х6 -уз3ц6 -уз3ч6 -уз3ш6 -уз3ґ6
+-ус3б6 -ус3в6 -ус3г6 -ус3д6 -ус3ж6 -ус3з6 -ус3к6 -ус3л6 -ус3м6 -ус3н6 -ус3п6
+-ус3р6 -ус3с6 -ус3т6 -ус3ф6 -ус3х6 -ус3ц6 -ус3ч6 -ус3ш6 -ус3ґ6 -ф8 -ф'8 -ф’8
+-фб8 -фв8 -фг8 -фд8 -фж8 -фз8 -фк8 -фл8 -фм8 -фн8 -фп8 -фр8 -фс8 -фт8 -фф8
+-фх8 -фц8 -фч8 -фш8 -фь8 -фґ8 -х8 -х'8 -х’8 -хб8 -хв8 -хг8 -хд8 -хж8 -хз8 -хк8
+-хл8 -хм8 -хн8 -хп8 -хр8 -хс8 -хт8 -хф8 -хх8 -хц8 -хч8 -хш8 -хь8 -хґ8 -ц8 -ц'8
+-ц’8 -цб8 -цв8 -цг8 -цд8 -цера2з3б6 -цера2з3в6 -цера2з3г6 -цера2з3д6
+-цера2з3ж6 -цера2з3з6 -цера2з3к6 -цера2з3л6 -цера2з3м6 -цера2з3н6 -цера2з3п6
+-цера2з3р6 -цера2з3с6 -цера2з3т6 -цера2з3ф6 -цера2з3х6 -цера2з3ц6 -цера2з3ч6
+-цера2з3ш6 -цера2з3ґ6 -цера2с3б6 -цера2с3в6 -цера2с3г6 -цера2с3д6 -цера2с3ж6
+-цера2с3з6 -цера2с3к6 -цера2с3л6 -цера2с3м6 -цера2с3н6 -цера2с3п6 -цера2с3р6
+-цера2с3с6 -цера2с3т6 -цера2с3ф6 -цера2с3х6 -цера2с3ц6 -цера2с3ч6 -цера2с3ш6
+-цера2с3ґ6 -цж8 -цз8 -цк8 -цл8 -цм8 -цн8 -цп8 -цр8 -цс8 -цт8 -цф8 -цх8 -цц8
+-цч8 -цш8 -ць8 -цґ8 -ч8 -ч'8 -ч’8 -чатыро8х3 -чб8 -чв8 -чг8 -чд8 -чж8 -чз8
+-чк8 -чл8 -чм8 -чн8 -чп8 -чр8 -чс8 -чт8 -чф8 -чх8 -чц8 -чч8 -чш8 -чґ8 -ш8 -ш'8
+-ш’8 -шб8 -шв8 -шг8 -шд8 -шж8 -шз8 -шк8 -шл8 -шм8 -шма8т1 -шн8 -шп8 -шр8 -шс8
+-шт8 -шф8 -шх8 -шц8 -шч8 -шш8 -шґ8 -ы8 -э8 -ю8 -я8 -ё8 -і8 -ў8 -ўз3б6 -ўз3в6
+-ўз3г6 -ўз3д6 -ўз3ж6 -ўз3з6 -ўз3к6 -ўз3л6 -ўз3м6 -ўз3н6 -ўз3п6 -ўз3р6 -ўз3с6
+-ўз3т6 -ўз3ф6 -ўз3х6 -ўз3ц6 -ўз3ч6 -ўз3ш6 -ўз3ґ6 -ўс3б6 -ўс3в6 -ўс3г6 -ўс3д6
+-ўс3ж6 -ўс3з6 -ўс3к6 -ўс3л6 -ўс3м6 -ўс3н6 -ўс3п6 -ўс3р6 -ўс3с6 -ўс3т6 -ўс3ф6
+-ўс3х6 -ўс3ц6 -ўс3ч6 -ўс3ш6 -ўс3ґ6 -ґ8 -ґ'8 -ґ’8 -ґб8 -ґв8 -ґг8 -ґд8 -ґж8 -ґз8
+-ґк8 -ґл8 -ґм8 -ґн8 -ґп8 -ґр8 -ґс8 -ґт8 -ґф8 -ґх8 -ґц8 -ґч8 -ґш8 -ґь8 -ґґ8 а1
+8а. 8а- 8б. 6б'1 6б’1 8б- б5б 8бб. 8бб- б3в 8бв. 8бв- б3г 8бг. 8бг- б3д 8бд.
+8бд- б3ж 8бж. 8бж- б3з 8бз. 8бз- б3к 8бк. 8бк- б3л 8бл. 8бл- 8бль. 8бль- б3м
+8бм. 8бм- б3н 8бн. 8бн- б3п 8бп. 8бп- б3р 8бр. 8бр- б3с 8бс. 8бс- 8бск. 8бск-
+б3т 8бт. 8бт- б3ф 8бф. 8бф- б3х 8бх. 8бх- б3ц 8бц. 8бц- б3ч 8бч. 8бч- б3ш 8бш.
+8бш- 6бь1 8бь. 8бь- б3ґ 8бґ. 8бґ- 8в. 6в'1 6в’1 8в- в3б 8вб. 8вб- в5в 8вв.
+8вв- в3г 8вг. 8вг- в3д 8вд. 8вд- в3ж 8вж. 8вж- в3з 8вз. 8вз- в3к 8вк. 8вк- в3л
+8вл. 8вл- в3м 8вм. 8вм- в3н 8вн. 8вн- в3п 8вп. 8вп- в3р 8вр. 8вр- в3с 8вс.
+8вс- в3т 8вт. 8вт- в3ф 8вф. 8вф- в3х 8вх. 8вх- в3ц 8вц. 8вц- в3ч 8вч. 8вч- в3ш
+8вш. 8вш- 6вь1 8вь. 8вь- вё8рст віда1з8мен віда1з8мян в3ґ 8вґ. 8вґ- 8г. 6г'1
+6г’1 8г- г3б 8гб. 8гб- г3в 8гв. 8гв- г5г 8гг. 8гг- г3д 8гд. 8гд- г3ж 8гж. 8гж-
+г3з 8гз. 8гз- г3к 8гк. 8гк- г3л 8гл. 8гл- г3м 8гм. 8гм- г3н 8гн. 8гн- г3п 8гп.
+8гп- г3р 8гр. 8гр- г3с 8гс. 8гс- г3т 8гт. 8гт- г3ф 8гф. 8гф- г3х 8гх. 8гх- г3ц
+8гц. 8гц- г3ч 8гч. 8гч- г3ш 8гш. 8гш- 6гь1 8гь. 8гь- г3ґ 8гґ. 8гґ- 8д. 6д'1
+6д’1 8д- д3б 8дб. 8дб- д3в 8дв. 8дв- д3г 8дг. 8дг- д5д 8дд. 8дд- д2ж 8дж. 8дж-
+д2з 8дз. 8дз- 8дзь. 8дзь- д3к 8дк. 8дк- д3л 8дл. 8дл- д3м 8дм. 8дм- д3н 8дн.
+8дн- д3п 8дп. 8дп- д3р 8др. 8др- д3с 8дс. 8дс- д3т 8дт. 8дт- д3ф 8дф. 8дф- д3х
+8дх. 8дх- д3ц 8дц. 8дц- д3ч 8дч. 8дч- д3ш 8дш. 8дш- д3ґ 8дґ. 8дґ- е1 8е. 8е-
+8ж. 6ж'1 6ж’1 8ж- ж3б 8жб. 8жб- ж3в 8жв. 8жв- ж3г 8жг. 8жг- ж3д 8жд. 8жд-
+8ждж. 8ждж- ж5ж 8жж. 8жж- ж3з 8жз. 8жз- ж3к 8жк. 8жк- ж3л 8жл. 8жл- ж3м 8жм.
+8жм- ж3н 8жн. 8жн- ж3п 8жп. 8жп- ж3р 8жр. 8жр- ж3с 8жс. 8жс- ж3т 8жт. 8жт- ж3ф
+8жф. 8жф- ж3х 8жх. 8жх- ж3ц 8жц. 8жц- ж3ч 8жч. 8жч- ж3ш 8жш. 8жш- ж3ґ 8жґ.
+8жґ- 8з. 6з'1 6з’1 8з- за1п8люшч за3ц8вярдз з3б 8зб. 8зб- з3в 8зв. 8зв- з3г
+8зг. 8зг- з3д 8зд. 8зд- з3ж 8зж. 8зж- з5з 8зз. 8зз- з3к 8зк. 8зк- з3л 8зл.
+8зл- зло7ў8жыв з3м 8зм. 8зм- з3н 8зн. 8зн- з3п 8зп. 8зп- з3р 8зр. 8зр- з3с
+8зс. 8зс- з3т 8зт. 8зт- з3ф 8зф. 8зф- з3х 8зх. 8зх- з3ц 8зц. 8зц- з3ч 8зч.
+8зч- з3ш 8зш. 8зш- 6зь1 8зь. 8зь- з3ґ 8зґ. 8зґ- 6й1 8й. 8й- 8к. 6к'1 6к’1 8к-
+к3б 8кб. 8кб- к3в 8кв. 8кв- к3г 8кг. 8кг- к3д 8кд. 8кд- к3ж 8кж. 8кж- к3з 8кз.
+8кз- к5к 8кк. 8кк- к3л 8кл. 8кл- 8кль. 8кль- к3м 8км. 8км- к3н 8кн. 8кн- к3п
+8кп. 8кп- к3р 8кр. 8кр- крова3ў8твар к3с 8кс. 8кс- 8кст. 8кст- к3т 8кт. 8кт-
+8ктр. 8ктр- к3ф 8кф. 8кф- к3х 8кх. 8кх- к3ц 8кц. 8кц- к3ч 8кч. 8кч- к3ш 8кш.
+8кш- 6кь1 8кь. 8кь- к3ґ 8кґ. 8кґ- 8л. 6л'1 6л’1 8л- л3б 8лб. 8лб- л3в 8лв.
+8лв- л3г 8лг. 8лг- л3д 8лд. 8лд- л3ж 8лж. 8лж- л3з 8лз. 8лз- л3к 8лк. 8лк- л5л
+8лл. 8лл- л3м 8лм. 8лм- 8лмс. 8лмс- л3н 8лн. 8лн- л3п 8лп. 8лп- л3р 8лр. 8лр-
+л3с 8лс. 8лс- л3т 8лт. 8лт- л3ф 8лф. 8лф- л3х 8лх. 8лх- л3ц 8лц. 8лц- л3ч 8лч.
+8лч- л3ш 8лш. 8лш- 6ль1 8ль. 8ль- л3ґ 8лґ. 8лґ- 8м. 6м'1 6м’1 8м- м3б 8мб.
+8мб- 8мбр. 8мбр- м3в 8мв. 8мв- м3г 8мг. 8мг- м3д 8мд. 8мд- м3ж 8мж. 8мж- м3з
+8мз. 8мз- м3к 8мк. 8мк- м3л 8мл. 8мл- 8мль. 8мль- м5м 8мм. 8мм- м3н 8мн. 8мн-
+м3п 8мп. 8мп- м3р 8мр. 8мр- м3с 8мс. 8мс- 8мсь. 8мсь- м3т 8мт. 8мт- м3ф 8мф.
+8мф- м3х 8мх. 8мх- м3ц 8мц. 8мц- м3ч 8мч. 8мч- м3ш 8мш. 8мш- 6мь1 8мь. 8мь-
+м3ґ 8мґ. 8мґ- 8н. 6н'1 6н’1 8н- на5д8вор н3б 8нб. 8нб- н3в 8нв. 8нв- н3г 8нг.
+8нг- 8нгв. 8нгв- 8нгр. 8нгр- н3д 8нд. 8нд- 8ндз. 8ндз- 8ндр. 8ндр- н3ж 8нж.
+8нж- н3з 8нз. 8нз- н3к 8нк. 8нк- 8нкс. 8нкс- 8нкт. 8нкт- н3л 8нл. 8нл- н3м
+8нм. 8нм- н5н 8нн. 8нн- н3п 8нп. 8нп- н3р 8нр. 8нр- н3с 8нс. 8нс- 8нск. 8нск-
+н3т 8нт. 8нт- 8нтр. 8нтр- н3ф 8нф. 8нф- н3х 8нх. 8нх- н3ц 8нц. 8нц- н3ч 8нч.
+8нч- н3ш 8нш. 8нш- 6нь1 8нь. 8нь- н3ґ 8нґ. 8нґ- о1 8о. 8о- 8п. 6п'1 6п’1 8п-
+п3б 8пб. 8пб- п3в 8пв. 8пв- п3г 8пг. 8пг- п3д 8пд. 8пд- п3ж 8пж. 8пж- п3з 8пз.
+8пз- п3к 8пк. 8пк- п3л 8пл. 8пл- п3м 8пм. 8пм- п3н 8пн. 8пн- п5п 8пп. 8пп- п3р
+8пр. 8пр- п3с 8пс. 8пс- п3т 8пт. 8пт- п3ф 8пф. 8пф- п3х 8пх. 8пх- п3ц 8пц.
+8пц- п3ч 8пч. 8пч- п3ш 8пш. 8пш- 6пь1 8пь. 8пь- п3ґ 8пґ. 8пґ- 8р. 6р'1 6р’1
+8р- раз5г8лед раз5г8ляд р3б 8рб. 8рб- р3в 8рв. 8рв- р3г 8рг. 8рг- р3д 8рд.
+8рд- 8рдж. 8рдж- р3ж 8рж. 8рж- р3з 8рз. 8рз- 8рзь. 8рзь- р3к 8рк. 8рк- 8ркс.
+8ркс- 8ркт. 8ркт- р3л 8рл. 8рл- 8рль. 8рль- р3м 8рм. 8рм- р3н 8рн. 8рн- 8рнь.
+8рнь- р3п 8рп. 8рп- р5р 8рр. 8рр- р3с 8рс. 8рс- 8рск. 8рск- 8рст. 8рст- р3т
+8рт. 8рт- 8ртр. 8ртр- р3ф 8рф. 8рф- р3х 8рх. 8рх- р3ц 8рц. 8рц- 8рць. 8рць-
+р3ч 8рч. 8рч- р3ш 8рш. 8рш- 8ршч. 8ршч- р3ґ 8рґ. 8рґ- 8с. 6с'1 6с’1 8с- с3б
+8сб. 8сб- с3в 8св. 8св- с3г 8сг. 8сг- с3д 8сд. 8сд- с3ж 8сж. 8сж- с3з 8сз.
+8сз- с3к 8ск. 8ск- с3л 8сл. 8сл- слова7ў8твар 8сль. 8сль- с3м 8см. 8см- с3н
+8сн. 8сн- 8снь. 8снь- с3п 8сп. 8сп- с3р 8ср. 8ср- с5с 8сс. 8сс- с3т 8ст. 8ст-
+8стл. 8стл- 8стр. 8стр- с3ф 8сф. 8сф- с3х 8сх. 8сх- с3ц 8сц. 8сц- 8сць. 8сць-
+с3ч 8сч. 8сч- с3ш 8сш. 8сш- 6сь1 8сь. 8сь- с3ґ 8сґ. 8сґ- 8т. 6т'1 6т’1 8т- т3б
+8тб. 8тб- т3в 8тв. 8тв- т3г 8тг. 8тг- т3д 8тд. 8тд- т3ж 8тж. 8тж- т3з 8тз.
+8тз- т3к 8тк. 8тк- т3л 8тл. 8тл- т3м 8тм. 8тм- т3н 8тн. 8тн- т3п 8тп. 8тп- т3р
+8тр. 8тр- т3с 8тс. 8тс- т5т 8тт. 8тт- т3ф 8тф. 8тф- т3х 8тх. 8тх- т3ц 8тц.
+8тц- т3ч 8тч. 8тч- т3ш 8тш. 8тш- тэ8мбр. тэ8мбр- т3ґ 8тґ. 8тґ- у1 8у. 8у- 8ф.
+6ф'1 6ф’1 8ф- ф3б 8фб. 8фб- ф3в 8фв. 8фв- ф3г 8фг. 8фг- ф3д 8фд. 8фд- ф3ж 8фж.
+8фж- ф3з 8фз. 8фз- ф3к 8фк. 8фк- ф3л 8фл. 8фл- ф3м 8фм. 8фм- ф3н 8фн. 8фн- ф3п
+8фп. 8фп- ф3р 8фр. 8фр- ф3с 8фс. 8фс- ф3т 8фт. 8фт- ф5ф 8фф. 8фф- ф3х 8фх.
+8фх- ф3ц 8фц. 8фц- 8фць. 8фць- ф3ч 8фч. 8фч- ф3ш 8фш. 8фш- 6фь1 8фь. 8фь- ф3ґ
+8фґ. 8фґ- 8х. 6х'1 6х’1 8х- х3б 8хб. 8хб- х3в 8хв. 8хв- х3г 8хг. 8хг- х3д 8хд.
+8хд- х3ж 8хж. 8хж- х3з 8хз. 8хз- х3к 8хк. 8хк- х3л 8хл. 8хл- х3м 8хм. 8хм- х3н
+8хн. 8хн- х3п 8хп. 8хп- х3р 8хр. 8хр- х3с 8хс. 8хс- х3т 8хт. 8хт- х3ф 8хф.
+8хф- х5х 8хх. 8хх- х3ц 8хц. 8хц- х3ч 8хч. 8хч- х3ш 8хш. 8хш- 6хь1 8хь. 8хь-
+х3ґ 8хґ. 8хґ- 8ц. 6ц'1 6ц’1 8ц- ц3б 8цб. 8цб- ц3в 8цв. 8цв- ц3г 8цг. 8цг- ц3д
+8цд. 8цд- ц3ж 8цж. 8цж- ц3з 8цз. 8цз- ц3к 8цк. 8цк- ц3л 8цл. 8цл- ц3м 8цм.
+8цм- ц3н 8цн. 8цн- ц3п 8цп. 8цп- ц3р 8цр. 8цр- ц3с 8цс. 8цс- ц3т 8цт. 8цт- ц3ф
+8цф. 8цф- ц3х 8цх. 8цх- ц5ц 8цц. 8цц- ц3ч 8цч. 8цч- ц3ш 8цш. 8цш- 6ць1 8ць.
+8ць- ц3ґ 8цґ. 8цґ- 8ч. 6ч'1 6ч’1 8ч- ч3б 8чб. 8чб- ч3в 8чв. 8чв- ч3г 8чг. 8чг-
+ч3д 8чд. 8чд- ч3ж 8чж. 8чж- ч3з 8чз. 8чз- ч3к 8чк. 8чк- ч3л 8чл. 8чл- ч3м 8чм.
+8чм- ч3н 8чн. 8чн- ч3п 8чп. 8чп- ч3р 8чр. 8чр- ч3с 8чс. 8чс- ч3т 8чт. 8чт- ч3ф
+8чф. 8чф- ч3х 8чх. 8чх- ч3ц 8чц. 8чц- ч5ч 8чч. 8чч- ч3ш 8чш. 8чш- ч3ґ 8чґ.
+8чґ- 8ш. 6ш'1 6ш’1 8ш- ш3б 8шб. 8шб- ш3в 8шв. 8шв- ш3г 8шг. 8шг- ш3д 8шд. 8шд-
+ш3ж 8шж. 8шж- ш3з 8шз. 8шз- ш3к 8шк. 8шк- ш3л 8шл. 8шл- ш3м 8шм. 8шм- ш3н 8шн.
+8шн- ш3п 8шп. 8шп- ш3р 8шр. 8шр- ш3с 8шс. 8шс- ш3т 8шт. 8шт- ш3ф 8шф. 8шф- ш3х
+8шх. 8шх- ш3ц 8шц. 8шц- ш3ч 8шч. 8шч- ш5ш 8шш. 8шш- ш3ґ 8шґ. 8шґ- ы1 8ы. 8ы-
+э1 8э. 8э- ю1 8ю. 8ю- я1 8я. 8я- ё1 8ё. 8ё- і1 8і. 8і- 6ў1 8ў. 8ў- 8ґ. 6ґ'1
+6ґ’1 8ґ- ґ3б 8ґб. 8ґб- ґ3в 8ґв. 8ґв- ґ3г 8ґг. 8ґг- ґ3д 8ґд. 8ґд- ґ3ж 8ґж. 8ґж-
+ґ3з 8ґз. 8ґз- ґ3к 8ґк. 8ґк- ґ3л 8ґл. 8ґл- ґ3м 8ґм. 8ґм- ґ3н 8ґн. 8ґн- ґ3п 8ґп.
+8ґп- ґ3р 8ґр. 8ґр- ґ3с 8ґс. 8ґс- ґ3т 8ґт. 8ґт- ґ3ф 8ґф. 8ґф- ґ3х 8ґх. 8ґх- ґ3ц
+8ґц. 8ґц- ґ3ч 8ґч. 8ґч- ґ3ш 8ґш. 8ґш- 6ґь1 8ґь. 8ґь- ґ5ґ 8ґґ. 8ґґ-
+}
+`
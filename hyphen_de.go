@@ -0,0 +1,2094 @@
+package main
+
+// hyphenDE содержит шаблоны переноса для немецкого языка (орфография 1996 года) из проекта hyph-utf8
+// (hyph-de-1996). Авторы шаблонов и условия их распространения указаны в
+// исходном файле: https://github.com/hyphenation/tex-hyphen.
+const hyphenDE = `\patterns{
+.ab1a .abi4t .ab3l .abo2 .ab3ol .ab1or .ab3s2 .ab3u .ade3n .ae3 .aft2 .ag4n
+.ag4r .ag2u .ai2s .akt2a .al2e .al3k .al3lei .al5len .al3se .al4tan .al4tei
+.alter6s5 .alt3s4 .al2tu .ampe4 .amt2s1 .amt4sc .ana1c .an3d2 .anden6k .and4ri
+.an1er .ang2 .an3g4li .angs4 .angst3 .ani2s .an3k4 .an3na .an3s2 .an4si.
+.an3z2 .ap5p6le. .aps2 .ari1e .ar3k2a .ar4m3ac .ar4mun .ar2sc .ar4tan .ar4t3ei
+.arter4 .ar6t5erh .ar2tr .arz2 .asbe2 .as4ta .as3tr .ata1 .ate2 .at4h .at4r
+.au3d .aue2 .au4f3 .aufs4 .au2s3 .auss2 .auß2 .ax2 .bahn3 .bah6ner .baus4
+.be3erb .bel2a .be3r4a .be3r2e .ber4g3a .ber6g5e6b .ber4g3r .ber4tr .bi4os
+.bi2t .bit1a .boge2 .bogen3 .bogens6 .bo4s3k .bu4ser .by4t .ca2s3t .ch4
+.char8mes .chi3er .dab4 .da2r1 .dar3in .darm1 .da4te. .da4tes .de2al .de1i
+.dein2 .de3lo .de8ments .den4ka .den4kl .den4ko .de1o2 .de3r4en .de1s .de3sk
+.des2t .di3el .di4en .dien4e .dien6st .dienst7a8d .do3b .do2mo .don4a .do1pe
+.dor2f1 .dy2s3 .ebe2r1 .edu3s .eg2o .eh2e .ehe1i .ei3e2 .ei3f2e .ei3k .ein3d
+.eine2 .ei4neb .ein6erl .eise4 .ei2sp .eis3s4 .ei2s3t .ei4tr .eke2 .ek3li
+.el2a .el2bi .el2bl .elb3s .el4fei .el2fl .el2i .el6st .em3m2 .en1 .en4da
+.en4d3er4 .en2d3r .end3s .en4dü .en2gl .enn2 .ent3 .en2ta .en4tei .en4tio
+.en4t1r .en5trop .ents4 .er4bei .er8brecht .er2bu .er4dan .er4dei .erden6k
+.er4dep .er4d3er .er1e .ere3c .erf4 .er1i .ers2 .er8stein .erster6 .er8stritt.
+.er8stritten. .er4z3el .er4zen4 .ese3le .es3p .es3ta .est6e .es3th .es3t3r
+.et2s .eu1 .eu3g4 .eu3r4 .eu3t .ext4 .fe3la .fer4no .fi3est .fi4le. .fi4len
+.fi2s .flug1 .for2t .fs4 .fu2sc .ga2me .ga4s1 .gas3e .ga4t .gd2 .gebe4a .geb2l
+.gee4 .gel4b3r .gel2d1 .ge3lu .ge5nar .ge3n4e .gene7cke .ge3n2o .ge3r4a
+.ge3r2e .ge3ro .ger4s .ge3s4a .ge3u .glan2 .gol6der .gs4 .halt4e .hau2t1 .he2
+.he4bei .he3fe .he3le .her3an .he3rat .her6b5ra .he3rer .he3ri .he6r5inn
+.hin3u .hips4 .hi4s .hof1e .ho4fen .ho4met .ho4st .ia4 .im2a .ima4ge .im5m2
+.in1 .ind4 .in3gl .ink2 .in3n2e .in3sk .inu1 .ioni1 .ire3 .is2a .is3t .it2h
+.iv2 .ivo3 .joni1 .jor3 .ka2b3l .ka2i .ka3le .ka3ta .ka4t3io .ki4e .klang3
+.ko3b .kopf1 .kor4da .kraf2 .ks4 .kus2 .la3be .la3ho .lase2 .le4ar .le4gas
+.le3n2i .len3z .lich8t7er8s .li2f .li4ve. .lo4g3in .lo2sc .los3s4 .lo3ver
+.luster6 .lus4tr .lut4h .ma3d .ma3ge .mal4e .ma2st .mat4c .matu3 .md2 .me3l2a
+.me3ne .me3no .men8schl .men8schw .ment4 .mes4sp .mi2f .mik4 .mil2z .mi2st
+.mi4t1 .mm2 .mutter5 .na3no .na3t .nat2h .nebe4n .ner2f .ne1ro .ne2s .nich2
+.nicht5e .ni4e .ni3k4l .no4th .nus4 .näs1c .oa3s .ob1a .obe2 .ober5ei .of2e
+.oper4 .or2a .ord4e .ort2 .ort4h .orts3e .os3s .os4tal .os5t6alg .oste2
+.os4t3el .ost5end .os8ten8de .oste6re .os8terwe .os4t3r .os2tu .ot1 .ozo4
+.pab4 .pa2r1e .par3t4h .pe2c .pe3la .pe3le .pf4 .ph4 .poka2 .po4st .pro1 .ps2
+.rabe4 .ra3ch4e .ra3me .ra3sa .rau2m .rau8schl .re3ale .reb3s2 .re3cha
+.re5insz .reis6e5i .rei4st .reli1 .res6tr .ri4as .richt6e .ro4a .ro3be .ro2h
+.ro3m4a .ro2tr .ruf3s .ruh2r1 .runder6 .räu3sc .rö2s .rü1b .rü6cker6 .sa3br
+.sali3e .sami1 .sau1c .sau5er. .sch4 .schaf8t7end .scheiner8 .se3ck .se2ei
+.se2ha .sen4f .sen3s .se3re .se1ro .se2t1 .sha2 .si3gn .si4te .ski1e .skis2
+.sour2 .spani7er. .spiege8lei .st4 .ste2i .steiner8k .sto4re .sucher6 .tage4s
+.tan4k3a .tan4k3l .ta3ra .tar3t .ta2t3h .ta2to .ta4tor .ta2t1u .te2e .te2f
+.tehe3 .teiler8s .tei8l7ersc .te3l .te3no .ten3s .te1ra .te2s .te4st .tester8g
+.tester8h .test3r .th4 .ti2e .ti2me .ti4mes .ti2s .ti8sch7end .tite4 .tode4
+.to4der .todes5 .to2n .to4nat .to4nin .to4pl .to2pr .to2w .tri3es .tro2s .ts4
+.tsa3 .tse3 .tu3ra .tu3ri .turm1 .tur4ma .ub2 .ufe2 .ufer1 .ul2b3 .um3 .uma2
+.ume2 .umo2 .un3a2 .un3d .une2 .un3g .uni4t .un3s .uns4t .ur3a2d .uran6fa
+.ur1c .ur1e .ur4inf .ur3o4m .ur1o2p .ur3s2 .ut2a .ut3r .ve5n2e .voll1 .vo4r
+.wah4l .wa2s .weg5s .wei4ta .welter8e .welter8kl .wer6ker .wer4kr .wer4tr
+.wetterer8s .wi4e .wor2 .wort5en6 .wor8tend .wor4tu .wur2f1 .xe3 .ya4l .zahn3
+.zel4la .zelle4 .zel6leb .zeug4i .zi2e .zie4l3u .zin4ka .zin4s3c .zin4st
+.zuch2 .zucht3 .zug3l .zu4gra .zu2pf .zwe2 .zweigen8 .zwei8g7end .äm3 .är6schl
+.ät2h .ät2s .öd2 .öl3l .übe4 a1ab aa2be aa1c a1a2ce aa2gr a1akt aals2t a1a2n
+a2ans a1aq 2a2ar aa2r3a aar3b aar3d aa3rea aa2rei aarf4 aar3g2 aar3k4 aar3t4
+1aas aata2 aa2th aa4t3r aat4s3 2a3au a1b 2aba ab1alt ab2am ab2ant ab1au 2abbat
+2abbin 1abd 4a3be. 4a3bec abe1e ab1eic abe3i4d ab1eil ab1ein 4ab2el abe2la2
+abe4l3in 1abent 2aber a2berd a3beri ab1er2k ab1er2r ab1er2z 4abes abe2s1e
+ab3esse 2ab2et 2abew 1abf 1abg 3abga 1abh 2abi 4abil ab1ins ab1ir abi3s4t
+3ab1it 1abk ab1l 1a2bla a4blag a3blat a4blau 3ab3lei 2ablet ab3li a2blin
+ab4lit 2ablo 2ablu 1a2blä 1a2blö 1abn 2abo 3a2bo. ab2of 3a2bon 4abot ab3r
+a2bre 2abro ab4ros a4brä 2abrö 1ab5sc 1ab3s2p abst2 ab3sz 1abtei abte2s 3abtr
+2abu a2bum ab1ur 1abw 2aby aby4t 3abz ab1ä ab2är ab2äu 2abö 2abü 2a3ca ac1c
+a1cem a1cen a2ceo ach1a a1chal a3chari ach3as ach3au 2achb a1che a2ch1e4c
+ach1ei ach4ei. a2chep a4cherf ach5erfa a4ch3erh a4ch3erl a4ch3erw a4cherö
+2achf 2a1chi a2chim ach3l 2ach3m ach3n a1cho a3cho. ach1ob a2cho2r 2ach3r
+2achsc achs4el ach3s4i ach3skr achs4or ach3su a4cht ach4tak ach8tersp
+ach6t5erw ach4t1o ach8traum ach6trit ach8träume. ach8träumen. acht6sal ach4tum
+a1chu ach1u2f 2achv 4ach1w a2chy ach3ö ach3ü 2a1ci 4ack. a1ckar a3ckel a2ckin
+ack2sp acksta4 2a1cl acon4n 2acu a1d 2ad. 2ada. 4adab a2dac a2dad ad2ag ad1ama
+a2d1an 3adap 4a3d2a2r3 2adat a2d1au a3dau. ad1c 1add 2ade. ade2al a3dec a3dee
+adefi2 2adeg 4aden ade1ra 4ade1s ade3s2p ades4s 2adf 4adh 4adi adi3en adi3er.
+adie4sc adi4st 3adj 2adli 3admi 4admu ad2ob 1a2dop 2adp 2adq 2ad3rec ad3rei
+ad3run 2ads2 ad3st ad3sz 2ad2t1 ad4te2 adt3h 1adv 1a2dä 2a1e1 ae2b a2ec ae2ck
+ae2d ae2i a2ek a3el. a2ela a2ele a2eli a3els ae2m ae2o3 ae2p a3er. 3a2er2o1
+aes2a ae2sc aes5t a2ew ae2x 2afa af1ab a2f1a2n a3far a2f1au 2afe a2f1ec
+a4fentl a4f1ep aff4a af2f3l af4flu aff2s 2afi afi2e1i afi6kanz afi4kat afi2t
+2af3l a1flu 2afo a2f3oc a2ford afo1s 2afra af3rau af3re 2afro af3rä af3rö
+af4rü af3s2a af3s2h af2si af2sp afs4t af2t1a af2tei af2te2l aft4erk af2t1o
+aft3r af2tra af4t5re aft4stä af2tur af2tö a2f3ur 2afä a2f1än 2afü afür3 a1g
+2ag. 2aga ag1a2b ag1a2d ag1ar a2g1au agd1 ag2del ag2di ag2dr ag2du 4age. age1i
+agein4s age4ler ag2em 4a3gen. age4neb a2gent 2ages age4sam age4s3i age2s3p
+ages6sen age4s3ti 3aggr a2g1id a2gim 2a2g1l ag4lan ag4las ag3le a4glö 2agm
+ag2n ag4nat ag4ne. ag4nu a4gnä ag3rat a2g3re a2g3ri ag4ro 2ags ag3s2ah ag4sam
+ag3s4eid ags8porta ags4tan ag1ste ag3stö 2agt ag2th 2agu a2gund 2ah. a1ha
+ah2an ah4at 2a1he ahe1in a2h1er2h ahe1s ahe1u a1h2i ahin3 ah2l3a2 ah4l1ei
+ahle4na ah4l3erd ah4l3erh ahl1o2 ahl3sz ah2l1ä ah2lö ahme1i ahme3s ah3mu
+ah4n3a ah3nee ahn3el ah4nerd ahner4e ahner6le ahner4n ah2nin ah2no 1a2hor
+ah1os 4ahr ahr1a ah3r2e ahren6sc ahre4s ah3ri ahr6tage ahr6teng ahr2ti ahr4tri
+ahr4tro ahr4tun ah2ta ah2te2l ah2t1ex ah4t5r aht3s6 a1hu ah1w a1hy a1hä a2h3ö
+2ai. ai3a4 a1ia. 2aib ai2bl aid2s ai1e4 ai3en3 aif4 ai1fr ai3g4 a3ik. ai3ke
+ai2lar ail3d4 ai2lei ail3g ai2lo 4ain ain2a a1ind ai5n4e ain3s ains2p 3airb
+ai2sa a3isch. ai5schw ai3s2e ait4 a3iv. a3ivl a3ivs a1j a2jat aje2 ajekt4o
+2ak. a3ka. 2aka3b4 a2ka3d2 2akal 2a3kam 2akar ak4at aka4tak 1akaz 2akb 2akc
+2akd 2a1ke a2kef a2k1em a2k1ent a2kes a2keu 4a1ki ak1ins aki1s 1akku 2ak3l
+ak4li a1kna 2ako 2a1kr 4akra ak4ri 2aks ak3sh ak2t1a2b 2aktb ak2tel akt2er
+2aktik 2aktis 2aktm ak2t3r akt4ri 2aktsi 2aktsp 2aktst 2aktw ak2tö a1ku 2akun
+a2kup 2akur aku2s 1akz 3akze 4akä 4a3kü a1la 2ala. 4alabo al2abr al1af al1age
+2alai al3akr al1am al1ana 4aland a2lang al1anz al1app a3lar. al3arc a3lare
+al2arm 2al3arr a2lart ala2s al1asi al1ass ala2t1a al4atm alat3z al1au al3aug
+3albat alber4e al4berh al4b3er4w al2b1l al2boh alb3ru alb3s al2bär al4d3erl
+al4d3ern alde2s ald3inn al2dr alds2 al2dä 2ale 4a3le. ale4ar al1eb ale2be
+al1ec a4l3ef a2l1ei a3l2eic a4l3ein a2l1el 3a2lema alen1 4a3len. 3alenc
+alende4 al3endr a4l3ends a2leng al2enn a3lentf ale2p al1epo 4aler. a2l1erb
+a2l1erf a2l1erh aler4kl a2l3erl al1erm aler4mi a2l1er4r a2l1ert 3a4l3erwä
+4ales a2l1e4sk a2less a2l1eu al3exi alf4r 2alg. 3algi al2gli al3glo 1algo
+3algor 2ali al2imb al1imm ali4nal al1ind a2l1inq al1ins alken1 al2klö al2kne
+1alkoh alk3s alks4t al2lab al2l1an al2l1ap al2l1a2r al2l1au al3le. al4lec
+3allee al3lend all3erk al3les al2lid alli5er. alli7ers. al2lob al2lop al2löf
+al2map al4m3ast almo6de. a2l1ob 3aloe a2lof 4alog alo2ga alo2gr al1ont al1ort
+3alp. 3alpe. 1alph al2pho alrat4 al3sak al6schei alsch3s al3ska al5s6terb
+al2stu al2sum al2t1ak al3tam alt1an al4temu al4t3er5f al2teu al2tin alt1op
+al4t3rat al2tre al4t3ri al2t3ro alt4stü 2altu 1altä al2tö a1lu alu3b4 al2u3f
+alu3g al1u2k a2lum al1umb al1ur a3lus 4aly al2zar al2zau alz4erk al2zw a1lä
+a2l1äm al1än al1äu a2l1ö al2ös 2am. am2a ama3d2 ama3g a2malg 2a3m4an a2m3ap
+3a2maz 4ame. a2meb 2amel am4e4n1 amen6spr ame3r2a a2m1erf a2meri ame5r2u ame1s
+a4mesh a3met 2amf am4ing 2amir 2amis 2amit 2amk 2aml 2amm. 2ammal am4mant
+am2mar am2mei am2mid ammi2e am2min am2mor ammu2 am2mö amni1 2ampe. 2ampen
+am4pf amp2f1a2 ampf1o 2am2ple 2ampo am3pr 2am2s am3sa 4amsc am4schl 3amse
+am3s2h am3so am3sp am3su 1amt. am2t1a2 am4tau am2tei amt3eig am4tel am4t3ern
+am2t1ex am4tis am2tit am2to4 am6tou am2t3r am6tre am4tri am2t1u am2t1ä am2tö
+2amu 3a2mul 2amä a2mö 2ana. 2anab ana3c 4anad anadi1 an2ag 2a3nak an1alg
+ana4lin an4a3ma 2anan an4and 2ana1s a5nat. ana4th a5n4atm ana4tr an3aug 1anb
+2anbas 2anbu an3ch 2and. 3an3d2ac and3arm and3ei anden6ga an4d3ent and5erob
+ande2s an2d1ex and4sas and2so and6spar and6spas and2su 4andu2 an2d1ur andy1
+2ane 4ane. an3e2c a3nee an2ei. an3eif 3aneig a4neis 3a2n1e4k ane2mi 4anen
+aner4fa an2erh a4nerke 4anern a4nerz. an4erze an1eth 1anf 2anf. 2anfab an3fe
+2anfi an4fj anf3le 4anfors anf5rau 2anfs an3f2u 3anfä 2ang. 1angab an2gan
+an2g1ar 2ange. 1angeb 1angeh an2g1ei an4g3erf an4g3er4w an4g3erz 2angh 2angie
+ang1l an2gla ang3n ang1r ang3ra 1an3gri 2angs. angt4 1anh 2a3n2i ani3d ani3els
+ani5ers. ani3g2 ani3ke 3a4nim a4n3ind a4n3ins ani2o an3i4on a4niso 2anj 2ank.
+an2kab an2k1ak an2kan an2kei 2anken ank5erfa 2anki an2klu an2klö ank3no
+an4k3opf an2ko4r ank1r ank3ra an4kras an2kro ank3rä 2anks ank3se anks2p 2ankt
+1anl 2anlad 3anlag 2anmo 1anmu 2ann. 1annah an2nar an3ne an4nef annen3s4 2anns
+ann4s3p 2annt 2ano. ano3b an1od 2anof 2anog anoi3 a3nol ano2la 1a2nom a3nom.
+a2n1or 2a3nos 2anpu 1anr 2anrö an3sar 3anschr an3skr ans1pa ans3pon 1anspr
+1anst ans4tr an3s2z 1an3s2ä 2ant. an2t3ar anta4re 3antei an3tha 2antie 3antise
+2anto anton2 1antr ant3rin 1antw 1antá an3t2ä 2anu an4ut 1anw 2anwi 2anzb
+2anzd 1anzei anze4n 2anzg 2anzh an2zid an2z1i4n 2anzk 2anzm 2anzr 2anzs 2anzt
+2anzv 2anzw an2zwa an2zwi 2anzy an2zä 1anzü 3anzün 1an1äs 2a1nö a1nü 2ao aof4
+ao3i a1op aopf4 a1or a1os5 aost2 a3ot. aot4r ao3t2s a1p 4ap. 2apa a2pe. a3pel
+a2pf ap2fa 1apfel 2apfes a3pfl a2pht 2api 2ap3l ap4la ap4lo ap4lä ap2n a2pot
+ap3pu 2apr 4apro aps4ter ap5t2 2a3pu a2pé 2ar. a1ra a3ra. ar2ab 2ar3abb ar3abf
+ar3abt ara3d2 ar3adr ara3ge a2r3al a3r4ale a3rali a3ralo 2aran a2r1ang a2r1anz
+2arap a2r3app 2arar a3ras ara2st a2r1au 1arb 2arb. 2arba ar2bak ar2b3at ar2bau
+4arbef ar4b3ein 2arbek 2arben 2arber arb3erl 4arbi 2ar2bl 2arbo 2arb1r ar2b3re
+2arbs2 arb3sk arb3so 2arb3t4 2arbu 1ar1c 2archl 2archr ar2dau arde2l ar2dob
+ar2dop ar2d3r ar2du a2rea are5aler a2reb4 aree2 ar1eff ar1ehr ar1eid a3reih
+areim3 a2rein arein4b arein4s arein4t a2rele 4arem 4a5ren. a5reni aren6sem
+are3r2a arer2e a4r3erei a2rerg a2r1er3h a2reri a2rerk a2rerl ar2erw are3u
+arf1r arf3ra arf2sp 4arg. ar3gan ar2gl ar2gn 2arg4o ar3g4r 2arh 2ari ar2ia
+a2rid ari3e2n ari3erd ari3erg ar3inf arin3it arin3s4 ar1int a3rio ar2ir ar4is
+ari2su a3riu ar2kal ar2k1ar ark3aue ar2kil 2ark3l ar4klag ar2kle ar2klo ark4lö
+ar2kor ark3s4a ark2se ark3she ark6tre ar2les ar3mad ar2m1au 3armee ar2m1eg
+ar2m1ei ar4merk arm2or ar2mum ar3m2ä 4armü ar2nan arn2el ar3ni ar4nin a1ro
+4aroc aro8ckeng ar1o2d ar1of aro2fe a3rol aro3m aron2 a2r1o2p a2ror aros3 2arp
+arp3fe 2arr ar2r1ad ar2r3as arre4n1 ar2r3or 2arsa ar3se ar3s2h 2ar3s2i ar2tau
+2artb ar3t2e 2artei arter6la ar2the art3ho ar3t2i 2arto art3r art4res 2arts
+art3ske 2artuc 2aru a2r1uh ar1um a3rumm 2arv arwa2 2ary ar2zau 2arze 2arzi
+1arzt arz4tei arz4tem arz2t3r 2arzu ar2z1w ar2zä ar2zö a1rä a2r1ö a2rü 2asa
+a4s3aa a2s3af a3sag as2al as1am as3art asa2s as3at asau4f a2s3aug a6sca
+a4schec a4schef a4sch3ei a6scherg a3s4chi a2schm 2ascht a3schu a4schum 4a3se
+a4seb a4sec a4s1ef as1eie as1emi a5sen. ase4na ase4n3o as2er a4s3erke as4es
+ase4t a4sex a3s2hi as3hir a2s3i2k 2asim asin2g as1inn 2asis aska3s as3ob as1of
+a5sol a5som as1o2p as1or a4soz a2s1p aspek6to as2ph a3s2pi as3pio a4spir
+as2pra 2as3sa ass2ab ass6aus. ass2e ass3ein asse3le as3s2i as3ski as3so as2spo
+as2spr as2st as3sta as3stei as3sti as3str as3stu 2as3ta a1stas as4tati as4tau
+as3te ast2el ast2er as4t3ese as4tex a4s2th as3tie as3til as3to as4tof ast3orc
+a1str ast3re as3t4ren ast5roll as3tub a3stä 2asu as2ur a3sus aswa2s 2asy.
+3asyl a2sä as3ät a2sö a2sü 2a1t 4ata at1abe at1abr at2a1f a5t2a3g at1akt ata3l
+a3tam at1apf at2asc at3att a2t1au a3tau. at2ax 4atb at2c a2teb a3tec ateien6d
+at1eig 3a2teli 3a2temg at2en ate4na atens4e a2tep ate3r4al ate3ran atern2
+ater3st ate2ru 4ates ates4sa a3tet at2eu a2tew at2ex at3hag a3t4heb a2th3in
+3athl a4thr at2hu 4a3ti ati3ka ati4kab ati6k5erw a4tinf ation4 at2is ati2sa
+ati2se atis3s 3atla 4atli 3atm 4atma 4atmus 4atmä ato4man ato4men 3atomk
+ato2mo at1ort ato3s atra4t a2trau at3re 4atri at3rin a2t3rom at4ron at3rot
+a2t3rä at3rü at2sa at4schn at2se at2si at2s1o at2s1p ats3tät at3ta 3attac
+at4tad at4t1ak at4tang at4tar at4tau 4atte. at2t3ec at2tei att2el at5ter
+at3thä 4atto at2t3rä att3s4 at3t2u at2tä a3tub atu2n atze4l atz3ela atz3elt
+at2z1er a3tzere at2z1in at2zo atz3t4 at2z1w at1än a3tü a2u 2au. 2au3a2 2aub
+au2bab au2ban au2bau aube4n au2beu au2bli au2blo au2blu au2blä aub2si 4auc
+aude4r3i au2dr 2aue aue2b au2ere aue3rei au5erein au5erst. au3ert auer3ö aue2s
+au2fa auf1an 2aufe. 2aufeh 4aufen. 3aufent auf1er au4ferk au2feu auff4 auf3ind
+1aufla 1aufn 2aufo auf3ski auf3t4 2auft. 2aug aug2ar 4augeb 4augeh 4augel
+aug2er 4augl 4augr au3gu au3h 2au1i au3in au2is 2auj auk3t aule2s aul4les
+au3lü 4aum au2mal au4m3ent au2m1e2r1 aum3eri au2mid au2mil aum1o au2mor aum3p2
+aum3s6 au4mun 4aun au3n2a aun2e au4nei au2nio au2no au3nu a4unz au1o 2aup2
+aup4ter 2aur2 au3ra au1rh aurü3 au2s1ah ausan8ne. au2sau 2ausc au6schmi 1ausd
+2ause. au4s1eh 2ausen au4s3erb au4s3erf au4s3erk aus3erp au4serw 1ausf 1ausg
+au2sin au2sis 1ausl au2so aus1or au2spr 1ausr 3aussag aus4se. aus3s4t aust2a
+2auste au5stein aust2o 1ausw 1ausz 3ausü 2aut. 2autb 2aute au4t1e2l au4ten4g
+au4t3erh 2autg 1auto au4trö 2auts2 au2t1äu 2auu 2auv auve4 2auw 2aux 2auz
+au3ze auz2w auße2 a1v av2a a3vang avas4 ava3t2 avener4 2avi a2vr 2a1w awi3e
+a1x ax2am a2xans ax2e a3xid a2xio ay1 2a1ya ay2al ay2as a1yeu ayma4 ays2 aysi1
+ay3t ay2u 2a1z a3z4a aza3d 3a4zal az2i az2o3 a3z2u az2zen az2z1in az2zw a1ß
+aße4 aßen3 a1ä a1ç 2a1ö2 2a1ü 3ba. b3a2ba 2babf 2babg ba2bl ba2br 2b1abs
+bach7t4e ba4ck3er back3s4 ba3d2e bade1i 2b1adel 2b1adl 2b1adm b1a2dr ba2du
+2b1af bah6nene bais2 b2ak ba2ka ba2k1er ba2k1i bak1l bak3r ba2kra ba2kre
+ba2lab ba2l1ak ba3lal ba2lau ba4l3erk balk4a balke4 bal4l3eh bal4l3ei baller6e
+bal6ler6g ball6erk bal3ti 2b1am b2ama ba2me ban2a 3b2and band1a ban4dal
+ban4dan ban4dar ban6deng ban2dr ba3n2e 2banf b1ang ban3gl ban4k1a banker4
+ban2kl ban2kn ban2kr ban2ku 2banl b1anna ban2o 2b1ans4 b1an3t 2banw b1anz
+ba2r3ab ba2rad bar3ast ba2r3at bar3de ba2rei ba3r2en barer5ei bar3n b2aro
+3bar2s bar3sc b1arz bar3zw 3bas ba3sa ba2sc bas2i bas4sa bas4sei bas4st ba2st
+ba4t3ent bat2o 3bau. bau3b bauer4l bauer4s bau1fl bau1fr bau3g2 b2auk bau3r
+bau1s bau3s2k baus4t b1a2x ba1yo 4b1b b3be bbe4n3 bbens2 bbe4p bb3le. bb3ler
+bb2lö b3brec b3bru bbru2c bb2s bbu1 2b1c 2b5d4 bde1s bdome4 1be. 3bea be3an
+be3ar 3beb b1ebb 1be1c 2becht 2b1e2del bedi4 be1e2h bee2l be1ela bee4rei
+be1erl be1ert be1eta bef4 2b1eff be3g2 begas1 be2he. beh5ri bei3b 2b1eier
+bei1f4 bei4ge. bei3k4 bei3l2a 2b1eime be1ind be1inh bein6hal bein4hi bei3sc
+beis2e bei1s4t beit2s 3bek 3bel be3lag be3las be3lec 4be2lek be2l1en bel3ere
+be2let bel3f be3l2i beli4e bel3la belle4n3 bel3li be2l3om bel3sz bel3t bel4un
+be2löf 1bem 2b1emp 2bemul 1ben be5nabe ben3ar be4nas be4nat b2ene be3nei
+be4n3end be4ners be4ness ben2eu 3beng be4nis ben3n 5benp b2ens ben4s3pa
+ben4spr benst4 3bensz 2b1entb 2bentd 4benteu 2bentf ben3th ben6thei bent4r
+2b1ents 2b3entw ben3un ben3z2 be1o 2b1epi be1ra be2r3am be2ran ber3a2s berb2
+ber3d b4ere be2re2b ber2ec bere3ck ber4ei. be4r3eiw be4rene ber4erg ber4erw
+bere4sc berf4 3berg. ber4g3af ber4gal berg3as ber4hab ber4in. be5r6inne
+berin4s ber3iss ber3kr ber3n2a b1ernt 3bers. ber3st4a bert2a bert2e bert2i
+b4eru ber3ze ber2zö be2rö 3b2e1s be3s2a bes4abb be4sap be4sar be2s1er be2s1id
+be3s4lo bes2po bes3sa bess4e b3esst. bes3sz be4stab beste2 be6stein bester4
+bes6terh best2i bes3tin be4stol bes4to4r bes3tos best4r be4s5trä be4s3tur
+be3s4ze 3bet be3tam bet2sp be1un be1ur 3bev 3b2ew 2b3e2x 3b2ez 2b5f4 bfal2
+bfal3t 2b1g4 b5ga bge3 bgel2e bge5n bges4 2b1h2 b5hä 1bi 3bib2 bibe2 biber1
+bi2c bien3s bieres4 bie2s biet2s 3bietu bik2a bi2ke. bi2kes bi2k3re 3bil bi3la
+bil2an bi4l3ans bi4lau bil4deb bi2lei 4billu bi2lu 2bimp 2b1inb bin2e b1inf
+2b1inh bi2nok 2b1int 2b1inv bi2o3 biri1 3bis b1iso bi2sp bis2s1c bi2st4 bi3sta
+3b2it. b2ita bit2an b2ite bi3ti bi2tu bi3z2 4b1j bjek4to 2b5k4 bl4 2bl. bla3b4
+2b1lac b3lad b2lanc b3late b2latt b2lau. b3laus4 2b3law b2le 3ble2a b3leb
+3blec b3leg 2bleh b4lei. 2b3leid 2bleih b3lein blei3sc 2bleit ble3l 2b3lenk
+b3lese 2blesu ble3s4z b4let b3leu 2blich 3blick b2lie 2blief 2blig bling4
+b2lis 2blis. b2lit b3lite 3blitz b2lo 3b4loc b3los 3b4lum 2blun b2lus 3blut
+blu4tem blut1o 2b1län b2läse 3blät 3blü 2b1m 4b5n2 bnas4 bni2 bnis1 bo4a bo5as
+b1o2b bo3ben bob3r bo2c bo3ch2 bo3d2 boe1 bo2e3i 2b1of bo3fe boh3re boh4rei
+boh2u bo1is bo2lan bo2lau bol5le 3bon. bon2an bon2da bon2d1e bo2ne 2b1onk
+3bons boo2l boo2ti b1op 3bor. bo1r2an bo2r3as bor2da bor2d3r bo2rei bo4rig
+bor2s b1ort bor4ter bor6t5rat bo4ruh bo4rä bo2sc bo3se bo4s3p 3bot bote3n4e
+bo3th bot2st bot3t 3b2ox bo2xo 2b1p4 bpa2g 2b1q b2r4 2br. b4ra. 2b3rad 2b4rah
+b4ra3k brast4 2b3rat. bra4t3er4 2b3ratg 2bre. 6b5rechte 2b3red 2b3ref 2breg
+b3reif 2brek breli1 3b4rem 2b3rent 2breo 2b3rep b4rer bret6t5en bri2da brie4fa
+2b3riem b4rien bri2er b3ries 2brigk b4rina 2b3rind b4rio b4risc b3ritt 2b3roh
+2b3rol b4ron 2b3rost bro4tr brot3t4 2b3rou b4ruc 2bruf b4rum 2b3rund brus4
+brust3 bru2th 3brä 4bräd brä4u 3b4rö 3brü 4b3rüb 2b1s b2s1ad bs2am bs3amb
+b4s3amt bsat2 bsau2r b3sc bsch2 b4schan b6schef bs2chi b4sco bs2cu b3se. bse2b
+b3sel. bse2n1 b3sen. b2s1ent bs1erf bs1erg bs3e4r3in bs1erk bs1ers b3s2es
+b3set b2sim bsi4t b4ski bs2ku b4sl b2s1of b3s2oh b4sop bso2r b3s2pi bs2pl
+bs2pu bss2 bs2t bst1a2b bst1ak bst3ank bs4t1as bst1er bst3h b3stic bst3ink
+b2stip b3sto b4stob b4stod bs4tol b4stor b4strac b4s3treu bs4tri bst3ro
+b2s3trä b3stä bs3tät b3stö b3stü b4stüb b2s1un bs2zep bs2zi b4s3är b3s2äu b2sö
+4b1t bta2s btast3r b5te b2t3h b3ti bti2s bt4r btran2 bts2 b3tü1 buche4 bu4chec
+bucher4 bu6ch5ers bu2chi buch3sp bu2e3 bu2f bul2l3a 2bumf 2b3umk 2buml 2b3umr
+bun4d3er bunde4s b1une bung4 b3un3gn 2b1unh bur1c b2urg burg1a bur4gan bur4gar
+bur4gin bur2gr bu3r2i 2burn b3ursa burts3 bu2sa bu2sc bus3cha bu3sche bu6schei
+busch3w bu2sin bu2s1p bu2su bus1un 2b1v 4b5w 3b2y1 by3p2 bys4 2b1z4 b5ze
+bzeit1 3b2ä1c 3b2äd 2b1äh b2äl 2bärz b2äs 2bäug bö2b3 2böf 2b1öl bölk3 2büb
+bü1c bügel3e 1c2a cab4 ca3bl ca2c ca2e3 ca3g2 ca1h cal2a cal2f3 cal3t 3cam
+2can cana3 ca2pe car3b car5n carri1 car2s ca3s2a3 cas5to ca3t2h ca1y2 c1b 2cc
+c1ce c1ch2 cchi1 c2d2 c3do 2cec 1ced ce2dr ce1er 2cef ce1i ce3in 2cek 3cels
+cen3a ce3nu ceo2 1ce1r cere1 cere3u ce3r2i ce3s4h 1cet ceta2 cet1am ce1u c1f
+c1g c2h 4ch. 2chab ch3a2b3i cha2ck 2chaf 2ch1a2g 2ch1ak chal6l5ei 3chanc
+chan3f ch1ang 4chanl 4chanz 3chao 4char. 3chara 3chard 3charta cha2sc chasi1
+1chato 2chatt ch5austr chau3t 2chb 2chc 2chd che3b4 ch3e4ben ch3echt ch1edi
+1chef 3chef. che4fer 3chefs 2cheh 2chei ch1eim 4chelem che4ler 3chemi 2chemp
+che4neb che2no 4chents 4chentw cher3a 4ch3erbs 6chergeb 4cherke cher6zie
+ch3es4s ches5t 2ch1e4ta 2ch3e4x 2chf 2chg 2chh 1chia 4chic chi3na 4chind
+3chines 2chinf 2chinh 2ch1ins 2ch1int 2ch1inv 1chip. 1chiru 2chiso 2chj 2chk
+2chl4 ch2le chle2i ch2lu 4ch2m 2chn4 chner8ei. c4ho 2chob cho2f ch1off chof4s
+ch1oh cho3l2a ch1orc ch1ori ch2os 2chp ch2r4 2chra ch3rad 2chre ch3rh 4chrit
+3chromo 3chron 4chs ch2spo ch4stal 2cht ch2tru 2chuf 2chuh 2chum 2ch1unf
+2chunm 2chunt 2chur ch1urs 2chut chut4t 2chv 4chw 1chy 2chz 1châ ch1äh ch1ärm
+ch1äs 1ché ch3öl 3chör 4chü ci2ak ci1c ci1es cill2 ci2na2 c1int ci2s1 1cit c1j
+c2k 4ck. ck1a 1cka. 2cka2b 2cka2c ck2ad 1ck2ag 2ckal cka2m 2ckan 2ckap cka4r1
+1ckat 2ckb 2ckc 2ckd 1cke 2ckef 4ckeff 2ck1eh 4ck1ei 2ckemp cke4na 6cken6sem
+4ckentf 4ckentw cke2ra ck2ere 6ckergeb 4ck3er4hö ckerk4 ck2ern 2cke2ro ck1err
+6ckerzeu 4ckese 4ckex 2ckf 2ckg 2ckh 1cki 2ck1id ck1in 3ck4is 2ckk 2ck3l 2ckm
+2ck3n 2ck1o2 ck3ot 2ckp 2ck3r 4cks cks2al ck3sc ck4spen cks4tri 2ckt ck3te
+ckt2i 1cku 2ck1uh 2ck1um3 2ckunt 2ck1up 2ckv 2ckw 1cky 2ckz ck1ä ck3ö2 2ckü
+c2l2 cle4a clet4 clin2g cli2p1 clip3a clo1 clo2ck 1clu clu4b c2m2 c3me c3mu
+1c2o 3coa co2c co3ch 3co2d2 co4de. co3di cof3f2 coi4 co1it co2ke co3la3 co2leu
+co5l2o 3com com4te. comtes4 con2ne co2pe co1ra cor2da co4re cor3t cos3t co4te
+coti2 2cp 2c1q 1c2r2 3cre2 4cree cre4mes cros4 cry2 c3rä 2cs cs2a cs4f c2si
+c1s2ti c1s4tr 4c1t c3ti4 ctio2 ctur6 1c2u 2cua cu2e cu2p3 cussi4 c1w 1cy c1z
+cä3 cäs2 1cé cô4 3da. da1a 2d1ab d3a2bak d2abe d3a2ben d3a2bi d3a2bo dab4ra
+da2bri da3brie d2ab4rü d2abä d1ac d2ac. dach3a da2cho 4d3achse d1ad da2de
+dad4r d1af 2daff dafo4n d1ag dagi4 dag2o dah3l da1h2o dail5 da1in 2d1air da1is
+da2kro dal2a 2d1a2lar dal3b2 4d1all 2d1alp d1alt2 2dalte da3lö da1lü 3dam
+d1amma 4d1ammä damo3 d2amp damp7f8erf 4d1amt 3d2an. d1ana da2nan da4nat 2danb
+dan4ce. d1and2 2danda d2andy 3dane 4d3anei 2danf d1ang 2danh d2ank dan2kl
+dan2k1o dan2kr 2danna d1a2no 2d1ans 2danw d2anz. 2danzi 2danzü 2d1ap d2aph
+da2por 4dapp 4daq da2r1a 2darb2 2d3arc dar2da dar2d1e dare2 daren1 dar3g 3darl
+dar2m1a dar2m1i dar4mu da2r3o 3dars 2d1art dar2th dar2tr da2ru d1arz da1s2
+da3sh d1as3p das4t d1asy da3t2e2 date4n 4d3atl 4datm da2tom dat2st 2d3atta
+3daub 2daud dau3e2 dauer3e 2d3au2f 2d3aug 2dauk da3unt 2d1aus3 3daw d1ax 2d1b4
+dbe2e dbu2c 2dc d3ch 4d1d2 d3da d3de d3dh d5do d3dä 1de dea2d de3alo de3ar
+de3a2t d2eb4 3debü de1c de4ca. de2cka deco3 de2del de2dit 2de3e4 de2fa. 2d1eff
+def4l deg2 de3gl deh2a dehe2 3dehn 2d1ehr d1ei 3d2eic 2deid de3i4den 4deie
+2deig de3il 3d2eim 4deime 4deinb dein2d de3inse dein6sta 4deinw 2deise d4e1ism
+dei2sp 2dekz de2l1ac del4ade de3lak de4l3aug del3b2 del1ec delei4g de3lein
+2delek 2delem de2len deler2 deler4r 2delf. 2delfm 3delik del2la dell3au delle2
+del4l3eb del4lei del4l3er del2l1ä de2l1ob del2se del2so del2s1p del3t4 del3änd
+dem2ar 2d1emb dement4 de6mentg dem5ents de3min 2d1emot 2d1emp d2en. den2am
+dend2 de2n1e2d de4n3end 4denerg den2es 4d3en4ge. de2ni denk3li denko4 de2nos
+dens4am den6scho 4den4sem den6sere den6s5tau 2dentd 4dentf 2d1entg den3th
+2dentn 2dentw 2dentz de3nu den6zers de2ob 2deol de1on depi2 dep4l 2depoc dep5t
+d4er. dera2b der3af dera2n de3rand de2r3ap de1ra4s de4r3asi der2bl 4d1erbs
+2derdb de2re2b de4reck de4r3ei4s d4eren de4r3end de3r4erb de3r4erf derer3n
+der3ero derer4t derer6ze de2r1eu derf4 d4erfl d2erhü derin4f de6rinnu
+derin8teg der3k2 4derklä d2erm de1ro de2rop der3r derst2 der3sta dert7ende.
+dert4ra 6dertrag der8trage 3de3ru de4ruh de4rum 2d1erz. 2d1erzv derö2 d2es.
+de2sa des1ah de4sam de2seb de4se2h de2sei 2d1esel des3elt de3sem des4end
+desen3e de2set de4sin des1o de2sor de2s1p de3spe dess2 dess4t dest5alt de3stel
+des6temp de5stern des4tex de1sto dest5rat de3stri des4tum de2su des1un 3desw
+de2s1än det2 de3ta deten4t de2thi 2d3etw 2d1eul de1un de1url de3us 2d1e2vid
+devil2 de2xer de2xis 2d1f6 2d1g2 dgas3tr d2ge. dge3r dger2e dge3s d2gesh
+dge2t3a dge4t1e 2d1h2 dha1s4 4dho d3hu 1di di2a di3ar dia3s4 diat4 di4ath 3dic
+di1ce di3chl dicht6er 4d3i2co d2ida 2d1ide 2didy di2e di3e4d di3enb die4neb
+diener6l di3e2ni dienst5r dien3z di3ers. dies1c di3e2th 3dif 3dig dige2s dig4n
+dik2a dil2s3 2d1imb 2dimp din4a 2d1ind di3n2e 2d1inf 3ding 2d1inh 2d1in1it
+2d1inj 2d1ins 2d3int 2d1inv di2o3b dio4n3i dion3s4 di3ora dio5s2 di2osk di1p4
+di3pt d1i2ra di4re. di2ren di2rin di2ris 2d1irl 2d1irr di2s1a2 2diso di2sp
+di3s4per 2d1isr dist2 di1s4ta di2s3te di4stra di4sz di2ta dite1c di4t3erl
+di4t3erm di4t3ers di1the di2tin di2tob di4t3r dit3s di2t1u di5v2 di3z2 2d1j
+2d1k4 4d1l2 d3la dla3g dlap4 d3le dle2ra dli4f dl3m dl3s 2d3m2 4d3n2 d5ne dni2
+dnis1 do5a d1ob 3d2oba d1of do2fe 2d1oh doll2 d3o2ly do2mal do2mar dom2e
+domen1 do4ming do2mu do5n2a do3nan donau1 doni1e 2dope 2d1opf do1r4a 2d1orc
+2d1ord dor2f1a dor2f1i dor2fl dor2fo dor2fr dor2f3u dor2fä 2d1org dori1 d2orn
+2dort dor4ter dor4tr d2os. dose4 do5s2k 2dosm dos3s dost1 dos4t3a doste4c
+dos4tel dos6teng dos4tes dos4ti dos4tr do4s2tu do3ta do2t3o do2tre do3un dow2s
+dox2 2d3p2 dpass3 dpo2st 2d1q d2r4 3d4ra. 3d4rab 2d3rad 2drahm 2d3rak 3d4ral
+d3ramp d3rand dran3k 2d3rast 2draub 2d3rauc d4rauf 2draum 2draup 4dre. 2d3rea
+d4rea. d4reas 3d4reck 2d3ref 2dreg 3d4reh dre2ha 2d3reic 3d4reie d4reiv d4rej
+2drek dreli1 4drem 4d3ren 4d3rep 4d3rer 4dres. d4resc 2drese dres6sei d4rew
+2d3rez 2d3rh d3ri d4ri. 3d4ria d4rib 2d5ric d4rid d4rie d5rieg 3drif 4driff
+d4rift d4rik d4ril d4rin. 2d5rind 2drip 3d4risc 2drisi 2driss 3d4rit 4dritu
+2d3rob d3roc d3rod d4rog 2drohr 3d4rohu d4roi 2d3roll 2d3rose d4ross 2d3rost
+2d3rot 2d3rou 2d3rov d3row d5rub 3d4ruc 2d3rud 2d3ruh 4d5rut 2dräd d4räh
+2d3rät 2d3räu drö2sc drü1b drü5cke 3d4rüs 2d1s ds3ab d2s1alk d4s1amt d2san
+ds3ane ds3assi dsau2 d2saut 4dsb d4schef d4schin dsch4r d3s2co d2scr d2s1e2b
+dse2e d2s1ef ds1eh d4sehe ds4eign d2sein d2s1emb dsen3er d2s1eng d2s1ent
+d2s1erf d2serh d2s1erk ds1err d2s1ers d2s1ert d2serz dse4t d2s1eta d2s1ev
+d2sex d3sha2 ds2hak d4shal d3sho d4shor d2sid d2s1im d3s2inf d3s2kal d3s2kel
+4dsl d4sli d3soh d2sop dso2r ds1ori ds3part ds1pas d2s1pat d2s1pec d4speri
+d2s3ph d3s2pi ds2por d6sporto d3spri d2spro ds2pu d2spä dss4 dst2 d4stag
+d2stas ds3tauf d2ste d3stec d3stei d4steil d5stell d4stem d4sten d3s4tern
+ds2ti ds4til ds4tip d4stoch ds4tol d5strei ds4tri d3s4tro ds2tur d4s3täti
+ds1ums d2sun ds2zen ds1än ds2äu d2sö 2d1t dta2be d3t2ac dtach3 dta2d dt2ag
+dta2n d3t2as dt2ax d5tea d2th d4thei dt3hi dt3ho dt4hy d3to2 d4to4b dt2op dt3r
+dtran2 dts2 dt3sa dt5st dtt4 dt2un d3t2ur d3ty d3tö d3tü 1du du1alv du1ar
+dub3l du2bli du1ce du2f 2d1ufe duf4ter duf4to duf2tr 2d1uh du1i du2kr du4l3art
+2d1umb 2dumd 2d1u2m1e 2dumf 2dumg 4d3umk 2duml d2ump 2dumr 2d1ums d2ums.
+2d1umv du2n 2d3un3d dund2a dun4de 2d1unf dung4 2d1ungl 2d1uni dun3ke dun2kl
+2dunr dun2s 2dunsi dunst3r 2dunt 2dunw 2d3unz du1os dur2 dur3au durch3 2d1urk
+2d1url 2d1urn 2d1ursa 2d1ur3t du4schn du4schr du4sch3w 2d1v2 4d1w dwa2 dwa4r
+dwes2 dwest1 1d2y 4dyl 3dyn dy2sp 4d3z2 3däc 2d1äg 2d1äh 2d1ämt 2d1änd 2d1äng
+2d1äp 2däq 2därz 2d1ä2u dä3us 2däx d1ö dö2d dö2f 4döl1 döll2 d2ön 3d2ör dö2s1c
+2düb d3über düns3 2e1a e3ab ea2be e4abi ea2b3l ea2bo ea4br ea2c eadli4 ea2dr
+ea2g ea3ga4 ea3g4l eakt2 e2akta e3akto ea2la e3alei e4alem ea4l3ent ealer2
+e3a4lerg ealer4t e3alex e3a2lin e2alo e2alti2 eal3tr ea2l3u2 eam3 e2am4e eam1o
+eamt2 ea4na ean3a2r e3anf e2ano ea4nä e3ar. ea2ra e2are e4are. ea2r1ei ea4rene
+e4arer e4ares ea2ro e3arz e3a2sc easin4 ea4sp eas3s eate2 eater1 e3ath eat3s2
+e3at5t4 eatu3 e3aue e3auf eau2fe e4aufo eau3g eau3n e2av e1b 2eba e3bak eba2p
+2ebea 2ebec 2ebed ebe1er 2ebeg eb2el ebe4ler ebe2lo ebenen3 2eber ebe4ras
+ebert4 4ebes ebese2 ebe4s3eh 2ebet ebet4s 2ebew 2ebh 2ebi 2ebl eb3le. eb3ler
+eb4leu e3blie eb3lo e3blä eb2lö 2ebo e2bob ebot2 2ebr eb4rea 2eb2s1 eb4sche
+ebse2 ebs3in ebs3pa ebs3tau ebs3tem ebs3t4h ebs3ti eb3str eb4stät 2ebu e2bunt
+ebu2t1 eby4t e3bän ebö2s 2e3ca 2e3ce ech1am 2e1che ech1ei ech2en1 e6ch5erzi
+e1chi ech3l ech3m ech3n e2cho. ech1ob ech3r ech4ri ech5sel ech3ser echst5re
+ech3t4ei ech6terh echter8ha e1chu ech1w ech1ä ech3ö2 e1ci eci4a ec4k ecke4n1
+e4ckerr eck4sta 2eckt 3eckty 2e1cl 2eco e3cr ec1s 2ect e1d ed2a ed2dr ed2e
+ede2al ede3n4er edens1 eden4se eden4sp edeo2 ede2r eder3a ede4ran eder3t2
+edes2t ed2i e3di. edi3an 2edip edi6teng e3d2o e3drei ed2sal ed4seh ed2s1es
+ed2si ed2s1o ed2s1p ed2s3tr ed2s1u edu2s e3dy1 edys4 ed2ö 2ee ee3a4 eeb2l ee1c
+ee4ce ee2cho e1e2ck e2ed eede3 eede1s eed3s2 ee3e2 e1eff eef4l eeg4 e1ei
+ee3ing eein4se eeis3s eel2e e3e2lek eele4n eel2ö e2e3m2a e1emb e1emp ee3mä
+e1en eena2g e2enc e2e3ne een1er e2eno een3s een2z e2e3nä ee3o e2ep ee3po
+eer3as e1erbt e1erd ee3re eer1ei ee4r3en4g eer2e2s eer3k ee1ro eerst4 eert2
+ee3r2un e1erz ee1rö2 eer2ös e2e1s2 ee3sh ees3k ee3sp ee3s4t e2et. eet2a ee2tat
+ee2th eet2i eet4r ee2tu ee1u2 e2ew eewa4r e1e2x e1f e2f1ad ef1ana ef1ar e2farc
+e2fat 2efe e2f1e2b e3fef efe4l3ei ef1em e2femi efe2n1 3e2f1ene e2fent efer5f
+efeuil4 3effek 1effi ef2fl 2efi ef1id e2f1ins efi2s 2efl ef4le e3f4lu e3flü
+2e3f2o 2efr ef4reih ef3rol ef3rom ef4ru ef4rü efs2 ef3so ef3sp ef2tan ef2tei
+2efu e2fum 2efä e2fäu 2efü e1g eg1a2m eg2anz egd4 e3ge ege4l3au ege8l7ei8er
+ege4ler ege2lo eg2en ege4n1a ege6nero ege2ra ege4s5tr ege1u 2egi 2egl e2glo
+e2glu e2gn eg3ni eg3nä ego1p eg4run eg4rö egs2ag eg4sal eg4s3an egsau3g eg3se
+eg4sei egs2e3l eg4sin eg4sk eg4so egs2pe egst2 eg4sto eg2th egung4 egus3 2e1ha
+eh1ach e3h2ah eh2al ehalt4s e3hand eh1arm e2harz e3haut e1he eh1eff eh1ein
+e3helf eh1elt e4hense e4h3ente ehen4tr 1e2hep 2eher ehe1ra e2h1erf e2h1er2l
+ehe3str 2e1hi eh3im eh1lam eh2l3au ehl3ein eh4lent eh5l2er ehlo2 ehl1or ehl2se
+ehls2t eh1lä 2ehm eh2mab eh4mant eh3mu 2ehn eh3na eh3no 2e1ho eho2f eho2l
+eh3oly eh2r1a2 ehr1e2c eh2rei eh2rel ehr6erle ehr4ern ehre3s eh4rin ehr1ob
+eh1roc ehr1of ehr1ä eh1rö ehs2 eh3sh ehst2 eh1ste 2eht2 eh3ta eht4r 2e1hu
+eh1unf e2huni e3hur eh1w e1hy e1hä ehö4rer e1hü eh3üb 2ei3a2 ei2bar ei2bli
+ei4blu eibu4t ei4b3ute e4ic ei1ce ei2cho e2id ei2d1a ei3de eid4ein ei4deis
+eid5erre 2eidn ei3do ei4ds ei1e eie2b ei3e2l eie2m 4ei3e2n eienge4 eien3s
+eie2t 4eif. ei1flo 1eifr 2eig. 2eiga eig2ar 2eigeb 2eigeh 4eigeno 5eigensc
+2eig2er 2eiges 2eigew 2eigi ei3gl ei4glo 1ei2g3n ei4g3rat 2eigre 2eigru 2eigrö
+2eigrü 2eigs 2eigt 2eigu 2eigä 4eih ei2hum ei2kak eik4am eik2ar eik2i eik2l
+ei3k4la ei3klä e2il 2eil. ei2lam eila2n eil3ane ei4lant ei4lanz ei2lar 2eilb
+eil3d4 ei4lein eile2n1 ei2let eil3f4 eilm2 ei2lob eil2ö 2eim. ei2mab ei2m1ag
+eim3all eim3alp ei2m1or 2eimp eim2p4l eim3sa ei2mur e4i2n1a ei4nac eina2d
+ei4n3an ei4na4s ei4n3at ein6derk ein3ebe ei2nel ei4n3en4g ei6nen6se ein5erbe
+ei4nerf ei4nerk einer6sc ei2neu ein4fiz 2einfo ein4fo. ein4fos ein3g2 3einger
+e4ingr e2inhä ei2n3ie e1init ein3k4 ein6karn 3einkä e2inl ein3n2 ein4nen
+ei2n1o2 3einric e4insa 3einsat e2insc 5einschä ein6stal ein6terv ein4tol
+3eintö 1einu ei4n3ä ei3o2 ei1p eip2f 2eir eir2c ei3re e1irr e4is. ei2sa ei3sas
+ei6schwu ei4serg ei4s3erl ei6s5erst ei4s3erw 1eisho ei3s2ky eis2pe e2iss
+eisser6s ei1sto eis4tol ei2sum ei2sur 1eiswo e2it ei2t1a2b ei2tal ei2tan
+ei2tap ei2tar ei4tat eite4ra ei4tess ei2t3h ei2tin ei2tor ei4trau ei4tro
+eitsa4g eitt4 4eitu ei4t1um ei2t1ur eit3z2 2eitä ei2tän eiv2 eive4 ei2zar
+eiz1in 2e3j e1k e3k2a 1ekd ek2e e3ke. e3ke4n e3kes e3key e3k2l ek4n e3k2o
+ekor4da 2e3kr ek4s1p 2ekt ek2tan ek5t6ante ek2t3at ek2te2l ekt3erf ekt3erk
+ek4t3er4z ekt2o ek2t3o4b ek2tä 2e3ku ekur2a e3k2w 1ekz 2ekä e1la ela2br el2abt
+el3abu ela2ck el3ader el1af 2elai e2l1ak el1a2m el2a3mi e3lamp el1ana e4landa
+e2l3a2ne e2lanm e4l1ans e2l1ant e4lanw e2l1anz 2elao e2l1ap e2l1ar ela2re
+el3a2ri el3arr ela2s el1a4si el1asp ela3su 2elat el3aufw 2eld el4d3erf elder4p
+elder4s eld5erst el3des el3dri eld3s2 4e3le. e3lea elea2r ele2c el3echt 4eleh
+el3ehe. 2elei e6l5ei6ern e2l1ein e3leine 1elek e2l1el 1e2lem 2e3lem. e3lema
+ele2mi 2el1emp 2e3len. elen1e elen4k3l e4lense e2l1ent e3lep 2eler e3ler.
+eler2a el1erd e6lereig el1erf e4ler4fa e4lerfi e2lerg el1erh el1erk e2l1erl
+e4l3ernä e2l1err el1eru el1erw eler2ö eles2 e2l1ess e2l1e4ta ele2ti elet4ta
+el1evo el1ex e3lex. 1elf. elf2er 1elfm 1elft elgi5er. elgi5ers el3g2l eli4are
+e2l1id 2e3lie eli3ef. 2elig e2lim elin3a eli3no el1ita 2elk elk3s2c el4larb
+el4lart el5le. ell3ein ell3eis el4lel el5lend ellenen5 ellen5s ell2er el3les
+el2lim 1ellip el2lor ell3sp el3lär ell2ö elm2e elm3ein 2eln 2elo e2l3oa el1obe
+e2lof e2lol e2lonk e2l1or e3lore elo2ri e3lot e3l2ov el3p4 el4s5ein els2ph
+el5stern el2sum el4tans elte4m el5ten. el4t3ent elter4b elter4f elter6le
+3elter4n elter6sc elte2s el4tesc el3the 2e1lu el1uf e2l1um el1ur el3use elu2t
+el3uto 2ely e2lya el3z2ac el2zar el4zene el2zwa 2elzy 2e1lä 2elö elö2s e1lü
+e1m e2m3a2b em1alk e2manf e2m1ano e2m1ans em1app e4m1a4s3p em1aus 1emba 1embo
+3embry emd1r em2dra em2dä 2eme e2m1e2b e2mef e2mele e3m2en emen6gel emen3ta
+emen4t3h e2m1erl em1erw e4mesu 3e2meti e2m1i2d 2emie emi2ei e2mig emi3k2 em1im
+2emin emi3n2a e3mind em1int 1e2mir e3misc 1emiss emi3tr emma3u em2m1ei e2moa
+e2mof e2mop emo3s 1empf4 em3pfl em3po empo1s em2sa em4scha em2sim em2spr em2st
+em3t4 1e2mul e3mur 2emä em2äh e3mäs 2emü e2na 4ena. e4n3a2b 4ena2c e4n3ack
+2e3nad enadi4 e4naf 4enah en3ak en1al e4nalb e3nale en2alg ena3l2i e4nalk
+e4nalm e4nalo enal3p 4en1am ena4n e4nand en3ane e4nant e4nanz e4n3a2p en3a2re
+en3ark en3aro en1as ena2sc e4nast 2enat 4e5nati e4natl enat4s e4n3att 4enatu
+enau2f en3aug e4n3aur e4naut en1a2x en3a2z en2ce. 1ency end2ac en2dal en4dang
+2endel ende4lä en4d3es4s en2dex en3d4ort end3rom end3s4au end3s2l end3s2p
+end3sz en3d2um en3d2ü 2ene. ene4ben en1e2c e2neff en2eid e3neien e4neige
+4eneigu e4nein e4neis en1e4kl e2n1el ene4le 2ene2m e2nemi 2enen e4nense
+e4n1ent en4entr e2n1ep 4e3ner. en2era e2n1erd e4n3erei e2nerf en4erfr 1energ
+e2nerh e2nerk e2n1erl e4nermi e4n3ermo 4enern e4n3erne ene2ro e2n1err en1ers
+4eners. e2n1ert en4ert. e2n1eru e2n1erw 2enes e4n1e2sc e2n1ess en1eta e2neth
+en1eul e2n1ev e4ne2x en3f enf2a enf2u 1engad 1engag en3g2al enge3r4a en3g2i
+en3gn eng2o 1engp eng4ra eng3se 2eni e3ni. e3nic e2nid 4e3nie eni3er. eni3erp
+eni5ers. en3i2ko en3ill eni4m en1ima en1imi e2nin e3nio e2nir e4n3iso e3nit2
+e3niv eni2ö enk3aus enk3erg en4k3erk en3k2ü en2nef en2nel en4ner4f enn3erg
+en4n3erl enni6ger 2enniv enns2 enn3ste e2n3oa e2n1ob e3nobel eno2br e2n3oc
+e2nof en3ol eno2ma e2n1op e2n1o2r en2ora eno4ri 4enorm en1ost 4e3not eno2w
+en3sabb en3sac en2san en5sche en2seb 1ensem ensen3e ens3ere en2sid en3spo
+ens4por enst5alt ens4tel ens6temp ens2th 2ens4to en4s3tät enst2ü ens3umf
+en5t2ag en4tanm en4tanw ent4ark 1entd en3t2el ente2n en4terb 1entf 2entfo
+2entfö 1entga 3entgeg en2thi 1enthu 1enthü en4tid 1entla 1entn en2t1os
+en4t3rol 1entsc 1entso ent4sto 1entw 4entwet 3entwic 1entz 2entö e2n1u e3nu.
+e4nur 2enu4t e4nuto 4enwü 2e1ny2 1enzep enz3erg en4z3erk en4zerl en4z3erm
+enz5ersc enzlan4 enzo2l enz2äp e2n1ä e4när 2e1nö en1ö2d e1nü enü1st 4eo e1o2b1
+eo3ben eo3bl eo3br eo1c eoch2 eo3dr e1of eo3g2 e1oh eo3la e3o2ly eom2 e1on.
+e1ond e1onf e1onh e1onl e1onp e1onr e1ons eo1o e1opf e1or e3or. eo1ra e3orb
+e3ord e3ors eort2 e3orw eo1s2 e3os. eo3se e1oste eot2e eo1ul e1p 2ep2a epa2g
+epas6ser 2eper e3p2f4 1e2pid e2pig e2pik 1e2pile e3pio 1epis 2epist 1e2pit
+ep3le 1e2poc eport4 1e2pos. ep2p1a ep2pei eppe3l ep4pl ep2pr 2epr ep3sh ep2tal
+ept2an ep2tau e3pu epu2s 4e3q er1a e3ra. e2rach e3rad. e3radi e2radj e2radm
+e4radmi e4r3adr eraf4a era2g e1rah e1rai er3aic e2rak e3rake e1rald eral4eb
+er3alke e2r3all er2an. era4na eran3d4 e3rand. e4rangr e2ranh e2rano e1rap
+er3apf er3apr e2rar er3are e3rari er3arr e3ras. er3asc e1rast e3rati e2ratl
+er3att e1raub e1rauc er3aue erau2f er3aug e2ra2v e1raw e2r3ax e1raz era2ß
+3erbarm erb2au erb2e erb2sp er1c er3chl erch2o erda3me 1erdb er3de 2erdec
+2erdel er4d3en4g erd3erw erdes4t erdeu2 1erdg er2dob erd3st 2erdy 4ere. er1eb
+ere4ben e3r2ech er3echs er1e2ck er1edi ere4dit er1eff er1e2h ere4i 6e3rei.
+6e3reib er1eig 4ereih e4r3eime e2rein er3eis. er5eisar er3eisb er3eisf er3eisr
+erei5str er1e2l e2rele ere3lev ereli1 2e3rem e4r1ema er1emb e2remp e4remu
+2eren e3ren. e3rena eren1e e4rense e4rentn e4rents e3renz eren8z7en8d er1epe
+2erer. 2ererb er3erf erer3fa e4rerfo e2r1erh e2rerk erer4kl e2rerl 4erern.
+e4rerne e2rer2o erer4ri er1ers 4erers. e8rersche e2rert 2ererv 2ererw 2eres
+er1ess eres3sk er1e4ta ere2th e4r1e2ti 3er1eul ere4vid erf2e er3for erf4r
+4erfür er4g3are 4ergebi 3ergebn 4ergebü 4ergeha 4ergehä ergel6s3 erg5elst
+4ergeni 3ergiee er2gop 4ergrem erg3s ergs2o ergs2p ergs4t e4rh 1erhab 2erhai
+4erhals 2erham 2erhas 3erhebu er3hei 2erher er3hu 2eri e2riat e3rib 4e3ric
+e4r3ico e2r1id eri2de 4e3rie eri3e2n1 e3ri3k erik4l 4e3rin. er1inb e2rind
+e2r1ini er1ink er1inl er1int e3rio 4eris e2risr 3eritr e3riv 2erk. 2erkaj
+er3ker 1erklä 2erkm 2erkre erk5t4 2erl. 2erlag 3erlaub 3erlebn 4erleh erm2
+er3mag er3me ermen4s er4m3ers er3mi er4n3alt er3ne er4nene er4nerf er4nerk
+3erneue ern1os 2e1ro. e1roa er1ob ero2bl ero2br e2r1o2f e1rog e1roh 4e1rok
+e1rol er3oly e1rom er3omb 2e3ron er3onk e2roo er1o2p e4ro4r eror2a e1ros
+1erosi e3rosit e1rou e1row er1ox e1roz er3p 2erren er3ror er3rä 2errü er3s2a
+ers4ana ersch4 erse4h3u ers2el er3sen er5s2i er3sk er3sp 4ersted er3stel
+erst5ers 4erstil ers4tod ers6tr er3swi er3sz ert1ab erta2d er3tat 4erteig
+er4t3erf er4t3er4g er4ter4h er4terk er4ters er2tho 4ertru erts2e ert3s2p 4ertö
+2eru eruf4s3 e4r3uhr er1u2m1 er1und e4rundu erung4 3erup er3use e2r3uz 3erweck
+er4zerk er4z3ers e1rä er1äf er1äh er1ä2m er1äp e2r1äs er1ätz erö2d 2eröh erö4l
+er1ös erü4b es3ab e4sabe e3sac es2ach e2s1a2d e3saf es3ak e2s3all es3ampl
+es2ank es2anm es2anr es3anz e3sap es3apf es3a2ra e3sarg e3sa2s es2ast es3ato
+es3aus esa2v es1ax 2esb esbi5er. e3s2ce esch2 es4chem es4chi e2s3ec es1ehr
+e2s3ein ese3in4s es2el ese4nal ese4neu esen3o es2ens esen3sk eser4at ese4r1u2
+eses2k e2s3e2x 2esf 2esh es2har es3he 2esi esi3er. e2s1il esi2st es2kat e4s3ke
+e4s3kl es3ku e4sky es3l 2esm es3ob es2oh eso2r eso3re es2ort e3spal e3s4pan
+es4park es2pek e2spel e4spers e2sph e3s2pi e3s2por e3spra e3spu 2esr 2ess.
+es2s1ag essali3 essau4s 1essay 2es3sc es3se ess4erf ess3erg es4serh 2essk
+2esso es2sof 2essp es2s1pa es2spu es4stab es3str es3stu 2essä estab4b esta3ge
+est1ak es4tanb es4tang e4stant e1stap e1star e4starb e2st1a4s e1stat e4staum
+e4staus es2tec est5eing est5eink est5einl e1stel e4sten es4t3eng est5erha
+ester6ke es4t3erz es4ter4ö es4t3ess es2th es2tid e4stig e1stil e2stip estmo6de
+1estni est1ob e2s3tom est3ori es4tr es5trac e3strec e1stu est3ums es2tur e3sty
+e1s6tü e3suh es1um e2s3ums es3unt es1ur 2es3w e3sy es3z es4zene e3s2ö e1t
+etab4 et2abl eta2c 2e3taf 2etal et1a2mi et4an. et1ant et4at etat3r et2ax 2etb
+2e3te ete2e e4t1ein ete3ke et2en eten3d2 ete2o eter4hö eter4tr ete2s 2etg et2h
+2eth. e3tha e4t3hal 1et4hi e2thik 1ethn e4thot et3hä et3hü e2tid eti2m etin1
+e4tinf e2tinh et1ini eti2ta eti2th 2eto e2t1o2f et2on eto4n3al etons4 e4torg
+2etr e4traum et3rec e2t3res et4ros ets2c etscher7e etsch3w et4sh ets1p et2spe
+et2ste et3su et4sum ett1a et2ta2b et2tad et2t3ak etta2m et4tang et4tans ett2as
+et2tau et2tei ette4n1 et2t3h et4tim et2t3r et2t1um 3e2tui e3tur 2etw etwa4r
+1etym 2etz et2zw et1äh e3tü eu1a2 eu3b4 2euc euch4ta 2eud eude1s eudi4e eu2eb
+euer3ei eue6reif eue6reis eueren4 euerer6s euerer6t eu3eri eu3erk eu3err
+eu2esc 4euf euf2a eu2fer eu2g1a eu6gense eu3g2er eu4g3ing eu2gre eu2gri eugs4
+eug3sp eu3h eu1id eu1in1 1e4uk eu2kä eulan2 euland3 eu3l2e eul2i 2e1um e3um.
+eu3ma e3umb e3umf e3uml e3um2s eum4se eum4s1p e3umw 2euna eun2e eu4nei e3un2g
+eu2nio eu4nis eunk2 eun3ka eu1o2 eu1p eu3p2f eu2ral eu4r1an eu4r3ast e2ure
+euren2 eu4rens eur4er eur3f4 1euro e3usar eu2sis eu3sp eust4 eu1sta eu1sto
+eu1s4tr eut2e eut2h 1eu3tha eu5t2o eut6scha eut6schn eut6schr 2eux eu2za eu2zo
+eu2z1w e1v e2vak e3var eva2s 2ev2e eve5ri evie3le 2evor e1w e2we. ewei4sc
+ewert4 e3wir ewi2s e3wit ewä2s 2ex. e2xam 2exas ex3at 2exc 2exd e2xel e2xem
+ex1er 2exes e1xi 2exik e2xil e2x1in 1exis ex3l 3exp 2exs 2ext. 2ex2ta ex2tin
+1extr 2extu 2extv 2exu e2xum 2e3xy 2ey1 ey2n ey4ne eys2 e1z e3z2a e2z1enn e3zi
+ezi2s ez2o e3zoh ez2w ez2ä 2e1ß e2ß1el e2ßent eße3re e2ß1er2g e3ä2 e1ñ e1ö2
+e3ü 1fa fab4 2f1ab5b fa2ben 2fabf 2fabg 2f1a2b5l 2fabn 3f2abr 2f1ab5s 2fabw
+fa4cheb fa4chel fa2ch3i fa2cho fach3s4p fa2del f1ader fa2di fa2dr fa3ec
+fah6l5ent 5fahrt fai3b f1a2ka fa2ke f3aktio f2akto 3f2aku fa3la fa3le fal2kl
+fal4l3ei fall5ent fal6lerk faller6s fal6scha fal6schl fal6schm fal2tr f1amt
+3f2an. fa2nar 2fanb fand2a fan2gr 2f1an3k 2fanl 4fann f1anp 2fanr 2fanw
+2f1an3z 2f1a2p f2ar far2b1a far4bel far4b3er far4bin farb1l far2bo far2b3r
+far2b3u f3arc 3fa5ri far2r1a far2rh farr3s 2f3art 2f3arz fa3s4a fa3sh fa2st
+2f1astr f3at f4at. fa2to f4ats 2f1auf f3aug f1ausb faus4t3r 3f4av fa2xa fa2ß
+4f1b2 fbau1 fber2 2f1c f3ch 2f3d4 fdien2 1fe 3fe. featu4 fe2c f2ech fe3che
+fe2dr fe2e1i feein5 fe1em 2f1e2he feh4lei f2eie f2eind 2f1eing fe3ins. 2f1einw
+f1eis 5fek fe2l1a fel3au fel2da felde4m feld6erh fel2dr fel4d5ri 2fe2lek
+2felem fe2l1er fe2les fe2l1o fel4s3oh fels2t felt2 6fel6tern fe2l1ä f2em.
+fem4m 2f1emp fen1a fen3au 4fenerg fe2ni fe2no fen3s2a fen5s2c fenst2 f1ent
+2f3entf f2enti 4f3entla f2ento 2f3entw 2f3entz 3fep fe2pi f2er. fe1ra fe2rab
+fe2ral fe4rang fer4ant fe4ranz fe2rau 2ferd. fer3da ferd2e3 f2ere fe2re2b
+fe2rec 3ferei 4f3ereig fe4r3eis f4erel fer3ell fe4rer4g fer4fah ferg4 f4ergr
+ferie4n3 4fer4leb f2ern. fer4nei f4erpa f4erpf f4erpl f4erra fer4reg ferri2
+f2ers. f2ert fert4r f2erz fe2r1ä fe2rö fess2e fe2st fest3a4b fest3an fest3ei
+fes4t1o fest3r 2f1e2ta fe4tag 3fete fet4t3a fetti3s 2feu. feuer3ö 3few 2f1ex
+3fez 4f1f ffab6s ff1a2d f3f2ak ff3ar f3fas ff1au f2f1e2b ffe2e f2f1ef f2f1ei
+ffe3in. ffe5inha ffe2m f2f1emi ff2en ff3erle fff4 ffi3k f2fim ffin3s ff1lag
+ff3le ff3li f3flu f3flü ff1ox ff1rak ff3ro f3f4rä ffs2am ff2s1p ffs3tan ffs3ti
+ff3stü fft2 ffus3s 4f3g2 fgeb2 fge3s 4f3h2 1fi 3fi. fi2ar fi3at fid2 fi2do
+fien3 fi1er2f fi2k1as fi2kel fi2kin fi2kn fi2k1o4 fi2k3r f2il fi2l1an fil3d
+fi2les fi3li fi4lin fil2ip fil2ma fil4med fil4mei fil2mä fi2lo 2fimp 3f2ina
+2f1inf fing2 fing4e fing4s4 fi3ni f2ink fin2s fin3sc fin3sti 2f1int fi2o fi3ol
+fi2r fi3ra fi4re fir3me fi3s2a fi4sch3a fi6schei fisch3l fisch3o fi4schr
+fi4sch3w fi3s2h 2f1i2so fis2p fi2s3t fite2 fi2tin fit1o2 fi4tor five4 fi2xel
+2f1j 3f2jo 4f1k4 fka4t3 f2l2 2fl. f3lad f5land f4lans f3lap f4lasc f3lats
+flauma4 fl4e f5le. 2f3leb f4lee 2f5lein flek3 flekt2 f3ler f4lex f3li. 3f4lim
+fli4ne f3ling 2flins 2f5lon 1f4lop f4lor 1f4lot flo2w 1floß 1f4luc 1f4lug
+flu4gen flu4ger 1f4luss f4lut flut1o 3f4läc 4fläd f3län f3läu f3lö 4flöf f4lög
+f4lü f5lüm 4f3m2 fma5che fma2d 2f3n2 fni2s 1fo f1ob fo2be 2fober fob2l 2f1o2f
+3foli3 fol2k3 fo2na fo4nan fon3au fon3dr fo3n2er fo4nin fo2nop fons4 fo2nu
+2f1op 4f3org fo3rin 3form for4m3a4g for4mas for4m3ei forni7er. for6schl for4st
+for4t3ei for4ter for2th for2t3r fort3s2 for3tu for2u fot4r fo2x 4f3p4 2f1q
+f2r2 f3ra. frach6tr 2f3rad 2f3rah fra4m f3rand f5rap f3rat 1frau. f3rauc 2fre.
+f3rec f3red 2fref 2freg f4rei. f3reic f4reie frei1f f4reig frei3k2 2freim
+2frein 2frek 2f3rep 2frest 3f4reu 2f3ric fricht6e fri3d fri2e 2frig f4ri3k
+f3rip 1fris f4risc f4rist 2f3roc 2frol 1f4ro2n fro4n1a f4rop fro2s f3rot f3ru
+f4ruc 2fräd 1f4rän frös2 f3rü 4f1s f2s1al f2sa2n fs3ane fs3ar f2s1a2s f2saut
+f3sc f4sca f4sce f4schan f4schef f4schro f4scr f2s1e2b f4s1ehr fse2n fs1en1e
+f2s1ent f2s1er fse4t f2s1eta f2s1i2d f3s2ky f2s1o2 f3soh f3sol fs2on f3spann
+f2s1pas f2sph f3s2pl f3s2por f2spre f2spro fs2pul fs3s4 fs2t fs3tak f2stas
+f3stat f3stel f3stern fs3th f2stip fs4tol fst4r f4s3tres fs3trü fs3tut fs3tät
+f4stäti f4stüte fs1ums f2s1un f3sy fs2än 4f1t f4ta. ft1a2be ft1abl ft1af ft2ag
+ft1ala ft1an ft1a2r f3tat ft3att fte2c ft1eck ft1edi ft1eh fte2he ft1eig
+ft1ein ft1eis ft1eli ft1emi f4t1ent ft3erfü ft1erk f2t1erl f2t1erz f2t1e2ti
+f2t1ex f2t3h f4t5hei f3ti f4tid ft1in f4tinf f4tins fto2 f2t1of ft3om f2t3ot
+f3t4ran ft3res f4tric ft3ro ft3ruh ft2s1 ft4sam ft3s2c ft4sche ftse2 ft4seh
+ftsen1 fts3i ft3st ft4staf ft4stei ft4stem ft6stier ft6s5treu ftstro4 ft4stru
+fts3tät f2tum ft1urk ft1url ftwa4 ft3z2 ftze3d f2t1äu f3tü 1fu 3fuc 3fug f2uh
+fuku3 f1um fun6derg 2f1unf fung4 2f1ungl 2f1u2ni fun2kl fun2ko fun2k3r fun2ku
+2f1unm 2funr 2funt f2ur furch2 fu4re. 2f3url fus2sa fus2s1p fus2st 3fut
+fu2ß1er 2f1v 4f1w f1ya 4f1z fz2a fzeiten6 fzei8t7end fzu2ga fz2w fz2ö 1fä fä1c
+fäh4rin fäh2r1u f1älte 2fäq 2f1ärm 2färz fässer4 fäs6serk fäs6serw 2f1ätz
+2fäug 2fäx fä2ßer 1fé 1fö 2fö2f 2f1ök 4f1öl för4s5 1fü 2füb fühl4sc fün2 fü2r
+3ga. 2gabf 2gabg g4abi ga2b3l gab2o g1abr gab4ri 2gabsc 2gabtr ga3bu 2gabw
+2gabz ga1c gade2r ga3di gadi4e 2gadl 2ga2dr gae2 ga1fl 5gag. ga1k ga2ka ga2ku
+gal2a ga3laf ga2lar 2g1alau 2g1alg gal3lo 2g1alp 2g1alta 2g1altd g1a2lu ga2mec
+ga3mel gam3ma 5g4amo 2g1amt g1ana 2ganb gan3d2 2ganf gan2g1a 4gangeb gan2gr
+gang4sp gan2g1u 2g1ank 2ganl 2ganmu 3g2ano 2ganr gans2 g2ans. 2g1ansi 2ganst
+2ganw ga1ny g1anz ga3pe 2g1app ga1q 3gar. g2ara 2garc 3g2ard ga3r2i 2g1arm
+ga3r2o gar2s 2g1arti ga3ru 2g1arz ga2s g2as. gas3al ga5schu gase2 ga5se.
+ga4sei ga4sel ga4se4m ga4sent gas3s2 5g4asse. g4assen 6gassess gas4t3el
+gast3rä ga3t2a gat2h 2gatm gat4r gau1c 2g1auf 2g3aug g2auk gau5ne 2g1aus
+2g1aut 2g1b4 gber2 gbi2 gbon2 gby4t 2g1c 2gd g1da gd2ad gda3de g2dak g2dan
+g2dar g2dau g2dei4 gdel6s gd2en g2d3ent g2der gd2es g1do g2dop gd2or g1d3r
+gd3s2 gdt4 g1dä1 g1dö ge3a2 geb2a ge3ble geb4lin gebot4 3gebü ge1c ge3ck ged4
+ge1e2 ge3ec geest3 4g1eff gef4l gef4r ge3fu 3gefä gegen1 gegen3s4 ge3g2l
+ge3hei 2g1eid ge4ie2 2g1eif ge4ig g2eil gein1 ge1ini ge1inn 2g1einr gein5sti
+gein2v ge1ir ge2is 2g1eise gei3sh geis4sc gei2st 2gek. gelb1r gel4b3ra gelb3s
+gelder4 gel6derh gel6ders ge3lec gele5cke 2ge2lek 2gelem ge4lene gel3ere
+ge4lerk ge4l3ers geler3ö ge2lev gel3f gel1i4m gel3la gell2i gel3sa gels2p
+gels2t gel3ste gel3sz gel3ta gelt4r gel3z2 gel2ö gem2 ge4ma. gem6e 4g1emp
+ge3mu g4en. ge3na ge4n1ac ge4nak ge4n3al ge4nam ge4nar ge4nat gen4aug g2enc
+4genda. 4g3endmo gen2dr gen3eid gener4f 4generg ge4n3ern gen6erwe gener4z
+ge4ness ge3nid ge2nim gen3k4 gen3n gen4sam gen3sk gen3sz 2gentf gen3t4h gen5tr
+2gentw gen3tä ge3nä ge4näu geo2ri ge1ou g2e3p4 ge1ra ge2ra2b ge2r3al ge3rann
+ge4rant ge4r3a2r 2gerdg ge4rene ge4reng ge4ren4s ge4r3ent ger2er gerin4f
+ger4inn gerin4t 4ger4klä g3erlas ger5me ger3no 2g1ernt ge1ro ge2rob ge2rop
+ger4sat 4ger4seh ge3r2u g1erzä ge1r2ö ge1s2 g2es. ges3auf 3gesc gesch4
+ge6sche. ge2s3eb 4g3e4sel. ge4s3elt ge2s3er ge3sha ge3si ges4pi ges3s4t gest2
+gest4a ge3stak ge3st6e ge4s3ter ges3th ge4s3tur ge3t2a ge4tang ge4tant g1etap
+ge3ti get4ri get3s ge5t4u 2g1e1ul ge3u4t ge3wa 4g1e2x 2g5f4 gfi2l 4g1g gga4t
+g5ge gge2ne gg2l g3gla g3glo g2g3n gg4r ggs2 2g1h 4gh. gh2a 3ghale gh2e 3g2het
+3g2hie gh1l 3gh2r ghs2 gh3sc g2hu gh1w gich2 gicht1 gi2eb gie3g gi2e1i gi2e3l
+giel2a gie3n gien2e gi4eno gie3re gie1st giet2 gif2tr gift5s gi2gu gi2kel
+2g1ill gi2me. gi4mes gi2met 2gimp 2gind gi3ne 2g1inf gin2ga 2ginh 2g1ins
+2g1int 2ginv gi2ob 2giok 2g3isel git2a gi3tu gi4us 2g1j 4g5k4 gl2 4gl. 4g1lab
+2g1lac 2gladu 2g1lag 2g1lam 2gland 3glanz1 gla2s1c glas3t4 3g2laub 2g1lauf
+gl3b g2l4e 2g3le. 3glea 2g3leb g3lec 4g3led g3lee 2g3leg 2gleh g4leic 4g3lein
+gleiter8s glei4t5r g3len 4glenk 4g3ler glerei4 2gles 3gles. g3lese g3lev g2lia
+2glib 3g2lid g2lie 2g3lieb 2glif g2lik 4glil g2lim 4glin g2lio 2glis 3g2lit
+g3lite g2liz g3lize g2loa g2lob g2loc 2g3loch g2lok g2lom g2lop g2lor 2glos
+g2lot 2gls g1lu 2g3luf 2gluk 4g3lun g2lut 2glw 3g2ly 2gläuf 2glöch 2glös 2glöw
+3glü g1lüg 2g1m2 g1n 2gn. g2n2a g4na. 2gnac 2g5nah gn4al gna4l3er 2gnanl 2gnb
+2gnc 2gnd gn2e g3neh 2gn3ent gne2tr 2gnf 2gng 2gnh g2nie g2nif g4nin 2gnint
+2gni4s3 gnise2 2gnk 2gnl 2gnm g2no g4non gno1r g3not 2gnp 2gnr 2gns 2gnt 2gnu
+3g2num. 2gnv 2gnw g2ny 2gnz 3g2nä g2nü go4a goa3li g1ob go3be 2gobj gob2l
+2g1o2f 2gog 2g1oh2 goh3ren go1i go3in gol2a gol2fr 3gon. gon2e 3gons goo2
+2gope gopf4 go4pos 2gopt gor2a 2gord 2g1org go2s1 gos3p gost2 2g1osz go3t2h
+got6terb got6t5erg 3gou go1y 2g3p4 2g1q g2r4 g4rab gra2ba gra2bi gra4bl
+2g3radl 2g3rah 2g3rak gram1 gram8m7en8d gram6mer g3rand. 2gra2r grar1e gra2s3a
+gra4sh gra4sp gra2st 2g3raub grau3f 2graum grau3sk 2g5re. g4reb 2g3rec g3rede
+g4re2e 2g3ref 2grege 2g3reic grei4fr 2g3reih g3rein g3reit g4rem 2g3renn
+gre3no gren6z5ei grenz3w g4rer gres6ser6 g3ret g3rev 2g3ric gri2e 2g3riem
+g3riese g4rif 2grig gril4la 4g3ring 4g3rinn g4rip gro2ba gro3ber gro2bl
+gro2b3r 2groc 2groh 2g3rol gron4 2g3rose g4ross gros6sel 2g3rost g4rot 2gruf.
+g4ruft 2g3ruh g3rui 2g3rum grun2g 3g4rup 3grus 2g3rut 3gruß 2gräd gräs1c g3räu
+2gröh 2g3rüc g4rün 4g2s1 g4s3a2b g3sack g4sa2d g4s3a2k g3sal g4salb g4sall
+g4salm g4salt gs2am g4s3ama gs3amb g4s3amp g4sant gsa4p gs3a2r g3sat gsau2g
+g3sau4r gsa2v g3s2c g4sca g4s3ce gsch4 g4schef g4s3co gse2 gs2e3h g3s2eil
+g3s2eis gse4kl g3sel. g4sela g3seln gs3em gsen1 g4sent g4ser g3sere gs3er1i
+g4se4s gse4t g4seu gsfi2l gsh4 gs3ha gsi2d gs3i2k g3sil gs3in g4s3ita gs2ki1e
+g4sm g4sn gso2 gso4b g3sol g4s3op g5s4orge gs2pac gs4pant g4spas g3s2pek
+g3s2pi g5s4pie g4s3pl g3spor gs6port. g6sporto g4s3pru gsrat4 gsrü2c gs3s4
+gs3ta g3stan g4stanz g3star gs4tati g3steh g3stein g3stel gs4tell gste2r
+gst3err g1steu gs2thy g3stif g3stil g3stim g3stir g3sto g4stoch g4stod g4stor
+gs3tr gst4ra g3s4tras gs4trat gst5reit gst4res g4streu gst3rit gst3ros g3stun
+gs3tä gs3tö gs4tör gs3tü g4sw g3sy g3säu 2g1t g3te gtei3s gt3h gt4hy g3t2i
+gti2m g3to gt4r gt2s g3tü gu4ale gu3am gu1an. gu1ant gu1as gu1c gu4d3r gu2e
+2gued guet4 2g1u2f 2g1uh gu1ins gu1is gum2e 3gumm gummi1 gun2e 2g1unf g2ung.
+gunge2 4gungew 2g1ungl 2g1u2ni 2g3unk 2gunr gun2s 2gunt gure4 2g1url gur2th
+gur2tr gurt3s guru1 gu2s gus3a gu3sc guschi5 gu3se gus4ser gus2s1o gus2sp
+gus4st gu4st gust3a4b gus3te gust3en gus6tend gus6terl gus4tr gu2t gut1a
+gut3er4h gut3h gut4sa gut2sp 2g1v 2g1w gy3n gyp2a 2g3z2 gzeu4gi 2g1äp gär3th
+2gärz gä4u gö2f g1öl 3göt 2güb 3gür3 güs3 hab2a hab2e h3abf hab2i 2habn h1a2br
+h1abs 2habw ha4ch3en ha2cho hacks4 ha2del hade2n h1adle hado2 h1a2dr 2hae
+ha4far haf2e haf3f4l h1affä h2aft haf4to haf2tr haft4s3p h2agg h1ah h2ahs h2ai
+3hai. h2aj 2haka ha1k4l 2h2al. halan4c h1a2lar ha2lau hal2ba hal4bel hal4bin
+hal2b3r hal2bu 2hale hal6lere hal6lerf hal6lerg ha3lo 4halp hal4sei hal4sk
+hal2sp hal2st hal4tal hal4tei hal6t3r h2ame 2h1amt h2an. 2hana ha2nal ha2nan
+2hanb h2anbe h2and han2da han2d3r hand3s ha2nem han2f1 han6g5end 2hani han2kr
+2hanl 2hano 2hanr h1ansc 2hanz 2h1ap 3h2ape ha2pl ha2po ha2pr h2a3ra ha4rab
+2harb h2ard har2fr h1arm. har3ma h2arme har4me. har4ne ha2rom hart4e har2th
+h1arti har2tr har4tri har2za h2as 4ha3sa has4c has2h3 has4sa hasser4 has4s3t
+has3t ha2str ha2ta h3atl ha2t3r 2hats hatt2 h3attr h1audi h1aufb hau5f6lie
+hau3f4lo 2h1aufm h1aufs h3au3g h1aukt hau2sa hau4san hau2sc h2ause hau4sel
+hau6s5ent hau4spa hau4spe haussen6 hau4sur hau2ta 4hauto hau4t3r ha2ve. h1a2ß
+2h3b4 hba4ras hbe3r2e 2h1c 2h3d4 hdan2 4hea he3be heb3eis he2b3l he3br he3bu
+he3ch2e he3chi he1cho h3echs he3cke hed2g he2dit he2el hee3le he1e4m hee2s
+he1e2t h2ef. he2fan he2fau he2f1ei he3f2em hef3erm 2heff he2fid he4f3ing
+he2f5l 2hefr hef4ra he2fre 3heft he2fu he3gu he2hel h4eib h1eie h1eif h1eig
+he2im hei4mal hei4man hei4mar hei4mei heim3p hei4mu 2hein heine2 hei4neb
+hei6nene hei4n3er h3eintr 4heio he1ism he1ist heit4s3 h1eiw hekt3a he2l1an
+he2l3au hel1ec he2lek h3elem he2len h2elf he3li hel4l3au hel4mei he3lo he4lof
+hel2or 2helt he2lö 2h1emb 3hemd he3mi he4mia h3e4miss 3hemm 2h3emp h2en.
+hen3a2 he4nas he4nat hen3ebe henen1 hen3end he4nene he4nens hen3erg he4nerm
+he2n1e4t henfal4 2henga hen4gag hen4kan hen4kau henst2 hen3str hent2a hen3te
+hen3tr h1ents 2h3entw h3entz he4n3u hen3z2 4he2o he3on he3op he3pa he3ph
+h1e2pi hept2 h2er. her3a2b he2rad 4herap he4r3a2r he2rat herb2 h2ere he2re2b
+he4reck her4eif 4he3reig he6reis. her7eises he2rel he4rene he6rersc he4rerw
+h1er2fo 6hergebn 2herif herin4d herin4f he6rin6nu herin4s h1erke her4klä
+h5erkran her3la h2ern he3ro he4r3o2b he4rof he4rop he4rot hert4 her3th her3tr
+her3um her4zap h3erzeu her2z1w h1erör he3s4a 4hese he3si he3s2p he3stro hes6tä
+he2tap heter2 he3th het2i he3t4s he3tä h2eu heu3g he2um 3heusc he3x he1x2a
+2hexp he1y2 2h3f4 hfaller6 hfan2 hfel2l3 hfi2s hflei2 2h3g4 hgas1 hget4 2h1h2
+hhoh2 4hi. 4hia hi2ac hi2ang h1iat 4hic hi1ce hich6t5er hicht6sp 2hid hi3d2e
+hi2e hi3ens hier3i hie4rin hiers2 hif3f4r hi2k3r hi2l3a4 hile3n2 hil2fr h2im
+2hima h3i4mit h4imm h3impe hi2n hi3nak hi3nam hi3nap hi5nas h2inde hi3nel
+hin2en1 h1inf h1inh 2hi3n2i hin3n2 hi3no hin3s2 hin2t1a 2hio hi3ob hi4on hi3or
+hi2p3 hi4pl hips2 hi4pu hi2r hi3ra 2hi3re hi3ri hir2m1a hir2mi hirn1 hir4ner
+hir2s 1hirt 2his. his2a hi2se h1i2so hi3tac hi2tan hi2tel hi1th hit2i hit3z2e
+hi2v1o 2h1j 2h1k4 hkamp2 h2keu h3kö 4hl hl2ag hla2gr hla2l hlan4d3a hl1ans
+hl1anz h1las h1lat h1laut h1lay hlb4 hl3d4 h3le. hle3a h3leb h3led hle3e4
+h3lein h2leis h3leist h5len. hle4nas hlenen3 hl2enn h4l3entr h4lents hl2enz
+h3ler hle2r3a hl4ere h2lerg hl2erk h6l3er4nä hle3run hl1erw h4lerz h3les
+h4lesi hles4t hlf4 h2lie h3lied h2lif h2lim hl1ind h2lip h2lis h2lit1 hl3l2
+hlm2 h2lo hl1ob h3loc h3log hlo2re h4lorm h3los. h3losi hlos4st hl4sar hl2ser
+hls3ka hl3s2lo hl5s6tern hls3tie hl5str hl2su hl3t2 h3luf h3luk h3lumpe hlz2
+h3läche h1läs h1läu hl2ö h2lös h1lüf 2h1m h3mad h3mag h3mak h3man h2mant h3mar
+h4marc hm2e h3me. h3med hme1e4 hmeer4s h3mein h3meld hme3le h3men hmen2s
+hme2ra hme1s2t h3mex hmi2e h3mil h3mind h3mini h3minz h3mirr h2mo h3mop h3mot
+hm3p2 hm2s hm3sa hms1p h2mu h3mul h3mä h4mäc h4mäh h4mäl h3m2ö h4möl 2hn h2na
+hna2c h3nag h3nam h4nar h4natt h3nau. hn3d4 hn2e hne3b hne2e3 h2n3ef hn3eig
+hn3ein h2nel hne4n1 hn4eng hne4pf h3ner hner4de hner3ei h4n3e2ro h4n3ersa
+hn4es hn3ex hn3f4 hnflei4 hnhof8stras h2nic h2nid h2nie hn1im h2nip hnk4 h2nor
+hn3sa hn3s2p hns4to hnsuch4 hnts2 h2nul h2n1unf hn3z2 h2nä hn1äh ho4ar ho3bern
+ho2b3l ho2ch3 ho4cha hoche2 ho2cka hocker4 ho6ckerl hock3t 4hocy 2hod 2ho2e
+ho2f1a2 ho2feu hof3f4a ho2f3l ho2f1o ho2f3r ho2fu ho2fä 2hoi ho2l1a2 hol3ar
+4holdy 3hole ho2l1ei hol3g4 hol3k holl2 ho2l1op hol3s 2holy h3olym 3holz
+hol6zene hom2e ho2me. ho2mec ho2med h2on 4hon. hond4 4hone hon2er 4hong 4honh
+4honk 4hons 4hony ho1on hoo2r 2hope ho1ra ho2rak h1o2r2an ho2rar ho2rau h1or3d
+2hore ho4rens ho3ret 2h1org horo2 ho2rop hor3ta hor4ter h1ortu hose2 ho2sei
+ho3sl ho4sla ho2sp ho3spr 4hosö 2hot. ho3th 4hotr 2hot3s2 2ho2w1 h1o2x ho1y2
+4hoz ho4ßene 2h3p4 h1q 4hr hra2b hr3a2c hr3ad hr1a2g h1r4ah h1rai h1rane hr3ap
+hr3ass h3rat hrb4 hr1c hr3d h2rec h3r2ech h3red h3ref hr1eh h4rei. hrei4ba
+hrei4br h3reic h3reif h4r3eig hr4eini h4reinl h4reins hrei3th hreli1 h3rep
+hrer6geb hr2erk h4rerla h6rer6leb hr2erm hrer3s hrer4sa hrer6tüc hr2erw hr2erz
+h3re2s3 hress2 hrest2 hre4t h2r1eta h2r1eu h2rev h3rez hrg2 h2ri h3ric h4rick
+hri4e h3riesl h3rin hr1int h4rist hrit6tel hrk4 hr3l hrm2 h3rog h3roh h1ro2l
+h4romat h4rome h4romi h4romo h4ron h1ropa hro4r h3rou hrr4 hr2s1ac hr4s3and
+hr3schl hr2sen hr2s1er hr2set hr4sh hr2sin hrs3k hrs3l hr4s1of hr3spa hrst2
+hr2su hr2tab hr2tan hr2te2l hr2th hr2top hrt3ric hrt2sa hrt2se hrt4sin hrt2sp
+h3ruh hr1ums h3rut h4ry hrz2 h3räu h3rö2s h3rü h4rüb 4hs h4s3acht h2s1a2d
+h2s1alk h2sall h4samt h2san h2s1as h2sath h2saud h2s3aur h2saut h4schan hs4cr
+h2s3ec hse2e h4s1ehr h2s1eie h4seind h6seinst h3sele hse4lin hse4mis h4s3endw
+h2s1erf h2s1erg h2serh h2s1erk h2s1erl hs1ern hs4erne h4sernä h2s1erw h2serz
+h2serö h2seth h2sex h3s2ext hsha2k h2s1i2d hs2im h2s3ing h3s4inni h4s3ita
+hs2kal h3skand hs1of h2sofe h2sop hs1org h2spac h4s3pani h2s1par h2s1pat
+h3spec h3spei h3sperb h2sph hs4pie h3spoi h2spro h2sprä hss4 h1sta hs3tabl
+h2staf hst3alt h3st2an h2s3tau h1stec h1stei h1stel h4stele h3s4terb h3s4tern
+h1s2ti hs3tie hs4tief h2stit h1sto h2stol h2stor h1str hst3ran h4s3treu hs4tri
+hstro2 hs3tum h1stun h2s3täu h1stü h2s1u hs2ung h2säh h2säug 4h1t ht1a h2ta2d
+ht2ag ht4akt. ht4akte h2tall h4talo h2talt h4ta2m h2ta2n ht3ane h3t2ank h3tanz
+h2tap h2ta2r ht3arr ht2a2s h2t3asi h2tasy h2t3a2t h3tat. h3tate h2tau h4tax
+ht3e4ber ht1e2c hte3cha h2t1e2d ht1eff ht1e2he h2teif h2t1eig h4t3eilz h2t1eim
+ht1ein h2t1eis h2t1eke h4t3elas hte6l5ei. h4telek h4t3elfe h4t3elit hte4m
+h2t1emi h2temp h4tenga h4t3engl h4t3enta h4tentf h4tents hter6de. hterer6s
+ht3erfü h6terfül h6tergeb ht3ergr hter6gri ht1erh hter6häl hter8höhu h6terleb
+h6t5erleu h6terneu ht5erspa hter8spar ht3erst h6tersta ht3erwä ht3erze
+h4t1e2se h4t1ess h3tet h4t1e2th h2t1eu h4textr h2t3h2 h4thei h3thera h3thes
+ht4heu h4tho h2ti2d h2t1im h2t1i6n3 ht3ine h4tisr htni2 hto2 h2t1ob htod1
+h2t1of h4t3oly h2tope ht1or h4tord ht3rak h3tran ht3rand h4t3ras ht3rat
+ht6rates ht3rau h4traub ht6raume ht3rec h5treck h4tref ht3rei h2trek h2t3res
+ht4ri h4t5ric h4t5rieg h4t5rin h2t3rol h2t3ros ht3ru ht3röm h2t3rü h4ts ht2sah
+ht2sal ht4s3a4n ht2scr ht4sein ht2sel ht4s3end ht4seng htse2r1 ht4s3eri htsha2
+ht3s4hak hts3k ht3skal ht2s1o ht2sp hts2ti hts5trau ht4s3tur hts3tät ht4s3tür
+htt4 htti2 h3tub htu2e h2t1urs ht3z2 ht1ä h2tär h3tü hu2b1a hu2b1ei hu4bel
+hu2b1en2 hu2bi hu2b3l hu4b5r hu2bu hu1c hu2fa hu2h3a hu2h1i h1uhr h1uhu hu2k1i
+huko1 huk3t4 hu2kä hu2l3a2 hule2 hu2l1eb hu2l1ei hu2lem hu4l3eng hu4lent
+hu2l1er hu2let hu2lid hu2l3in hull2 hu2lo hul3s4 hu2lä hu2lö hu3m2a h1umh
+2h1ums hu2n h1una hun3d2e hunde3i hunde3s 2hunf hung2 hun3ge hung4s hungsa4
+h1uni h1unm 2hunt h1ups 2hur hur3g2 hur2th hu3sa hu2so hus2s3a hus4ser4
+hus2s1o hus2sp hus2st hu2tab hu2ti hu2t1o4 hu2t3r hut2t hut4zen hut4z3er
+hut2zu 2h1v hvil2 2hw2 h2wall hwe1c h1weib h1weih hwein6sa h2wirr hy2lor 3hym
+h1yo 3hyp hy2pe. 2hy2t 2h1z hz2a h3z2o hzug4 h3z2w häde2 h1äff hä2kl 2härz
+hä6s5chen 2häug häu2s1c hä3usp 1hè 1h2ö 2hö. hö2c hö3ck h4öh 5höhe hö2s1 h3öst
+h2ü hübe4 h3über h4übs h3übu hüf2 hühne4 hüs3 i1a i2aa i2ab i2ache i3ad. ia3do
+i2af iaf4l i2ag i4ago i2a1h2 i2aj ia2kei ia2kr i2aku i3al. i3a2l1a2 ial3ar
+ial3as i3al3b4 i3alc i3al3d4 i3a2leb i3alef i3alei ia3lek i3alel i3aleng
+i3alent i3alerb i3alerf i3alerh i3a4lerm i3a2l1et i3alex i3alf i3alg i3a2lia
+i3alim i3a2lin i3alj i3alk i5al3l iall2a ial4ler iall2i i3alm i3aln ia2lon
+ia2l1o2r ial3p i3alr i3als i3al3t4 ia2l3u4 i3alv i3al3z2 ia2lä i2am i3am.
+i3amp iampe4 i3an. ian2a ia2nal ian3alt ia2nau i3and2 ia2n1e2b ian2er i3anl
+i3ans ian2s1p i3ant i3anw i3anz ia1o i2ap ia3pf i2a1q i3ar. i2a2ra i4ari i3as.
+ia3sh i2asi ia3s2p ias3s iast4 i3at. i4ate i3at2h i4athe 1iatr i3ats i3au
+ia3un i2av 2i1b ib1art i2b1auf i2b1aus i2baut ib2bli i2b1eig i2b1eis ibe4n1
+i2b1ep i6ber6geb ibe1ro i2bim i2b1in i2blad i2bleu i3blu ib2o i2b3rau i2b3ren
+ib3ric i2b3roc ib2ser ib4ste ib2un i2b3unk i2b3unt ibus1 i2bö 2ic i3ca ic1c
+ice1s ich1a ich6art. i1che ich1ei ich2er icherin5 i1chi ich1l ich3le ich3li
+i3ch4lo ich5m ich3n i1cho ich3ort i2ch3r ich6sele ichsen3 ich2s1i ich4spe
+ich6stie ich4tab ich4tan ich2tr i1chu ich1w ich1ä i1ci ic1in i3cke ick1s ickt2
+i1cl ic3la i5cu i1d id2ab i3d2ac id1a2n i3d2ans i3dat id1au id2ax i2dea 1idee
+2idel idel4ä i4demu ide4n1o iden4se ide2on i3der 4ider. iderin8nu ider6reg
+ide1rö ide3so ides2p 2idia 1i2dio idi1s idni3 id2o i2dol 2idoo 2i2d3r id4ru
+i3dsc id2set id2s1p idt4 2idu 1i2dy idä1 i2dö ie3a2 ie2bl ie2b3re ie2bri
+ie4b3rü ieb4sto ie2bä ie1c ie2cho iech3t ie2ck ie2d3an ie3de ie2dr ie1e2
+ie2f1an ie2fau ief1ei iefe2m ief3f4 ief2i ie2f3l ie4fonk ief1r ie2fro ie2fäh
+ie2gl ie4g5li ie3g4n ieg3r ieg4ra ie2gre ieg4s3c ieg4se ieg4st ie2h1in ieh3r4
+i1ei ie1ind i2e2l1a iel3d4 i2ele ie2l1e2b iel1ec iel3eid ie2lek i4elen ie4lene
+ie4leng ieler4e ieler6fi ieler8geb ieler6ke ieler6la ieler8lebn iel4erw ieles4
+ielf4 ieli2d i1ell2 ie2lo2b ie4lor i2els2 iel3sz ielt2 iel3ta iem2e 2i1en
+i3en. i3ena ien1ag ien4am ie4nas i3end i2ene ien1eb i3enec i3e2nek iener6fo
+ien3er4g iener6la i3enex i3enf i3eng4 ienge4f ienge4z i3enh ie2nim ie4n3in
+i3enj i3enk i3enla i3enle i3enm i3enn i3e2no i3enp i3enr ien2s i3ens. i3en3sa
+i3en3sc i3en3s2e ien3si ien3s2k i3en3s2p iens6t5er ienst5rä i3en3sz ien4tar
+i3enth i3enty ie3nu ie4num i3env i3enw i3enz i3enä i3enö ie1o4 ier3a2 ie2rad
+ie2rap i3erbun i2ere ie4reck ie4r3eis ie3r2er ierer3k ie4r3erz ie2ret ierf4
+ierg4 i1ergi ierk2 i1ern i3ern. i2erni ie1ro ier4re. ier4s3eh ier3sei iers2t
+ier3sta ier3ste ier3te ierts4 ier3z2 ie2rö ie2san i2esc i2ese iesen3s4 ie3s4pa
+ie4spu ies6ser6g ies2st iess3ti iest6e ie1str iet1a ie4tag ie2tan ie2tap
+ie2tat ie2tau ie4t3ent ie4t3erh ie4t3ert ie4tha i4ethe iet3her ie2t3ho ie2thy
+ie2t1o4b ie4t3ri ie2t3ru iet2se i1ett iet3zw ie2t1ö2s ieu2e i2e1un ie2w1u
+i1e2x 2if if1ar i2f3arm if4at i2f1au i2fec i2f1ef if1ein if2e4n i2f1erg if1erh
+if2far if2f3l if2fro iff2s iff4ste if3l if1lac iflo4 if4los i1f4lä i1flü if3r
+i1fre if4rev if2s if3sa if3se if3sp if3sta if4t3a if2ted if2t3ef if4t1ei
+if2te2l if2tep if4terk ifte2s if4t3esc if2t1op ift1r if2tra if4t3ri if2tro
+ift3sp ifts2t ift3sz if2tur i1fy if1än 2i1g iga1i i2garb ig1art iga3s4 i2g3att
+igd4 i6gebrau i4gefar ig1ein ige4na ige6nene ige4nid ige2ra ig5erwer ig1erz
+iger4ze i2g1ess i2gim i2gl i4glag i4g3lim ig4na i3g4neu ig4no i4gnä i3g2o
+igo1p ig3rad ig3re ig4ren i2grou ig3sa igs2ag ig4sal ig4schr ig3s2o ig3sp
+ig4spa ig3stei ig4sti ig4s3to ig3str ig6stras ig3s4tü ig3sä igung4 2i1h i2har
+i5hea ihe1e ih1elt ihe4n ihe1u ih3m ih3n ih3r ihs2 ih1um. ih1w ii2 ii3a4 i1ie
+i3ig ii3h i1im i3in i1i4s i2is. ii3t i1it. i1j 1i2js 2i1k ika2ge ik1ak ikaken3
+i2kakt ik3amt i4k1ang i6kantei ikanten8n ik1art ik3att i2k1au i3kaz 4ike i2keb
+ik1ebe ike2c i2k1ed i2kef i2k1ei ike4l1 ike2n1 i2k1ens ike2ra i2k1e4r2e
+i2k1er2f i5kerfam i2k1er2h i2ker2l i2kero i2ke3ru i2k1eta i3ki. i3kie ik1in
+i2kins iki1s i2k3l i3k4leri i3k4let ik4lim i3klu ik4län i2kne i2k1off iko1p2
+ik1o4ri iko1s i2kres ik4ris ik3rä i2krö iks2 ik3sa ik3ste ik3sz ikt3erk
+ik4t3esk ik2t3re ikt2u i2k1uh i2kup i3kus ik1äh i2kär i2köl i2kü i1la i2lab
+ila2br i4labs i2l1ac i2l1ak il3a2ma il1anm il1ans ilan6zer i2larb il1asp
+i2l1au i3laub i3l4aufb 2ilb ilb4l il2c il5chen il2da ild3ebe il4d3en4t il3der
+ild4erp ilde2s ildi2 ild1o il2dor il2dr 2ile il1e2c il1ein il1el i2lemb il1ent
+i4lentl i4lents i2l1erd iler4ei i6lereig il1erf iler4fo i2ler2g i2l1er2h
+i4lerkl il1err i4lerri il2erz ile4th il1ex ilf2 ilfe3s il2f3l il2f3re ilf4s3
+il2gl 2ilh 2ili ili3e4n3 iliga2 ili4g3ab ilik4 i2l1ind i4l3init il1ins i2l1ip
+i3lip. i3lips ill2an il4lenn il3l2er 1illu il2mak il2m1ap il2m1au ilm1ei
+il2min il2mor 2ilo il1ob il2of il2oh il2op i2l1or i3lou il1ox il4sein ils4to
+ilt2 il3t4h i1lu i2lum ilung4 il1ur i3lus 2ilv4 il2zar il2zau ilz1er il2zwa
+i1lä1 i2lär ilü4 imad2 ima3i im2al i2m3anh im1ans i2marc im3aren i2m1arm
+i2m1art im4at imat5sc ima4tur i2m1aus i2maut 1imbi i2meg im1ein i2mej i2mek
+i2mele i2melf im2en i2m1erf i2m1erl i2m1erz i4me3sh i2meti i2mew i2m1i2d i2mim
+i2m1ind i2minf i2m1ins im2mei immen1 imm3ent im6menth 1immo im2mä 2imo i2m1ob
+i2mo2p 1imp imp2fa im3pfo imp2s im3pse im3sph 2imt imt2e im3t2i imt3s2 imtu2
+2imu im2um im1urk i2mö 2in. in3ab ina2be in1a2c in1ad i4n3ae i3nald inaler4
+ina6lere in2alp in1am in2an in3ana in3ann i2narb i2narm in2ars in3att i2n3au
+2ind. inda2 ind2ac in2dal in2dan in3de 2inde. ind4eid 2inden ind5erke inde3sp
+1index ind2i 1indik 2indr ind4ri ind3se 1indus 2indä in3dö in3d2ü 2ine
+i4ne4ben in1e2c i3nee i2neff in4elen in2em i2neng i4n3enzy ine3nä i5ner.
+i4n3erbi in2erh in3erle i6ner6leb iner4lö i4n3er4tr i4nesk in2et in1eu ine3un
+ine2x in3f4 1infiz 1info 2ing 4inga in2g1af in2g1ag in2g1al in2gam ing1ar
+in2g3at 3ingeni in3g2er in4g3erw in2gl in3gla in3glä in2gor ing4s3am ings6por
+ing4s3pr 1inhab 2inhar 2inhau 2inhe in2i3d 2inie 2inig ini3k4r 2inis ini3se
+init2 i3nitz 3inkarn 1inkas in4k3ent ink4er in3k2ü inma4le 4inn. inne4n
+in4ner4m 4innl in2nor 1innta 2ino in1od in3ols in1or ino1s inost2 i3no3t
+i2n1ou 2inri ins2am in6samt. insch2 2inse. in2seb 2insed 2insen ins2i 2insk
+in4sm 3instal 2inst2e ins4tip 3instit ins4to 4instra ins4tri in4strü in4s3tät
+1insuf ins3umz in2sur in3s2z 2inta in3te 2inte. 1integ 2inth inthi1 int2o
+2in3t4r 3intrig 4inträ int3s 2intö i2n1u i4nuh in3unz 4inverm invil2 i1ny2
+in3z2e inz2i inz2u in3zwä 2inä i2n1äh in2är in1äs i1nö in1ö2d 2i1o io1c io2d
+io3e4 i2of iof4l i2oh io3k6r i3ol. i3om. io3me i3oms ion2 i3on. ion3an io2n3au
+ion3d2 io4nee i3ong i3onn io2nor i3ons3 i2ony i2oo i2o1p i3o4pf i3opt i2or
+i3or. i3orc ior2e iore4n io1r2h i3orp i3ors i3ort i3os. io3sh io5ska ios2p
+i2o1st ios2u i2o3sz io3t i3ot. iot4r i3ots i2ou i2ov i3o2x i3oz. 2ip. i1pa
+ip2an i1pe i3per 2ipf2 i3pfan iph2 2i1pi ipi3el ipi3en ip2pan ip3pe ipp1f
+ip4pl ip3pu i1pr 2ips ip2sa ip2sei ip2sp ips3t ip4sta ip4stü ipt2a ipt2u 2ipu
+2i1q i1r4a i3ra. 2i3rad i3ras irat2 ir2b3l ir1c ir2ch1o ir4e i3ree 2irek
+ire4na irg4s ir2he ir2i iri3a 2i5rig 2irk irke4n ir4kene ir2k3l irli4n ir2m1ag
+ir2mak ir2mau ir2m1ei irme4n1 ir2m1o2 irm4th ir2mum ir4munt ir2mä 2irn ir2n3a
+ir4nat ir2no i3ro 1iron irpla4 irre4l ir2rh ir3sche ir4schl ir4schm ir4sch3r
+ir4sch3w ir3se3 ir3s2h ir2st irt2s3t 2iru ir1u2m iru2s1 i1rä ir1äh i3ré i1rö
+i3r2ü i3sac i4samp i4s1amt is2ap isa2r is3are i3sat i2sau is3auf isau2g 2isb
+i2sca i4schar i3s2che i4schef i4sch3e4h isch3ei i6schemi i6scher6z i4schin
+i5sching i2schl i2schm isch3ma i4schna i4sch3re isch3ru i4schwa i6schwir
+i4schwo isch3wu i4schwü i4schüb i2scr 2ise ise3a ise1e iseh2a ise3hi is4eind
+i4seint is4e3li i6sel6ter ise2n1 ise4n3a2 is2end isen3s ise4r3ei is1erg i2serh
+i2s1erm i2s1es4s is2et i4s3etat i3s2eu 2isf 4ish isi2a i2s1i2d isin3g4 isi1s
+i4ski i4sku is3la 3islam 2isma 2ismi i2s1of 1i2sol 3isom is2o2n3 isonen4
+iso6nend i2sop is1org is1ort 3i2sot 2isp is1pa i2spar is1pe is1pic is2por
+i2spro is3sa is4s1ac is4sau iss3che is6schen iss3erf iss2po is2st is3sta
+iss3tr is3stu is2sum is4tab ist3a2c ist2an is3tang i1stat ist4e i1stel iste4n
+istes3 i1steu ist2id i1stil is3t6o is4toc is3tr ist4ra ist3rei i1stro is4tru
+is3täu is3tör i1stü i3suf isum3p i2säh i2s1än i2sü it1ab. it1abs ital3a it1alt
+it1a2m it1an ita3ne it3anr ita2po it1app it1a2re it1art i3tat it1au i3tauc
+i2taut it1eff i2t1ei it2eic 2itel ite4l1a i4telek i2temp ite2n iten3s2 i4tents
+i2tepo i6tereig i8t7ersche it2erö i4t1esk i2t1ex i3text i5thr i2thy i5tic
+i2tid i5tig 1itii iti3ker it1in1 i4tiso iti3sp iti2v5a itmen2 4ito it1ob
+ito4be i5toc i2t1of it1o2p it2os 2itr it3raf it3ras it3rau it3re i4tref it4ret
+it3rob it3rom i2t3run it3räu it2sa its1ag it2s1e it4se2h it4s3e2r1 it2s1o 2itt
+it2teb itt3hä it2t1o4b it2top it4tri itt3rol itt6schi itt4seh itt4sei itt4sor
+itt2sp itt4sti it1uh it1ums it2ung i2tuns ituran4 it1urg itut4 it2ze2c itz2er
+itz3erg it6zergr it4z3erl it2z1w 4itä it1änd i2t1äs ität2 i3tü 2i3u2 iu4m1
+iuma2 ium4se iun2 ius3t 2i1v i2v1ad i2v1ak i2v1am iv1an i2veb i2v1ef iv1ei
+iv1elt ive4n iv1ene i2v1ent ive3re iver8folge iv1erh iver4kl iv1erl iver3s
+ive3s i2v1e4x iv1ins i3vol i2vr i2vun i2v1ur i2v1ä 2i1w 2i1x i2xa ix2em i3xi
+ixt2 4i1z i2z1ag i2zan i2z1ap i3z2as iz1au i2zaus izei3c izeits4 i2zele ize2n
+i4zener i2z1erl iz1ir i2zo2f i2zuna i2z1w i3z2wi izz4a i2zän i2zö i1ß iß1er2s
+2iä i1ä2m i1äp iär2 i1är. iär3m i1ärs i1ät i3ä4tem iä2ti iä4tr iät3s4 i1ñ
+i1ö2k i1ön i1ös. i1öst i1ü4 j2a jab4 ja1c jah4r3ei jahr2s ja3l2a ja3ne jani1
+jani3t4 ja5ru jas2o ja1st jat2 je2a jean2s je2g jek2ta jek4ter jek4tin jekt3o2
+jektor4 jek4t3r je2p jes3t je2t1a je4t3h je2tin je4tor je2t3r jet3s2 jet3t
+je2t1u2 je3v je3w ji2a jit3 ji2v joa3 jo2b1 job3r jo4da jo2i jong2 jo1r2a
+jord2 jo2sc 3jou jou2l j2u ju2b3l jugen6 jugend3 ju1i ju2k ju3l2 jung3s4 ju3ni
+ju3r4a jur2o jus3t ju3t2e1 2j1v 1ka ka3ar 2k1abb kab2bl 2kabd 2k1a2ben 2kabf
+2kabg 2kabh 2kabn 2k3a2bo 2k1abs 2k1abt 2kabw 2kabz ka1c kade2r 2k1adm 2k3a2dr
+3kadu 2kadv ka1f4l ka1fr kaf3t2 kag2 kaga3 2k1age 3kah ka1ho ka1in kaken2
+ka1k4l 2k1akt. 4kala. kala3b4 ka2lan kal3d ka2leb ka4l1eh ka4lens kal3eri
+3k2alk kal2k1a kal4kan kal2k3l kall2i 2k1allt ka2lop ka2l1os kal4tex kal4th
+ka2lu k2amt kan4al ka4n1a2s ka2nau 2kanb kan3d4 2kanda 2kandä kan2e 2kanf
+2kanim kank4 2kanl 2kanom 2k1anor 2k1ans k2ans. kan4tar 6k5antenn 2k1anth
+ka3nu 4kanw 2k1anzu 2kanzü 3kanä ka2o1 3kara 2karbe 2karc k2ard kar3d2a k1area
+k2arg ka3r2i kari3es k2ark 2k1arm kar2pf k2ars k2ar3ta k2arte k1arti 4kartik
+karu2 k2arw 3k2asc kasi1 kas2o ka4sp ka2s3t 2k1ast. ka4ste kas6tras 3kasu
+ka3sz ka2tan 3kateg ka3t2h ka4t3r 2katt4 kau4fer kau2f1o kauf4s3a kauf4sp
+kaufs7tem k2aus. 2k1auss 2kausw kau3t2 2kauto 2kaz 4k3b4 kbo4n kbu2s kby4 2k3c
+2k3d4 ke2ben 2k1e1c ke2di k1ef 2keff kefi2 kege2 ke2gl ke2he. ke2hen kehr2s
+kehr4s3o 2k1eic 2k1eig kei2li ke2im 2k1ein ke1in2d kein4e k1ei1s 2keise keit2
+ke2la kel1ac ke3lag ke4l3am kel1au kel3b4 keld4 kel3eis 2ke2lek ke2l1en
+ke2l1er kel7l4e kell2i ke2l1o2 kel3sk k4elt ke2lä ke2lö ke2mi 2k1emp k2en.
+ken1a ken3au kend4 ken3dr ke2n1e2b kenen1 ke4nene ke4nens kener4n 4ken4gag
+k5en6gel. ke2nim ken3in 4kenlad 4kenläd kenn2a kenn2e ke2no 4kensem ken3si
+ken3s2k ken5s6tei ken3sz k3en4te. ken6ten. 2kentf 2k1entg ken3th 2k1entl
+2k1ents 2kentw 2kentz ken3z2 2ke1o2 2kep ke2pl k2er. ke1ra ke2ran ke2rau
+ker4ble k2erc 2kerd ke2re2b ke3reig ker3ein 4kerfah k4erfam ker2fo ker5g
+k3ergeb 2kergu ke6rin6nu kerin6st kerin4t k3erken k2erko k2erl k3er4lau
+k3erleb k6erlebe ker2na ker4nei 4k3erneu ker6n5eur k1ero ker8oberung. ke1rod
+2k3eros ker4reg k2ers. 2kersa kerz2 k1erz. ker4zeu 2k1er2zi ke2r1ä k6es. ke2sa
+k1ese ke2sel kes2sa ke4t1a ket2ag kete4 ke4t1eb ke4tel ke4th ket3ha ke2tu
+ke1up keu6schl 2k1e2va 2k1e2x key3 4k3f4 2k3g2 kga4s1 kge3s4 2k1h4 kho3m k3hu
+ki3a ki4ad kia2r ki1c 2ki2de ki3d4r k2ids 2kidy ki2el kie4lei kiel3o 2kiern
+kier2s kier4st kie2z ki1f4l ki1f4r ki3k4 2ki3l2a ki3lo 2kilä 3kin. 4kindex
+2k1indi 2k1indu 2k1inf king3s 2kinh k2ini kini3k2 k2inn ki3n4o3 kinos2 kin3s
+2k1inse 2k1inst 2k1int ki3or kio4s 3kir 2k1i2so kis2p kis3s kist2 kis4to kiv2
+kive4 2kiz 2k3j 2k1k4 kkab4 kl4 4kl. 4kla. 2k1lac klan2 2kland klan3du k4lar
+k1last k1lauf k3laug k2le 4k3le. kle2br k3leg 2kleh k3leit k3lem. 2k3ler
+kle2ra 2k3leu kle3us 2klic k2lien k2lif 2klig 3k2lim k2lin k3lin. 3k4lina
+k4link k2lip k2lir k2lisc 2klist klit2s 2k3liz 2k3loc klo2i3 2klok 3k4lop
+k3lor 2klose klost6 k1lu klu4b k2lud k2lug k2lum klung4 2klux 2kly 2kläd k2lär
+2klöc 2klöf k2löst k4löt 2k1lüc 2k1m 4kma kma2la k2n2 2k5nach 2k3nad 2knah
+2k5nam k3ne k4nec kne1e 2knes 2knetz 2k5neu 2kney 2k5niv kno2b3l k4nol 2knorm
+2knov k3nu 2knum k6nur 2k3näp 1ko ko5ad ko2al 2k3oas kobal2 2kobj kob4s
+2k1o2fe kof3f2 koh4a kohl2e kohle3i koh3lu koka3 ko3l2a ko3le kol2k3 3kom
+4k3omn ko4mu k2on ko2nem kon2i kon3s4 kont6e ko2nu 2kop. 2ko1pe kopfa2 kop4fen
+kop6f5err 2kop3s ko3pte 2kopz ko3r2a kor2ba kor2bl kor2br 2k1orc korden3
+korder4 kor6derg ko2rel 2k1org ko3ri kor3m kor4nac kor4no2 kor2n3ä 2korpi k2os
+ko4sk ko2sp 3kost ko2stü k3osz ko2ter ko3ti kot4r kot3s2 kot4tak k1ou ko3un
+3kow ko2we 2k1o2x 2k1p2 2k3q k2r2 2k3rad 2k3rah k4ral k3rats 2kraum k4raw
+k4raz 2k5re. 2k3reak 2k3real 2k3rec 2kred. 2k3rede 2kredn 2kredu 2k3ref 4kreg
+2k3reic kre1i2e4 kreier4 k3reif 2k3reih 2kreim krei6sei krei4st kreli1 k3ren
+k3res 2kresu k3rev 2k3rh 2krib 2k3ric 2k3ries 2krip k3risi krob4 k4roch 4k3roh
+k4roi k4rok k4ron k4rop 2krot 3kroth k3rou 2kruf 2k3run k4räc 2kräd k4rän
+2k3räum 2kröh 4ks ks3a2b k3sac ksa2k k4s1amt k2san ks3a2r k2sau k2sav ksch4
+ks2chi k2s1e2b k2s1ec ks1ei ks2eid ks2eif k4seind ks2end k2s1eng k2s1ent ks1er
+ks2ere k2serf k2serg k2serk k2serl k2sers k2serw k2s1e2v k2sex ks3ha k2s1i2d
+k2s1in k2s1is ks3kl k4sm ks1o ks2on k2sop k2so2r ks1pa k2spal k3s2pat k3spe
+ks2pel k2sph ks2por ks2pul k2spä ks3s4 k1st2 k2stal k4s3tanz k3stat4 ks4tel
+ks2tep k4stier k2stit ks4tol k2stor k4strop k2stuc k2stum k2stur k3stäl k2stüt
+k2s1u k3sul ks2zen k2säh k2sö 4k1t kt1abr kt1abs k2t1ad kt1akt k3tal kt1am
+kt1an kt2and k2t1a2r kta4re kta3ri k2t1au kt3aug kt1ein k4t1ela kte4n1 kten3s2
+k2tent k4tentf k4tents kten3z kte1ra kt4ere k4t3erfo kt1erg k2t1erh kte3ru
+k2terö kt1eta k2tex k2t3h k2ti2d kti2me kt3ing kt1ini kt3inn k2tins ktion4
+kt2is kti2s1e kti4ter k2t1of k3t4ran kt3ras k2t3rau k4tref kt4ro ktro1s kt3run
+kt3rü kt3s kts4a kts2el ktsen1 kts1o kt4sor kts2pa kts4t kts2ti kt1s2z ktt2
+k3tub kt1ums k2tuns kturen4 kt3z ktä3s kt1äu k3tü ku2al ku1c kud4r 3kug ku2h
+2k1uhr ku3la ku3l2e ku3l2i 4kulp kul4to kul2tr kum2e 2kumg 2k3uml kum2s1
+k2u3n2a kun3da kunden3 kung4 kun4s4 kunst3 2kunt 2kunw 2k1up. kur2bl ku2rei
+kuri2e kuri4er 2k1urk ku2ro kurs1c kur2sp kur4ste kur4str 2k1urt kus3a2r
+ku4schl ku2sp ku2s3t ku2su 2kut. 2k3v 2k1w k3wa 2k3z2 kze3l 1kä k1ämi 2k1änd
+kär2 2k1ärg kä2s1c käse3 1kö k2öf k1öl 1kü kü1c 3küne 3kür kür4s 3la. la3ar
+l1ab 3l2ab. la3b2a 2labb lab2br 2labd 2la2ben 4labf 4labg 2labh 3labil la2bit
+2la2b3l 2labn 3lab2o 4labo. la3b4ra 2labs la2bus 2labw 2labz l2abä la1ce
+la2ce. l2ache lacks2 1lad 2l1ada 2ladd 3laden la3d2i 2ladj 2l1adl 2ladm
+2l1a2dr 3l2adu 2laf la2fa la2f1ei laf1r laf3s laf3t4 la2fu 3lafü la2g1a lag3d
+l2ager 4lagg la2gio lag3l la4g3n lago4 la2gob 2la1ho 3lai lake2 la2kin l2akk
+la1k4l la2kro lak3t 2l1al 3lala. la2lar 3lali 4lalt l2ama lami3t lam2m1a
+1lammf 2lamn la2mor l2amp 2l1amt lamt4s la4mun la2na la3nad la3nan la4nat
+la4nau 3l2and lan2da lan4dam land3au lan6d5erw lan6d5erz lan6d5inn lan2d3r
+la2nem lan3erd laner4f 2lanf lan6g5esc lang3s4 2lanha l2anhe 2lanl 2l1ann
+l1ano la2nof 2l1anp 2lans2 l1ansi 2lantw 2lanw lan2z1w 2la4nä 3lao 2l1apf
+la2ph l1a2po lap2pl la2r1an 2larc lar1e2b la2r1ei la2rel la4rene larf4 lar3g
+lar3ini 2larm. la2ro 2l1arom l1ar3t lart4h l3arti 3laru l2as. la4sam 4lasd
+la5seb la4sei la4s1e2l l2asg 2lash la2sin la2sis 2lask la2so 2la4sp 3lasser
+lass4tr l2a2st las4t3an la4ste las4t3ri la4stu la4sä lat2ak la3t2e la4tel
+la5t4i 2l3atl 2latm lat2o la2t3ra lat4ri lat2s lat3st 2lat2t1a lat4tan lat4tex
+lat2th lat4t3in lat2t3r latzer4 la2tö 1laub. lauben6s5 lau2b3r laubs1 laub4se
+lau4fin lau2fo lau4fri 1laug lau3gl 2laun. la4us 3l2aus. 2l1ausb lau6scha
+2lausd 2lausf 2lausg 2lausl 2lausr 2l1auss 2lausw 2lausz 2lauto lau2tr la3va
+lave4n 1law lawa4 l2ay 4l1b l3bac l2bant lb3a2ri lbau1c lbb4 lbby4 l4b3eink
+l4b3eise lbe4ral lbe3rei lberin5 lbe7s l4b1e4ta l2b1id l2b1ins l3b2lat lb3le
+l2bled l2bli l3blo l3b2lu l3blä l3b2lö l2b1o2ra lb3rea lb2s lb3sa lb3se lb4sh
+lb3si lb4sk lb3so lb3sp lbs4t lbst3ac lbst3ei lbst1u l2b1uf l3bum lbu4n lbzei2
+lb1ärm 2l1c l3ca l3che l4chei l4chent l3chi lch3le lch3li l3chlo lch3n lch1ob
+lch3r lch3s2 lch1w lch3ü l2ck l3cl l3co 4l1d ld3a2b1 ld2ac ld3a2ck l2dad l2daf
+lda2g l2d1ah l2d1ak l2d1al l2d1a4n ld3ane lda2r l2d3ari ld1arm ld1ass l3dat
+l4d3ato l2d1au ld3aus l3de. lde4ben l2dein7 l2deis l2d1elf l2d1e2mi l2d1ems
+lde4na lden5erg l4dentl l3der. l4d3erfa l6der6geb ld1erh l4der4he l3d2erl
+l6d5erlas l3d2ern l2d1er2p lder4tr lde3sa l2d1es2s lde3str l2dex ld1id ld1i4mi
+l2dob l2dop ldo2r l2d1ori ld2os ld3r ld4ram l2dran l2drec ld5rie ld4ris l3d4ru
+l2drüc ld3sa lds4an ld3st ldt4 ld3th ldt5s ld3tu l2d1ul l2d1um ldwes4 l3däm
+ld1är ld1ät ld2ö2 1le le2ad le3ar le2as leben4s3 le2bl le2b3re 2lec lech1a
+le2chi lech7t6e le2er le3f2a 2l1eff le2g1ab leg1as lege1i le2gl lego3 leg4r
+le2gä 3leh 4lehe. leh3r2e 4lehs 4leht lei4ble l2eid leif1a lei4fan lei4fei
+leifer6g leif3s 2l1eig 3leih lei3l2 leim3p l2ein. l2eind lein4du l4eine
+lei6nerb le2inf le2ini 4leink 4l3einsa 2leint l2einu le4is leisch5a lei8schei
+lei6scho lei6sern l1eisf leis6s5er l4eist lei4str l2eit lei2ta lei4to leit3sk
+leit3s4t lei4ßer 3leko 2lektr 2lekz 3l2ela le2le le3lei 2lelek 4leleme le3len
+le3les 2lelf. l2eli l2em. le2mau le2m1ei 3lemes 3lemet lem1o2 le2mor 2lemp
+lem3s le2mu le4mun l4en. len1a le4na2d le4n3an le4n3a4t 2lency 4lendet l1endp
+4lendun le2n1ed 4lenerg le4neur 4leneuv len4gag len4kau len4k3lo len4klu
+l1enni len6sein 4len4sem len3ska len3sz 2lentf 2l1entk 4lentla 2lentn
+4l3en4tro 4l3entw 5lentwet lent4wä 2lentz 2l1enzy leo2f le1os 2lep 3lepa 3lepf
+4l1e2pi 3lepr lep5t l2er. l2e1ra le2rag le2rap le2ra2s le2rau le2re2b ler2e3c
+l3ereig le4r3ei4m le4r3eis le2rel le4reng le4rerg lerer5k le4rers l3erfas
+2l1erfo l2erfr l2erfü l1erg l2erga l4ergef 3lergeh 6lergen. l4erger l4erges
+3l4ergew 2lergi l2ergl l2ergr 4l3erhol lerin4s lerk2 l2erka 2lerke l1erkl
+l4erkle 4lerklä l2erko ler3kr ler3l 5l6erlebe 3l4erlei 2lermä ler4nal 3l4erne
+ler4nei 3l2erra ler4ric l4ers. l1ersa lers2t ler4sto le2rup l4erwa ler4wer
+2ler2wo 2l1erz l3erzeu ler2zo ler2zä le2r1ä 2l1erö l4es. les2am les4e le3sei
+2l1esel le3s4h lesi1 le3sk les4ki les2ko le2spo lest6 le1sta leste3r lester6i
+les2ti le1sto le1str 3lesu 4lesw 2lesy 2le2tap 2le2tat le2thi let2i letsche6
+let4tel let4top lett1r letts2 le2u 4leue 3le3u2f l2euk 2l1eul le3unt 2leuro
+3l2eut le2vol 2lex 3lexik le2xis 4l1f l3fah l2f1ec lfe1e lf3einh l2feis lf2en
+l4ferei lfe4rel lf1erl l3fi lf3led lf3lo l3f4lu l3f4lä lf3ram lf3res lf4ru
+lf4rü lf2spe lf2s3ti lf2su lfun2 lfur1 2l1g l3gas lga3t lgd4 lgen2a lgens4
+lgeräu3 l2geti l3g2i lg2lö l3go lg4p l3g4ra l3g4ro lgung4 2l3h2 4lhe 3lhi. 1li
+li2ad li4am. lian2g li2ast 3lib4 libi1 li1c lich4ta lich4to 4lick li2cka li2cl
+li3d2a 2l1ido li4ds 3lie. liebe4s5 li1efa 3liefer li1efk li3efl lie4n1a2
+li3ene lien3s lie4rei lier4sp lie2s1c 3lig li4g3ers lig4n lig4ra li2gre li3ker
+lik2o likop4 lik2sp lik4ter lik4t1o2 lik2u li3l lil2a li3m2a1 limas4 limat4
+2limm 3limo 2limp lin2a li3nar 2l1indu li2nef li2neh li2nep li2nes 2l1inf
+2l1inh li5nie lin1it 2l1inj lin4kan lin4kar link2s li2nol l2ins. l2insa
+4linsel 2linsp 2linst 2l1insu 2linsz 2l1int li3nu 2l1inv 2linz li2o li4om
+3li1pf 3lipt 3lis. li3s2a li3schm li4schu 4lis2h li3shi 2l1isl 2lisol 2lisot
+li2sp liss4 3list lit4a li2tal l2i3t2e li4t3r lit1s2 lit3se lit3sz li3tu
+li6tun li4tur litz4er li3t2ä 3liu liv2e li2vea li2ves livi3e li3vr 4lixi
+li2zau lizei3 li2z3ä 4l3j 2l1k l3kale lk1alp l3k2an l3kap l3kar. lk1erd
+lke3r2e lk2l lk3lad l3k4las lk3lic l3k4lu lk2men lk4ne lk5ner lkor2b1 l2k3ru
+lk2s1 lkse2 lk4spe lks3t lkt2 lk2ü 4l1l l2labk ll2abr l2labt l3labu l3lage
+lla3gl l2l1am ll3a2ma l3lame ll2anb lla4ner l3lans. ll4anwa ll1anz ll1arm
+l4latm ll3att ll3aufg ll1aus llb4 llch4 lld4 ll5ebene l3lec ll1ech lle3er
+l2l1ef ll1eic ll1eim ll2eis l4leise lle2la l3len. lle4na llen3dr ll5en6dun
+l4lentf l4lents l3lep l3ler. lle2ra ll2ere l6lereig ller4fo l8lergene l4lergo
+l4l3ermi l4l3ernt ll3ertr ll2es ll1exe llf4 llg2 lli4gan l2limb l2l1ind l4linf
+ll1ins ll3k4 ll3l2 ll5m2 ll3n2 ll1ob l2lobe l2lof ll3ol ll1opf ll1or l4lorb
+l2lo2ri l2l1ou l3low ll2s1es ll3ska ll2spr ll2säu ll3t llt2e llt2i llti2m
+llts2 ll1ur llus5t6 l3ly ll3z2 ll1äm l2lär 2l1m l2m3a2b l2m1ad lm1a2ge lm1aka
+l2m1a2m l3mana lm1apf lm1art lm3att lmbu2 lm1c lmd2 lm3e4dit l2m1ef l2m1e2p
+lmer2 l2m1erf l2m1erl l2m1erz l4messa l2m1id lm1ind lm1ins l2mof lm1orc lm3p2
+lmpf4 lm3s2k lms6t lm3ste lm3s2z lm3t4 l2mum l4munt lm1äst 4ln lna2r ln3are
+lnd2 l3n2e lnes2s l2nin lnus2 l1ny l1nü 1lo lo4ak 3lob. l2oba 3lobb lobe2s
+2lobj l1o2bl l2obr lob4ri lo4chel 2loe l1of lo2fe lo4gh lo2gl lo2gor lo2gre
+loh2e 4l1ohr loi4r 3lok 4l3okk lo2k3r 5loks l4ole 2l3o2ly lo2min lomä3 lo4nin
+lo2n1o lo2o 2lope lop2p1a 2lopt lor3am lor2an 3lorb 2l1orc 2l1ord lo3r2en
+4l1or3g2 4lork 4lorp 2lort2 lo4rä lo4sa 3lose lo4ske lo2spe lo2spr lo4ste
+los3to los3t4r lo2ta lot4e lot2h lo3tha loti4o 2l1ov lo2ve 2lox 4loß 2l1p
+lp2ar lp2f lph4 l2phir lp1ho l3phr l3phä lpt4 l3pu l4p1är 2l1q 2l3r2 lrau2s
+lrebs2 lre1s lrut4 lrö2 4l1s ls3a2b l3sac l2s1a2d ls2al l4s1amb l4samp ls2amt
+l2san ls3ane l3sare l3sarg l3sark lsau2 lsau4m lsau4r l4schin l4schmü lschs2
+l2s1e2b l3seil ls2ele ls1eli ls1er l2serf l2serg l2serh l2serk l2serl l2sers
+l2serw lse2t ls1eta ls2ext ls3ha l2s1id l2simp ls2kal l3s4kele ls2ky l2sop
+l4s3ort. l2spac l2s3ph l2s1pir l3s2pit ls2po l3spri ls2pu l3spul ls3s4 lst2a
+lstab6 ls3tabl ls4taf lstahl3 l2stas l4stat. l4state l2ste l3stea l3stec
+l3steh l3stei l4steil l3stel l3stemp l4sten ls4t3erk ls6terne ls6terns ls2tie
+ls4tip l2stit l4stoch ls4tol ls4tri ls6tru l2s3trü ls2tu l4s3täti ls4tüm l3suf
+ls1um l2s1un ls2und ls3unk l3s2äm ls2äug ls1äus l3s2öl 4l1t l2tab lt1abs ltag4
+lt1alg lt1am l4tame ltampe4 l5t2an. ltan3d l2t1ap lt1ara lt1art l3tarta
+l3tartu l2t3ato l2t1au lt3aut ltbau1 lte2c lt1eh l3tehu lt1eig lt1ein l2t1eis
+l4t1elt lte3mi lt2en lten6gel lten4sp lt3ents lte4ral lter4fa l3t2erg lter6ken
+lter4nä lter4se lt2erö l4t1esk lt2est lte3str l2t3h l3thas l4thei lt4hem
+l3t4hu l3tic l2ti2d ltimo4 l3tine lti3t l2t1o4b l2t1o2f l4tord l4torg l4t1o2ri
+lto2w ltra3l lt3rec lt3rei lt3ris lt3rol lt3räu l2t3rö l4ts lts2eh lt2se2l
+lt4s3ort lt2s1pe lt3s2ph lt2sti lt3t l3tub lt1uh l2t1um lturan4 ltu2r1i lt1äh
+lt1öl lt1ös l4t1öt l3tü lu1an 4lu2b3 luba2 lubs2 lu2dr lu2es 1lu2f2 2l1ufe
+2luff lu3fo luf4t1a luft3e luf4tei luft3r lu2g1a lu2g1e2b lu2gei lugen1 lu2g3i
+lug3l lu2go lu2g3r lug3sp lu2gu 2l1uh lu1id lu1is. lul2ö lumbi1 2lumd lume4
+2lumf 2lumg 2l1umh 2lumk 2luml l2ump 1lumpe lum2ph 2lumr 2l1ums lu3mu 2l1umw
+2lumz 1lu2n 2l1una 2l1unf lung4sc 2l1uni 2lunr 2l1uns 2lunt 2lunw 4luo 2lur
+l2ura lu2r1an lu2rei lu2ri l1urn lu2ro l1urt lu4ru 2luse lu2sp lus4s3a lus2s1c
+lus4sei lus4s3er4 lus2s1o lus4s1p lus2s3t lus4stä 1lu2st lus6terl lus4t1o2
+lust3re lu2s1u lu2t3a lu4tas lu4tau lu2t1e4g lu2tel luter2 lu4t3erg luter4s
+lu6t5ersa 2luto lu2t1o4f lu2top lu4t1or lu4t3r lut5schl lu2tä 3lux 2l1v2 lva3
+l3vl lv3r 4l3w 2lx 1ly ly1a ly3c 2lymp 3lyn ly3no ly1o ly3onn 3lys ly3t 2l1z
+l2z1ac l2z1ag l2zan l2z1ap l2zat lz1aus lze2l l2zele l4z3enth l2z1er2h l2z1id
+lzi4m lz1imi lz3l l2zo2f lz3t2 l2z1u4fe l2z1ur lzvol2 lz1w lz2wec l2zwu l2zäp
+l2zär l2zö 1là lä1c 3läd 2l1ähn 2lämt 1länd 2l1äpf 2läq lär4mar l1ärme 2lärz
+lä2s1c 2lät 2läub 2läuc 2läue 1läuf 2läug 2läx 1lö lö2b3 2löck 2löd lö2f
+2l3öfe 2l1öhr 2lök 2l1öl3 2löp 3lösc 3lösu 4löz 4löß 2lüb 3lübd lück4e2
+lücker3 5lüd 2lüh lü2hel lüh1l 1ma 3ma. maa2 m1ab m3a2bar 2mabb m2abe 2m3abf
+2mabg 2mabk m2abli 2mabm m2ab4ra ma2bri 2mabs 2mabt m2abä ma3chan mach2e
+mach8terh mach8t7ers mach4tr ma2ci mack2s ma3dac mada2m ma2del 2m1adm 2m1a2d4r
+ma4d2s m2adä mae4 ma1f4 mag2a ma2ge. ma2geb ma2gef ma2geg ma2gek ma2gep
+ma4ges. ma2get ma2gev ma2gew 2m1agg magi5er. magi5ers ma3gl ma3g4n 2m1ago
+mahl4st ma1ho mai4s3e ma2ke. 2m1akt mal2ag mal1ak ma4lakt ma2lan ma2l3at
+ma2lau 2mal2de m2aldi ma3l2e ma4lex mali1e mal3lo 2mallt m2alp mal3t malu4
+ma2l3ut 3malv ma2mid mam3m 2m1a2nal ma2nar 2m1a4nat ma2nau 2manb man2ce man3d4
+man3ers ma2net m2anfr man3g2 m4angel man4gl 2m1angr m2anh 3manip 2manl m2anle
+5m2ann 2m1ansa 2mansä man2th mant3he 2mantw manu3 2manw 2manz m1anza 2m1anä
+ma2or ma1q 4marag mar2an 2marb mar3g2 3ma1rh ma3r2i m2ark mar2kr 4mar2o maro3d
+4marr mar6schl mar6schm mar6schr mar2sp mar2su 2m1arti ma3r2u m1arz 3mas
+ma3s4a mas2e ma3s2p massen3 mas4st mas4ta mas4tel mas4ti mas4to mas4tr ma4s3z
+ma2ta2b ma2tan m3a2tel ma4t3erd ma4t3erz m4atme 2matmo ma4tort mat3se mat3sp
+matt4r mat3url ma2tä 2m1au2f 3maul 3ma3un mau3r 2mausd mau2ta m4ay ma1yo 3maß
+2m1b2 mbe2e mbera2 mbe3r2e mbert4 mb4l mble1i m3b4r mbu3sc mbut2 mby4t 2mc
+m3ch 2m1d m2dan m2d1a2s md3ato mde2a m2dei mder2 m2d1erl md3ras md3s2e mdt4
+m2d1um md1är 1me me3a meb4 me2ben 3mebr me1c medi3e4 me1ef me3e4n1 mee4rei
+2m1eff meg4 mega3 me4gel 3meh meh6l3er meh6rert 2m1eif 2m1eig m2ei3l2 mein4da
+meinde3 meiner6k 3m2einu 3m2eist me3l4ant me2l1au melb2 mel3d2 melde3i me2lek
+2melem me2ler melet4 2melf. 3melk mel4k3ei mell2 3melo me2lob mel2se mel3t4
+6mel6tern 2m1e2mis 2m1emp 2m1e2mu me3nage me4n3an men3ar me4nas men3au 2m1endl
+menen1 4men4gag men3ge me2nim men3k4 men2on men4se. 6mensemb men4sen men4ser
+men6ses men2so menst4 m4enta men4t3ak ment5eig men6t5ers 2mentn ment4sp me1o
+2meou 2mepa 2m1e2pi 3m4er. me1ra me3rak mer4a3s4 mera3um me2re2b me4rens
+mer4err mer4erw mer3f 4m3ergän me3rid merin4d merin4t 4merklä mern3s2 m4ersh
+mer3sm mer4sto mert4r merz6eng 3mes me2sal 4meser mes2po mes1pr 2mes2sa
+mess3an mes6ser6g mes4s1o mes2sp mes2st me1sto me3sze me4sä me3ta meta1s me3th
+me4trig met6t5en6d me3tu meu1 2m1ex me2xe 2meö 2m1f4 mfi2le 2m1g2 2m1h4 1mi
+mi3a2b mia2n mibi1 mic1e mi1ch mi2ci mi3da mi2di. mi3dr 2midy mie3dr mi3ele
+mi4e3no mien3s mierer4 mie4rob mie1s mie2ti mie4to mie2tr mi1f4 3mige mi3h
+mi2k1an mi2kar mi2kel mi2kin mi3k4l mi3kr mi2ku mi3l2a milch1 mil4che mild4s
+mi3l2i 4milz m2im2a 2m1imm 2mimp min2ac min5anze m2inde minde4s 2m1indu mi2nef
+miner1 mi4n3e4ri min2eu 2minfo min2ga ming3s 2minh mi3ni mini3k4 mi3n2o
+mi4n3of 2m1inse mi3nu mioni1 mi1p 3mir. 3miri 3mirs 3mirw 3mirz 3mis. mi2sa
+mi3sau mi4scha mi4schr mi4sch3w mise1 mis2p mis5sar mis4ser mi2ste mi1sto
+mi1s4tr 3mit mi2ta mite2 mi2t3h mi2to mi2tr mit3s2 mit5sa mitt2e mi2t1u 4mitz
+mi3v2 mi1ä 2m1j 4m1k4 m3kn 4m1l2 ml3c m3le ml3f ml3k m3lo ml3p ml3s 2m1m mma3a
+m2mab m2m1ak m2m1al m2m1ans mm1anz mm1art mma1st m2m1au m2m1e2b m2m1ef mm1ein
+mme4lin mme4na mm2ene m4mentl m4mentw mme4r3a2 mme4rec mme2s mme3sc mmes5t
+mme4sz m2m1eu mmgas4 mmi3el mmi3k mm1inb mm1inh m2m1ins mm1int mmi3sc mmi1s4t
+mmi5tw mm3p2 mmpf4 mm2s mm3sa mm3s2i mm3so mm3s2p mm3sta mm3sti mmt2 mm3te
+m2mum mm2un mmu3r mm1äu mmül2 mmüll1 2m3n2 m4nesi 1mo mo2be 2mobj 2m1obs 3m2od
+mo3de mode3s mo2dr m1of mo2fe 3mog 2mog. mo2g1al 3m2oh moh2a moi3r mo2k1l
+mol3d 3mom mom2e 3m2on mon4dac mon4del mon2do mo2ner mon2i mon2s3 mon3sa
+mons4e mon3s4u mont2a mon3th mo1ny mo2nä 3m2o2o 2mo1pe mo2per 2m1opf 2mopt
+mo1ra mo2rak mor2an mo2rar mor2d3a mor2dr morf4 mor3g morgen5s6 mor3t2 3mos
+moster4 mos2ti mo3ti mo5to mot4r mous2 2m1o2x mo1y 4m1p mpa3ne mpe4lin mpe2n1
+m2p1ene m2pf mpf1ef mp4f3erf mpf3erg mp6fer6ge mpf3erp mp6ferpr mp4f3err
+mp4f3er4z mpf3l mp2fr mp1haf mp1hos mp3lei m4p3lem. m2p3len m2p3les mp4lis
+m3pon mpor6tag mpor6ter6 mp3sh mp3str m3pu 2m1q 2m3r2 4m1s m2sam m2san m4sap
+ms1as m3sat m2sau msau3e m3sc msch2 m4sco m3se m4s1e2d m4s1ef m4sein m4se2le
+mse2n m4s1ene m4sent ms2erh m4s1eu m4sex mso2r ms1ori m2sped ms2po m2spot
+m2spro ms2pu m2spä ms3s4 m3steh m3stei m3stel ms2ti m2stit m3s4to m3s4tr
+ms5trä m3s2tu m3stä ms4tü ms1ums m3sy m3sä m4s1än m2sü 2m1t mt1ab mt1ak mta2m
+mt1ar mt3arr mt3aug m2t1e2d mt1ein mt1eis mt1elt m4tenga m4t3engl mt1ent
+m4tentf m4tentg m4tentr m4tents m2t1erb m4t3erei m2t1erf m2t1erg m2t3e2r1i
+m2t1erk m2t1erl m2t1ers m2t1ert m2t1eta m2t1eu m2t1ev m2t3h m2ti2d m2tim
+m2t1in m2t1i2r mti2s mt1ita mtmen2 mt1ob mt1op m2t3ro m2trö m4ts mt2sa mt3sco
+mt2s1e mt3send mt3s2ka mt3s4kel mt3stu mts3tät mt1um mtu3re mt3z m2t1öl mt1ös
+m3tü 1mu mu1a mu3cke mu4ckel 2m1uh mu3la 3muld mul4lau 3mult m4umb 3mumi m1ums
+mum2s1p 3mun mun6derf mu2ner2 4m1unf 4m3ungeb mu3ni mu4nin 4mu4niv 4munw 4munz
+muru2 mu4r1uf 3m4us mu4s1a mu2s1o mu2sp mu2s3t 4must. must4e mu2su mut1au
+mut4str 2m1v mvoll1 2m1w2 mwa2 mwa4r mweg4s mwel4 mwelt3 mwu1 3my my1al my3l2
+my2s3 2m1z2 1mä 2m1ähn mäh1r 2m1änd 2mäo 2m1äp 2mäq mär1 mär2kl mär2z mär4zer
+mä3t4r mäu2s1c 3mäß 1mé 1mö möbe2 mö2c 2mö2f 4mök 2m1öl m1ört 1mü 2müb 3müh
+mü2her mühl1a mül4len 3mün 3müt mütter3 1na 3na. 2n1ab naben3s4 n3abh 3nabi
+na2b3l na2bor na2br nab4rü 4n3abs2 na2b3u na2bä 3nac na2ch1 nachen4 na5chen.
+n3achse nach3sp nach8t7ersc nacht8raum 5nachw na3dab 4nadd nade4l1 na2der
+4n1adl 4n1adm 4n1a2dr 4nadv 3nae 2n1af na1fra nag2a na2gem 4n1agg n1a2gi
+na3gin na3g4r 3n2ah na2h1a 4n3ahn 4n3aho 3nai nai2e n1aig 4n1air nai4re n2ais
+2n1ak na2ka 3nako na2kro 4nakt 3nakä n4al. na2l1a2 nal3am na4lar 2n1albk n2ald
+nal3da n4ale na2leb nal3ei na4l3ent na6lerei na4ler4g na4lerm na4l3erw nales2
+nal1et nal1ex nalf4 nalg2 nal3gl na2lid nal3l2a nal2ph n2als. nal3t2 nal5tr
+n2alty na2lu 2naly na2lä na2mat 3name na3me. 4na2mei n4a3men namens3 4n1a2mer
+na2mid na3m4n 3n2amo n1amp nam4sp 2n1amt namt4s n1an 2n3an. 4na2na na4nat
+4n3anb n3and2 nan1eu 4n3anf 4n3ang 4nanh 2nani 4n3ank 4n3anl 3n2ann 4n3anna
+4nano 4n3anp 2nanr 4n3ans4 2nantr 2nanw n2anz. nanzen4 nan6zene nan6zeng
+n3a4nä na3ot na2per n1apfe 4napfel na2pos na2pr nap2si n1aq n1ar 3nar. na2r1a
+2narc n2ard n2are 3nar2i n2ark n2arle n2aro na2rom nar2rh 2nart n2arta n2arth
+na3r2u 3nas n4as. na4schw n2asf 4n1a4sp nas2s1c 4n1assi nas4ta na2str 4nasy
+nasyl2 na2sym na3sä 3nat na4t3au nat1ei na2tem na2th 4natm nat2o 4natom 5nats1
+nat4sa n1au 4nauf nauf4fr nau2fr 5naui 3n2aul 4nausb 4nausd 4nausf 4nausg
+4nausl n2auso 4nausr 4n3auss 4nausw 4nausz 3nav nave4 navi5er. navi5ers 4n3b4
+nbe2in nbe3r2e nbes2 nby4 2n1c n2c3ab n3can n3ce4n3 n3ces. n3chl nch3m n2ck
+ncor2 n5cu 4n1d n2da2d nda1f nd2ag n3dai n2d1ak n4dakt n2dana n2dani n2danl
+nd1ann n2d1anz n3dap nd3arr n3dat nd3att nd1au n2daut n2dax nd1c nde4al.
+n2d1ede n3dee n2dei n4dei. nd3elfe ndel3l ndel4s3a ndels5en nde4mot nden3sk
+n4dentl n4dents nde3o n5der. n5deren nderer3 nd2erh n5deri nder6läs nde4rob
+n4de4ros n6der6sat nder5ste n3d2es1 nde2se ndes3s n2deth ndia3 nd1imm n2dob
+ndo1c n2dof ndo6na n2dopt nd1or n2do2ri ndo1st n2d3ott nd4ram n2d3rat nd3rau
+n2d3re n2drif n2droc n2drod n2drui n2d3run n2d3rö nd4sene nd2spr nd3th nd3ti
+ndt4r n2duns ndwa5re ndy3 n2dö nd2ös 1ne 3ne. ne2ap 3neas ne3at ne3au ne2bl
+2n1ebn neb4r 2nec 3neca 3nece ne1ck neck2a ne2dit 2nee neei2 ne3e4in ne3eis
+neen2 nee1r2 nee3t n1ef n2ef. n2e3f2a 2nefr 2n1egg neg4l n1e2go neg4r n1e2he
+2nehe. 2nehem 2nehen2 ne3her 3nehm 4n3ehr 2n1ei 3neia 4neic nei4dei 4neier
+3neigt 3neigu 4neing 4neinh 4neinl 4neinz 4neip neiss4 ne2ke 2n1eks nek3t2
+ne2l nel3b n1ele 4nelek 4nelem ne3len ne3l2i ne4lim ne4lit 3nelk n2ell nel2l1a
+nel4lei 3ne3l2o 3nelu 3ne3lä n2em. 4n1emb n1emi 4n3emp 2n1ems 4nemu 3nen n4en.
+n2e4n3a ne5nac n2enb n2enc nen4dar 4n1endb 4n1endd 4n1endf n1endg 4n1endh
+4n1endk n1endl 4n1endp 4n1endt 4n1endw ne2n1e2b nen3ei nene4m nenen1 ne4nene
+ne2neu n2enf 4n1engb nen4gen 4n1engs 4n1engt n1engu n2enh ne4n3i n2enj n2enk4
+n2enm nen4nar ne2no4 nen3s2e nen3sk nen3s2p 5n2en3t2a 4n1entb 4nentd 4nentf
+5n2enti 4n1entl 4nentn 5nentr 4n1ents 4n3entw 4nentz ne4n3u n2env n2enw
+nen5z2e ne2o3b ne2oh ne2or neos4 3nepa ne2pen 2nepf ne2pi ne2pos nept4 n4er.
+ne1ra ne2ra2b ne2rac ne2r3af ne2rag ne3r4al ne2ram ne2ran ne2r3ap n2erat
+ne2rau nerb2a 4n3erbe. 4n3erben 2nerdb nere2 ne2reb ne2rec 5nerei. n1erf
+4nerfas 3nerfr 2nerfü 2ner3g4 3nergr n1erh 4n3erhö 3neri n2erj n1erk4 5nerka
+n2erkö n2erli 2n1erlö n1ermi n2ern. 4n3erneu 2n1ernt 2n1ernä n1eros n2ers.
+2n1ersa 4n3ersts nert4 3nert. ne2rup n2erv 4nerwar 2n1erz nerz2a n1eröf ne1rös
+3nes n2es. ne2sal nes2an ne2sei ne2s1ev 2ne3sh nesi1 ne3ska ne2s1of ne2s1or
+ne2s1pa 4n1es2si nes4sig ne1sta ne2ste nes3ti 4n1est3r 4nesyn ne2tab 2ne4tag
+net1ak ne2t1an 2ne2tap 2n1e2tat ne4te2l ne2th ne4t3ha ne3the ne3ti ne4tin
+n4ett net3ta net3te net3tr 2n1e2tu net4zer net2z1i ne2u neu1c neu4ere neuer4f
+neuer4k neuer4r neuer4s neuer4w neu3g4 n2eun 2n1eup neur2 3n2evi ne2vol n2ew
+2n1ex 5ney 3nez 3neß 2n1f n3f2al nfalt4 n3f2ang nf4ar n3f2en n3f2er nf2es
+n4fex nff4 n3fi nfi4le. nf4le nf2o nfo1s nf4r nf3s2 nf2tan nf2t3r nft4st
+nfts3tr nf3tu n2f1u n3f2ä 4n1g n2g1a2c ng1ad n2g1ak ng1a2me ng1ams nga2n
+ng1and n2g1ans ng1ant n3g2ars n2g1a2v ng3d4 n2g1ein ngelb4 nge3l4ei n3g4en
+n5gene nge5nerw ngen3sa nge4ram n2g1erg ng3erse nger4zä n3g4es nge3s4a nge3sp
+ngg3s ng3hu n2g1id ng2lad n2glic ng4lok n3glot ng2läs n2gn ng3ne n4g3ni ng4nom
+ng2nu ng2ob ng1opf ng1or n2g3rai ng4ran n2g3rat ng3roc ngs3au ng4scr ng4s3e4h
+ng4sek ng4sens n4gt ng3ts n2gum ngung4 ngzei4t n2g1äl n2gö 4n3h2 n7halts n5he
+nhe2r 1ni 3n2ia ni3ak nibb4 ni1ce n1id 3n2id. ni2de 3n2i3de. 4nidee n2idi
+ni3d4r 2n3idy n2ie nie3b ni1el nie3l2a nie4n3 ni3ene ni3eni nie4rei ni4erna
+nie2sa ni2eu nife4s3 ni1fl ni2g1a2 2n3i2gel 2niget nig3li ni2gn ni2gre nig4san
+nig4sp nihi3 ni2kar 3nike ni2kel ni3k4erh ni2ki nik3ing ni2kor ni2k3r nik3t4
+3n2il ni3l2a ni3l2i 4n3imp n1in1 3nin. n2ina nin2ac ni2nal 3n2inb 2nind 2ninf
+3ning ning4s 2ninh 4nink2 3nino ni2nor 3n2inp 2nins n2ins. 4n3int n2i3nu
+4n3inv 3n2inw ni2ob ni3ok ni3ol ni3ora n2ip ni4ron n1irr 3n2is ni4sam ni2san
+nis3cha ni4schw ni2s1e ni3se. ni2som 4nisot ni2sp ni3spi nis3s4 ni2s1u ni2sä
+2nit 3nita ni2ti nit2o 3nitr nit3s4 nit2ta nit6tele nit6ter6g nit6t5er6k
+nit4tra nitt3ri nitt4sa ni3v2 3nix 2n1j 4n1k nk1abr n2k1ac nka2ge n3kal n4kalg
+nk1ang nk1apf nk3art. nka3sc n2katm nk1aus n2kaut nke2c nk1ei. nke4lei n4kelem
+nke4na nken4te nk2er n4k3erle nke4ros nk3ersa nke2t nk1eti n2ketu nk1id n2kim
+nk1inh n2k1ins n4klade n3klag nk3leis n2k3len nk3les n3klin nk2lo nk4nac
+nk4neb n2knis n2knit n2k1o4be n2kopt nko2r nkord2 nk1ori n2k1ort nk4rab n4kre.
+n2k3rel n2kren nk3rep n2k3rez nk3ro n2krol nk3rät nk2sal nk2se nk3sen nk2so
+nks2ti nk3s2z nk2tak nk2tan nk4tau nk4tent nk4terg nk4t3ern nkte3sk nkt2et
+nk2tin nkt1it nk2top nkt1r nkt3ric nk2tro nk2tru nkt4sen n2kum nk1ums nku2n
+nk1urh n2k1äh n2k1äp n2köl n2küb 2n3l2 nla3ge nle2ga nle3x 2n1m2 n3ma nmen2s
+n5mi n3mä 4n1n nna2be n2nada n2nalg n2n1all n2nan nna3st n2nau n3nec nn2ei.
+n4nein n3nelb nne4le nne3lu nn2ens nner4ei n6n5ereig nner4fü nner6geb nn4ergr
+nn2erh nn2erk nner4la nn2ero nn3erwa nner6war nner2z nne2rö nne4s1e nn2eu
+nn2ex nn3f nng4 n3n2i n4n3iso nnis3t nno2b nno3be n2nof n2nop nno2r nn1ori
+nn4sam nn3se nn3s2p nnst4 nn4stoc nn2stö nn5t2a nn2th n2n1uf n2n1unf nn1ur
+nnvoll4 nnvol5le 1no 3no. no5at n2obel 2nobj no2bla n2oble 3noblo 3noblö
+2n1ob2s no1c noche4 noch4r 2no2d no3dr no2ed n1of no2fe 2noff 2n1oh n2ohe
+no3id. 2n3okk nok2l n4ol. n3ole no2leu no4lig no2liv 2no2ly 3nom2e3 3nomp
+non2e n1onk nons4 n1ont 2nony 3n2opa no2per no2pi n1ops 3nor. nor2a no2rad
+n2o1rak no3ral no3r4ar 2norc nor4da 3nordb nor4des nor2d5r no3r2e 2n1org 3norh
+3n2orl 5norm norm2a nor3mal 3nors 2n1ort 3norö 3n2os. nos2e1 no3sh no5sk
+no2s3p 2no2sti nost1r 2nostv nos2u no2tan no3tart not1e4i no6tentr no2ter2
+noterb3 no2tex not3h no2tho no2t3in no2t3op no2tr no2tä 3nov 2n1o2x 3noz 4n3p4
+npa2ge npa2s npf4 npro1 npsy3 2n1q 6n3r2 nran2 nrebe2 nreli1 nre3s4z nräu3s
+nrö2s nrücker6 4n1s n3sabo n2s1a2d n2s1agi ns3a2k n2sall ns4alp n2salt ns4anat
+n2sanm nsa2r n3s2arg n3sark nsa2s ns4ath nsau4r nsau2s n2saut ns2av ns2ax
+n3sche. n4schef nsch5eul n4schl. nscht4 n3schu nsch7werd ns4cr ns1eb ns2eh
+nse2ha2 nseh5ere nsei4n3 n4seinf n4seint ns2ele ns3elem n2sem. n2sene nsen4sp
+n2sepo n2s1erf ns1erg n2serh n2s1erk ns4erko ns3erle n4s3erne ns1ers n4sersc
+ns3ertr n2s1erw n2serz n2serö n2seth n2sety n2s1eu ns2ext nsfi2l ns1id nsi4den
+n3sil n2simp ns2inf n2sini nsinn2 nsinns3 ns3int n3sis n4siso nsi4te nsi2tr
+n3s2kal n3s2kel ns2kis n3skle n3s2ky n3smara n2s1o2d ns1of n2soff ns4om
+n4s1ont n2s1op ns2orc n4s3ort. ns2pac nspa2g n3spe ns4pek ns2pel n5s4pen
+n4speri n4spers n2sph n3s2pi ns4pie ns4pir n2spo n4s3prie n2spro n2sprä nsrü2
+ns3s4 ns3tabl ns8tagent nst1ak n4stale nsta2n1 n3stand nst3ane n3star n2stas
+n4stat. n6staten ns4tati n4stats nst5eife nst7einhe ns4tent ns2tep nst5erge
+n3steri n5s6terne n5s6terns ns4teu ns2ti n3s4tic n3stif n4stilg n3stim n2stob
+nst5opfe ns4tor ns4tric n4strie n4strik ns4trip ns6trun ns2tu nst3u4t n3stäm
+n3s4tän ns4tüm n3suf ns2um ns1un ns2ung ns4unk ns2unw ns4unz n2s1urs n2sut
+n3sy ns4zene n2s1än ns2äug n2s1äus 4n1t n3t2a3c ntak4ta nta4lin n4t1all nta2lo
+nt2alp nta3ne n4tansp nt1ant n4tanza n3t2arb nt1ark n3t2arm n3taro nt1ar3t
+n4tarti nt3artu n3t2arz n2tath nte3au nte1e nte3g6 nt1eh n3tehe n2teig n4t1ein
+n2t1eis nte4lin n2t1e2mo nt4en nte4na nten6te. ntera2 nte6r5eis nt4erh nt4erk
+nt4erm nt4ern nt4ers nt4ert nte3sa n4t1es4s nte2st n6testri n2t1e4ta nteu3
+nteu6eri nte3v ntge4n nt3hel nt3ho nt4hos n3thr nt4hu n2t5hum nt4hy n3t2i
+nti3c ntim3p ntine4 n4t3inf n4t3inh ntini1 n5t4lem ntmen2 ntmo4 ntni2 ntnis1
+ntopf3e n4torg n4t3o4rie nto1s nt4ral nt1rau nt4raum nt3rea nt3rec n5t4ree
+nt3reif n5trep nt4repr nt3rich n4t3rieg nt4rig n2troh n3trop n2t3rü n4ts
+nts2ah nt3sp nt4spar nt5spe nts2t ntt2 n3tub ntu4re. ntu1s nt3z nt1äm n2t1äu
+n3tü 1nu 3nu1a nu4ale nu3a2r3 nubi1 2nu1c 3nud nude2 3nue nu2es nuf2 nu2fe
+3nug 2n1uh 3nuhi 4nuhr 3nui nuk4 nu3kl nu2kr nul2l1a nulle2 nul4l3eb nul4lei
+n2um. nu2ma 2n3umb 4numf 4numg 2numl 3numm 2numr 2n1ums 2n1umv 4numw 2n3umz
+nu4n 4nuna 4n1une 3n2ung4 4n3ungl 4n1uni n3unk 2nunr nun3s 4nunt 4nunv 4nunw
+3nuo 2nup 2nur nu2ra nu4r2i nur3s nur2z 3nu2s nu3sc nu3se nu3spo nuss3er4
+nus6serl 3nut nu2t1a n3uto nu4t3r 3nuu 3nux 3nuz 2n1v2 n3ver n3vl nvoran4 2n3w
+1ny. 1nyh n1yo 1nyr 1nys 1nyw 4n1z n2z1ach n2z1a2g nza2k n2zan nz3a4ne n3zani
+n2zar nza2s n2zat n2z1au nze4l3a n6zenerg n4zen4se n4zentl nz3erem n2z1erh
+nz1erl nzer4lö nz5erste nzer6tra n4zerwe n3z2es nze3sk nze2t nz1eta nze3u4t
+nz1id nzi2ga nzig4s n2zinh n2z1ini nz1int nz3le n2zof n2z1op nz3s nz1wa n2zwet
+n2zwir n2z1wu n2z1wä n2zwö n2zän n2zär n2zöl 1nä 4näb 3n4äc 3näe n1äf 3näg
+3nähe nä2hi 3nähm 4n1ähn nä2hu 3näi 2n1ä2m 4n1än 4näpfel 2näq när4s5t 3näs
+nä2sc n2äss 4näst 2näu 3nä1um 4näuß 3né 2nöd 4nö2f 4n1ök 4n1öl n2ör 1n2öt 3nü.
+2nü4b nür1c 3nüs 1nüt 2o3a2 o4a3bi o4ac oa3che oa3chi o4ad oa3de oad4st oa3in
+oa3k2e oak1l o4a3la o4a3mi oa4n o2a4r o2as o5ass o4at o5au o1b 2ob. o3b2al
+ob2am ob2as ob1auf 2obb ob2e 2obe. 2obea 2o3bec 2obef o2b3ein 2oben obe4na
+oben3d4 o2ber o3ber. o4berb ober5eis 1oberf ober3in oberin6g obe4ris 7oberungs
+2obev 2obez 2o3b2i obi4t ob3ite 3obj ob1la ob3lei 1ob3li 2oblo2 ob2lu 2ob2lö
+2obo ob1or ob3rei 2obrü ob3s2h ob3sk obs2p ob3sz 2o3bu o4bunt obu2s obu2t3
+2oby oby4t 2o3b2ä 2obö 2o3bü o4büb 2oc o3ca oc1c o1ce och1a ocha2b ocha2r
+o1che oche4b o2ch1e4c och1eh och1ei oche2l ocher4k ochi4d och3l och3m och1o
+och3r och1s ocht4 o1chu ochu2f och3u4t och1w och3ö2 o1ci o1ck o2ckar o2ckau
+o3cke o6ck5ersc o3cki o4ckin ock3sz ock3ta o1cl o3cu o1d 2o3d2a od3ak od2dr
+o3dec o3d2e3i odein3 ode4l3ag ode2n1 ode2s1e ode3sp o3dex 2o3dia odi3c 2odif
+2o3dir odium4 2odn o2don odo4s 2odr o2dre odt4 2o3du o3dy 2o1e2 oe3di oe4m
+oen1e o3er o4e3s oe4sc o2e3t o3et. oet4h o3ets 2ofa ofa2c of1a2d of1a2g of2ang
+of1au o2f1e2b o2f1ec o2f1e2d o2f1ei o2fent 2o3fer o4f1erb 2o3f2es o2f1e2t
+of1eun of2f1a off3erz of2f1in 1offiz of2f3l of2fo of2f3r offs2 off3sh off3si
+off3sp off3t4 of2fu 2ofi ofi3k4l ofi3s4 2o1fl of3le of3li of4lö 2ofo 2o1fr
+of3rä of4rü of2s1 of4sam ofs2ch of4sen of3sta of4staf of3str of3sä 2oft oft2a
+of2tei of3th 2ofu of3ur 2ofä 2ofö 2o1g o2g1ab o2g1ac oga3d og1ang og1ans
+o2g1ei ogeld2 oge2l1i ogener4 ogeni3 ogen4id ogerätein8 og2gl o3gh ogi2er
+ogin1 o2g1ini og1l og3le og2lo o3g4n ogo4i og1o2ri og3s2p og1ste og3sti 2o1ha
+oh1alk o1he o3he. oh1eis o3hem o3hen. ohen3s o3her. o3here oh1er4t oh1er2z
+o3hes 2o1hi 2ohl ohl1a oh2la2d oh3lec ohl1ei oh3lep ohler2 oh4lerg oh4l3erh
+oh4lerw oh3lo oh4l1o2r ohls2e oh2lu oh2lä ohm2 1ohmi oh4n1ac ohn1ap oh3nee
+oh2ni 1ohnm oh2n1o ohn3sk 2o1ho ohol1e oho4len o2h1o2p ohr3a2 oh4rat oh2rel
+oh2rem ohren3s ohrer2 oh4rerg oh3rie oh4rin oh2rol ohrt4r oh1s o1hu oh1w 2o1hy
+o1hä 2ohö oh3öl 2oi o1i2d oi4da o3ie o1im o1in o4ine oi2r o2isc o3isch. o1ism
+oiss2 oi1th o1i4tu 2o1j ojek8tori 2o1k ok2a oka3b2 ok3ac oka3i oka2la okale2
+oka6lere okas4t ok2e 3o2kel oki4o ok2li ok1lä ok2o oko4pt ok2so ok2s1p ok5t2
+3okw 2ol o1la ol3abu olaf4 ol1ant ol2ar ol4arm olar3s2 o3l2as olast4 ol1a2v
+4olc ol2chr ol4d1am ol2d1ed ol2dei ol4d3eng old5ersa olde2s ol2deu ol2dim
+ol2d3o ol4dr ol2dä 4ole. o2l1ef ol1eie o2l1eis oler2 o2l1ert ole1s ole3s2t
+ol2e3u2 ol1exz ol2fa ol2fem olf3ere ol2f3l olf1r ol2f3ra olft4 olge4ne ol2gl
+ol2g3r ol2i olie4n1 oli2er oli3k4 oli5tu 3oliv oli3ze ol3ke ol2kl ol2k3re
+ol2l1ac ol2lad ol2l1ak oll3am ol2l1au ol2l1e2b ol4l1e2c ol2l1ei ol2lel
+oller6ge ol4ler4k ol4l3erw ol4l3ess oll3s2a oll3sp 4olo ol2of olo1p ol1ort
+ol3s2k ol4ster ol3t4h o1lu olu2th ol2y ol2z1a2 ol3zan ol4z3ern ol2zim ol2zo
+ol2zw 4o1lä ol1ät o1lé 2om o2mab oma2bl o2m1a2ge om1alg om1all oma4ner om3ansc
+o4mante o2m1ap o2m1ars o2m1art omar4te o4m1a2sy o3mat o2m1au o2meb om1ebe
+o2m1ef o2m1ei o2mel o3meld o5men. om1ene o4mep om1er2h omer4s o2meru om1er2z
+omi2c3 omiet1 o3mig om1ind om3ing om1ins o2m1int om3ma omm2e o4mn 3omni 4omo
+o2m3oa o2m1org om1o2ri om3pf oms2 om3sk om3t4 o2mum o4munt 2ona on3a2b ona3g
+o3nal onaler6e on3ann onan6z5ei on1ap o2narb ona3th onat2s on2au on3auf 2onc
+on2dan onderer5 onde8rers ond1r on2dra on4drin ond3sk 2one on1e2c o3nee o2nef
+one3h on3ein one2m on1ema one2n1 o4n3ends on2eng onen3s2 o3ner. o2n1erb on1erd
+oner4fa on1erg o2nerh on4erka on1ers on1erö o3nett on2eu on3f2 2ong on3gla
+ong4r ong3s2 on2gue 2o3ni on2i3d onie3g o4nikr o4nim o4nind on3ing o4ninh
+on2inn o4nins on3k2 1onke 3onkel onli4 onlo2c 2onn on3n2an on3n2e ono1 o3nod
+o2nof o2noke on1orc on3ord ono3s onot4 ons1a on4sam on2seb onsen1 onse4t
+onsi2d ons3ing on4s3l ons1p onst2a onst4r ons5tri on3ta on2t1eb on2te2l
+ont5end on4t3erl on2th on4t3rat 2onuk o3nur 2onut on3v 1ony on3z2 onze3in 2onä
+on1äh 1oog oo2ka oo2k3l oo2kn oo2mo o1op o1or oor3d oo4sk oos3s4 oo2su oo2t1a
+oot1ei oo4t3h oo2tr oot2st oo2tur 2op. o1pa opab4 op1akt opa2le o3pa5s opa3s4t
+1ope 2ope. o1pec 2o1ped op1ef 2o1pei o1pek 2opel ope3l4a3 2o1pen o2pera op1erh
+2opf. op2f3a op3fah o2pfe op2fin opf3la op1flü op2fo op2fä 4oph2 o3phe o1p2i
+opi5a4 opi3er. opi5ers. opie4r3u opin2 2opl op3lag o2p3le op3li o3p2n 2o3po
+opo2la op2pan op4pl 1oppo op2p3r 2oppt 2o1pr 3o4psi op3sz 1op3t4 o2pum 2opy
+2o1q 2or. or1a 2ora. o1raa 2or3a2b o2rabb o2r3add or3adr o1r2ag 1orake o1ral
+oral3l o4r3alm or4alt or2am or3a2mi o1ran3d4 oran2f o4rang oran2m oran4ze
+or3ap 2orar or3arr o1ras o2r3att 2orau oraus6wa 2o1raw orb2l or1c 2orca or2ce
+2ord. 4orda ord1am or2dar or2dau 2ordb ord3eng orde4s or2deu or4d3ing or2d1ir
+or2dit 1ordn or2do2 2ordr ord3s2t ord3t 2ordu 2ordw 2ore ore2a o2r1e2b
+o2r1e2ck o5ree or1eff ore2h or1eig oreli1 orems2 o2r1er o3r2ere orer1i o3r2ero
+or1e2th o2r1eu 2orf or2far orf3li or3g4a 2orget or3ghi 2orgia orgi1e or2gl
+or3gla or3gle or2gn 2orgr 2orh 2oria 2oric 4o3rie. o3rien. o6rienti o3rier
+4oril or1ima ori4mi 4orin1 o2rind 2oris 2oriu 2ork or3k2a or4k3ar ork4r ork2s
+2orm or2mam or4mans orm3asp or2m1eb or4m3erf or4m3er4g or2mor orm3ord or2mum
+ormu4n or4muni or4munt ormwa5 or2n1a2c or2nal or2nar or5ne. or3ni or4nin
+or3no1 2o1ro o2r1ob or3oly oro3n2a oro2pe or1opf o2ro2r o3rou o2r1ox 2orp 2orq
+2orr orr4a or3r2e or3rh 2ors2 or3s4a or3sh or3si or3sk or3sz or2t1ak or2t1an
+orta2r or2t1au or2tef orte4n or4ten5g ort3erb or4t3ere ort3erf orter6fa
+ort3erg or4terk or4t3erl orter6sc or2t3e2v or2the or2tin or4t3off or4to2r
+or4trau ort3re or4t3räu or2t1um or2tö 2o3ru or2uf or1uh orum4s o4r3un o5rus
+o2rya or3z2e o3rä or1änd or1ät 2o1rö o2rü 2o3s2a os3ad osal2 osa1s 2osc o2s3ca
+osch3ar o3sche osch3le os2co 2ose ose1e ose1in2 os2el ose2n o2s1er4k os2ex
+2osh o3s2hi os2ho os4hu 2osi o3sk o4ska os2kal o4ski 2os2kl 2os2ko o4skr os2lo
+1osm os4mog 2oso osol1 2os1p os2pac os2pe os3pec o3sphä o3s2po os4pot os2pra
+2oss os6s3a2c oss3ala os2san oss3and os2sei os4s3en4k os4s3enz os2s1ep oss2er
+os4s3er4b osser4e oss5erei os4s3er4f os2sik os2sim os2s1o2 os4son os2sp oss1pa
+os2s3t os4sto os4sä ost1a os4tam os3tarr osta4s os4tat ost1ei oste2n oster3e
+ost5erwe oster8wei ostes5s ost3eur os2t3h os2tid os3til os2tit ost1o4b os3toc
+os4t1or ost3ran ost3re ost3rot ost3rä ost3uf os2tug o2stö 2osu4 os1um 2o3sy
+o3s4ze o2sö 2o1t o3tabe o2t1abi o2t1ah o2t1ak o3tal o3tam ot1ant ota2s ot1ast
+o2t1au o3tau. o2teb ote1i o4t1eib o4t1eic ote3i4n o4t1eis ote4l1a ote4lin
+o3tem o4t1emi ot2em3p2 ote4na o4tentb ot3entr ot1erb o4t1er2l o4t1erw ote2s
+o3the o4them o2t3hi o2thr 4oti ot2id o2til o2t1i2m ot2in ot3inh otli4 ot2o
+otob4 ot3opf oto4rei oto1s o3tran ot3rat ot4rau ot3re ot3rin ot3roc ot3rus
+ot2s3at ot3sch ots2en ots1o ots1p ots2pe ot2spr ots3tau ot3sti ot3stra ott1a
+ot2tan ot4ta2s ot2teb ot4terh ot4terk otte2s5 ot2t3h ot4tim ott2o ot2t3r
+ot3t4ra ot4tri ot3t4ru ot1url ot1ä o2t1ö o3tü ouff6 ou1f4l ou4ge ou3gl o1uh
+ou1is. ou2le. ou2les 2o1um 2o2u2n oun4ge. 4o4ur oure2 ou2ret ouri4 ourie4
+ourme4 our4ne. ou3s2i 3outp out3s2 ou3tu4 o1v ov2a 2ovel o3ven ove3s4 2ovi
+oviso3 2ovo 2o1w o3wec owe2r1 o2wh o3wi o2wu o1x 2ox. ox2a 2oxk ox3l o2xu 1oxy
+o1yo oy1s2 2o3z2 3o4zea ozen4ta ozes4sc 4ozi ozir3 ozon1a oz3z 2oß o2ß1el
+o2ß1en2k o2ß1enz oßer2 o2ß1erb o2ß1ere o2ß1erf oß1is o1ä o1ç o1ñ 2o1ö2 2o1ü
+1pa. 1paa 1pac p2ad pa3da 2p3a2dr pa1fr 1pag4 pa3gh pa1ho 1pak pa1k4l pak4to
+3pala pala3t 3pal2e pa3l2i 1palm pal2ma pal2m1o pal2mä 2palt pal2ta pal4tei
+pal2tr 3palä pa2m3a pa2nar pa4n3at pan3d pan4ds pa2neu panf4 pang4 pa4nisl
+pank4 2panl 2pann panne2 pan4n3eb 1pa2no pan3sl pan3t4h 1panto 2pantr panz2
+pan5ze 1pap papi2 papieren8 papie8r7end pap2pr pap4s pa1q 1para pa4r3aff
+par3akt pa4rant 2parb 1p2arc par3d 2parer parer8geb 1parf 2parfö 2parg
+pargel6d 1park. par4k3am par4kau par4kr 1park1s par3m2 par3ne 1pa2ro 2parp4
+2parr 4parta 1parti 1partn 3party par3z pas2e pa3s2p pas6sein passer4 pas6serg
+pas2s1p pa4st pat1a pat4c pa5t4e2 2patel 1pat4h 1pati 1pat4r 1pau 2p1auf
+pa3uni 2pausz 1pav pay2 2paß 2p1b 2p3c 2p1d2 pda4 1pe. pe2a2 pea4r pea4s p1e2b
+pech1 1peda 1peel pe2en 2pef 4p1eff 1peg pei1 2peic 1peil p2eim 2peis pekt4i
+pekt4sp 2peku 1p4el 3pel. pe2l1a2 pe4lai peld4 3pele pe4l1e2h pe2l1er pe2let
+pe2leu peli2d peli4n pe4l3ink pel3inn pel3k pel3l2a pell4e pell2i pe2lob 3pels
+pel3sp pe2l1ä 1pem pe3nal pe4nas pen3d2a pe4nen1 pe4ni2t pe2n1o pens2a 3pen3si
+pen3s2o3 pens2p pen6ster 1pensu pen3sz pent2a 2pentw penty2 1pep pe3pi pept2
+pe1ra per2am per3as pe2rau pere2b perer2 perer3z pere1s pe3r2i3d 3perio 1perle
+3pero per4r3an 1pers 2perse 2persi 3persp peru2 pe3run perwa4r pe2r1ä 1perü
+pe3s2a pese2n 1pes5s2 pe2st pes4ter pest1o 3pet pet4r 2pex 2pf. p2f1ab p2fad
+p2faf pf1ai p2f1ak pf1am pf1ans p2fa2r pf3are p2f1au 4pfe. p2fef p2fei pf1eim
+pf1ein pfe2l p3fen. p4fener p2fent p4f1ep pfe2r5a p4ferde pfer6pro pf4es pf3f4
+p2f1i2d pf1inn p2f1in3s pfi2s pf1lam pf4lan pf4leg pf3lei pf3lo pf3lä p2f3om
+p2for pf3r pf1ra pf4rü 2pfs2 pf3sa pf3se pf3sl pf3sz 2pf3t4 p2fum 1pfä p2fär
+p2f1äu 2p3g2 pgra2 1ph 2ph. ph2a phal4te4 p1hand 3pha1s p1hau 2phb 2phd 2p1hei
+phen3d2 phe4n1e phen3s2 2ph1ers 2phf 2phg phien3 phik1a phi4kan 2phk ph2l 2phm
+2phn p2ho. p2hob ph4r 2phro 2phs ph3t4 2phthe phu4s phu3t 3phy 2phz phä1 3phän
+4phär 2phö 2p1hü pi2a1 piab4 pia3k4 pi4ali pia3n piap2 pias4 pi1ce pid2 pi2el
+piel3a 3pier pie2ra pie4reb pi3gl 1pil pi3le 3pilo pil4zer pil2zw p2im 3pin.
+pi2nad 3ping pingen4 ping3s 3pins. 3pinse pin3s2p pi2o pi3o2i3 pi3onu pi3os
+3pip pi2pe 3pirate pi3ri 3pirin 1pis 2piso pit2a pi3t2h pit2s pitz2e pi2z1in
+2p1j 2p1k2 pku2 1p2l2 2pl. 3pla 4p3lad p1lah pla3na p4lau pla2y 2p3le. ple1c
+ple2e p4leg ple3n2 2p3ler 2plig 3p4lik p4liz 2p3lu plu2s 3plä 2p3m2 2p1n2 1p2o
+pob2 po1c 3pock 3pod 3poe po2el 2poh po2i po3id 3poin 3pol po2lan po2l1au
+pold2e po3li polo3p pol3z2 pom2ph 2pond pont2 po1ob po2p1ak po2p1ar po1pe
+po2pl po3pt po1rau porf4 por3s 3portal por2th 3porti 3porto. 3portos 3portr
+por4tre por6tric poss2 po4sta pos4tag pos4tei pos3tel pos4tem po1s4tr post3ra
+po2stä po2ta pot1ar 3pote pot2h po2t3in pott1r po2t1u 3potä po3un po2w4 po3x
+2p1p p2p1ab pp1ans p2pat pp1au ppe3e pp1ei ppeli5ne ppels2 ppel5ste pp2e2n1
+ppe4na p2p1erz p2pf4 pp1fr p2p1h p4p1i4a pp3l p4p1lac p4plan p2ple pp1lä pp3oh
+pp3p4 p2p3ra p2pri pp3rol pp3rot p2p3ru p2p5rä p4ps pp3s4a pps2p pp3sy ppt4
+pp5te p4p1um p2p1ö2 p2r2 1prak 1prax 2pre. 2prec 3pred 2pree1 pre2ei 2preg
+1prei 3preis prei4s3c prei6sei 2preiz 1prem pren4ga 2p3rer 1pres press4e pri4e
+2prig pri2l1 2pring prings4 1prinz pri2t1 prit3a priter4 prit5t 1priv 1pro
+3prob pro3be 2proc 7p4rod 3p4rog 3proj 4pross pro1st prot2e 3proto 2prott p4rä
+1präd 1präf 1präg 1präl 3präm 1präp 3präs 1präv 2prö 1prüf 1prüg 2prüh 2prün
+2p1s 4ps. ps1ad ps2hi ps1id ps4pi pss4 p2st p3sta pst1au p3stea p3stel pst3erh
+ps2th p3s2ti ps4to ps2tu p3stä p3stö p3stü 3p2sy 4psys ps2ze p2sö 2p1t pt1a
+pt2ab pta2g pt3a4t p3te p4t1e2b p4t3ec p4t1ei p4tele p4temp 4pten p4t1en2g
+p4t1ent p4t1ep pt3erei p4t1erw p4t1erz p4t1e2ti p2t3h p3ti p4t1in1 pt3ing
+pto2mo pto2p p4tos pto2w pt3r pt3s2 pt4sl pts4t pt1uh pt1um p3tung pt1urs
+3p2ty pt3z2 ptü4 1pu pu1a pub4 2puc pu2dr 2p1uh 2puk pu2kl pu2k1o pu2lin
+pul2sp pul2s5t 2pulw pum2pl 3pun 4pund pun2e pun2s 4punt 2pur pu2ra pu2rei
+pus2h pu3she pu2s3t 3put pu5t2e put2s puzi3 2p1v 2p1w pwa4r 3py1 py3t 2p1z2
+1pä 3pä2c pä3cke pä4ck3er 3päd päde2 pä2d1er 3pär 3päs pä4t1e2h pä4tent pä4tep
+pä4t3erb pä2t3h pä2to pä4tr pät3s4 1pé pö2bl pö2c 1püf 2pül pül3l2 qu4 que3rel
+quer5n que4te. 1queu 1ra. r1aa ra2ab 2raac 2raal ra3ar r2a1as r1ab ra2b1ar
+1rabbi rab2bl 2rabd rabdru4 ra2bei rab2er rab3erd 2rabf 2rabg 2rabh 1r4abi
+2rabk r2able ra2bli ra4b5lo 2ra2br 2rabs4 2rabt 2r3abw 1raby 2rabz r2abä r2ac.
+ra2ce 2r1acet ra4cheb ra2cho rach6t5rä ra2chu r2ack 1r2ad r4ad. rada2 ra4dam
+2radap 3radar ra2dei 3radf 3radh 3radio 3rado 3radp ra4d1r rad5ri rad5t4 ra2el
+r2af raf3ahn raf3ar rafe2 ra2f1er raf3r raft5s rages4 2ragg ra3g4le 4ragm
+ra2gn r2ago 1rah. rahle4n 5r4ahm r1ahn 2ra1ho 4raht ra3hö r2ai 2raic rail2l
+2r3air ra3ke 2rakk 3ra1k4l ra2kre r3a2kro 2rakti ra2kus 2rakz 3rakü r2al r4al.
+ra2la2 ra4l3ab ral1ak ra3lamp rala4s ral3b4 3r4ald ra4l3end ra4lent ra4l5ern
+ra3lex r4ali ra2lid rali1e ra4lind ra4l3ing 2r3alk. 2r3alm. 2ralp. 4ralpe
+r4als ral3sk ral3su r3alt 3r4al5t4h ra2l3u 3raly ra2lä rama3s ra2mei ra2mer
+r2ami r2amm ram4man ram6mens ram6m5ers ram4m3u 2ramn 3ramsc 2r1amt ramt4s
+ran3ade r1a2nal ra2nan ra2nar ra2nau 2ranb r2anbe r4anda r4ande ran4dep
+ran4d3er 3r2andi rand3s rand5se 3raner 2ranf 2ranga ran6g5e6be 1rangi r2angl
+rangs2 rang5ste rani1e r3a4nil ran3ka ran2kr ran2kü 4ranl 2r1anm r2anmi r2anmu
+2ranna rano2i 2r1anp 2ranr 2rans r2ans. r1ansc ran4spa 4r5antei r1anth 2rantr
+1ranu 2ranw r2anz. r2ap 2rapf 2rapo ra2pok ra2pos rap2pr 2ra2pri 2r1aq r1ar
+r2ar1a 2rarc r2are 3r4arei raren1 r2arf4 ra3rie rar3in ra3ris r3a4rist 4r3arit
+r2ark raro2 ra2rom 2rart 2rarz rar3zw r2as r4as. ras2a ra4schl ra3spr ra2sta
+r4aste ras4t3ei r3asth ras4to 2rasyl 1rat r4at. rat1a rat2ak ra2tan ra2t1ei
+r3atel ra4tid 2ratm rat2o 2r3a2tom ra5tor rat4r 2ratta 2rattr 4ratz rat3ze
+4rau. 3raub. rau3e4n 2rauf rau3fä 2rau3g2 3raum rau4m3ag rau4man rau2m1i 3raup
+4raur 2rausb 3raus2c 2rausd 2rausf 2rausg raus8gewä 2raush 2rausl rau2sp
+2rauss raus8scheidu raus5se 2rausv 2rausw 2rauto raut1r rau4tra rau4tro raut5s
+r2ax raxe3 r3axt 2raß 1raü 4r1b r2b1ab r3bac rba4del rb2al r2bang r2bant
+rba3re rb1art r2barz rb1auf rbb2 rb1ech rbe3erf rbei5d2 rbe3inf rb3einh
+rbe3int rbel2o r4belä r3ben. rbe3r2e rber6gin rbe3rum r2bim r2binf rbit2a
+rbi3tu rb4la2d r2blan r8blasser r4b3last r3blat r3blau r2b3le. r3blen rb3ler
+r2bleu rb2lin rb2lö rb2ob rb3ras rb3rea r8b7rechts rb4sam rb2sei rb2ser rb2s1o
+rb4stä rb2su rb2u rby4t rbü4b 4rc r1ce rce4n r1che. r1chen r1ch2i rch3l
+r3ch4lo rch3m rch3r rch1s4 rch3sp rch3t2a rchter6r rch1w r1ci r2ck r1cl 4r1d
+rd2ac r2daf r2d1ak r2d1a2l rd2amm rd1an rdani1 rd1ara rd1ark r2darz r3de.
+r2dei rd2ei. r4deis r2d1elb r2delf rdem6 rden3d r4dengl rde4nu rde3ob rde3r4er
+rderin6s r4d3ernt r3des rde3sp r4d1ex r2d1inn rd1iri rd1ita r2dof r3don rd1os
+rd3oss rdo4st rd3rat r2drau rd4ri rd5ris rd4rö r3d4rü rd3s2k rd3s2z rd2sän
+rdt4 rd3th rdt2s r2d1uk rdär2 r2dö 1re 3re. rea2d rea6l5erw 4re2am re3at.
+re3ats re2b1a re2b1l reb1r reb3ra reb3so rech3ar 4rechs 2reck. 2recki 3red.
+re3da 4redd 2redi re2dik 3redn 3redu re1e 3refe 4reff r2eff. 3refl 3refo 3reg
+rege4l3ä 4r1egg re3gi 2reh re2hac re2har rehen1 re4hene re4h3ent re2hi reh1l4
+re2h1o re3hol re2hü r2ei. r2eib rei4bel rei4ble 2reid r2eie 4reier. rei4fei
+4reifel 2reig 3reigeh r4eigel 6reigens 3reigi 4reign 3reigru 3reigä rei3l2a
+rei3l2i 2r1eilt 3reim reim2p r1ein rein2a rei5nac rei3nal 2reinb rein4du
+rei3n4e3c reinen5 2reinf re4info 4reinn 4r3einr rein8s7tre rein4sz rein6teg
+re1in2v 4reisar 4reisb reises4 2reisf 2reish 2reisr reister6 2reisw 4reiti
+reit3s2 3rek 4re2ke 4rekk r2el. re3lat 2relb rel2e relea4 re5lei re2lek 4relem
+r2elev 2relf 2relit 2relix r2ell rel4lar rel4lei re3lo r2els 2relt relu2 r4em.
+4remb rem2da re2m1ei r2emi re3mig 2remis 4remit 4rempf rems1c rem4str 2rem2u
+r4en. r2ena 2rena. re4nac re3nal re4n3an 2r1endg 3rendi ren3dr ren2eu 5renf
+4rengag 2rengp 3renh re2ni 3renl 3renm ren4nar ren6nene renns4 renrü2 ren6sein
+ren6serg rens2p 2rentd 2rentf 3rentfo 2r1entg r3enthä 2r1entl 2r1ents 2rentw
+2rentz r2enz ren6z5er6f renzer6l ren6z5er6s renzer6w ren4z3in ren2zw re2ob
+re1on re3or 3repe 4re2pen 2repi re2pis 2repoc 2r1e2pos 4repp 3repu 3r4er.
+rera2 2r1erb rer2bi 3r2erbr 2r1erd rere2 4r3ereig r1e4rek re2r1ep r2erer
+2r1erf 4rerfah r4erfe 3r2erfr rer2fü r1erg 4r3ergeb 5rergebü r4ergen 3r4erges
+2rergo rer2gr r4ergru rer2hö r1erk rer4kan rer2ke 4r3erken 3r2erki 3r2erko
+r1erl 2r3er2la 5r4erlag r3erleb r2erli 2rerlö 2r1erm rer2n r1erne 2r1erni
+4r3erns 4r1ernt 2r1ernä re1ro re2rob re4rosi r1erre rer4reg rer4rei r1erri
+5r2ers. 2r1ersa rer3sc r6erschi r2erse 2rersp rer2st r6erstad r1ert4 r2erte
+4rerträ r1erw 2rerwa rer4wac rer4wec r4erwes 2r1erz 3r2erzy rer2zä 2r1er2ö
+3r4es. re2sa re4sam re3sar re4schw 3rese re4se2h 3resol 3reson res2po 2ress
+4resse res6s5erw 4ressu re1sta res4tas res4tex 2res2tu 3resu re2t1ak re2tau
+re2thy re4trol re2u reu4eri reu3g2 2reul re3uni 2r1eur 4reuu 4r3eva 2r1evid
+rewa4r re2wi 2rewo 2r1e2x1 2rezi 2reä 2reü 4r1f r3fam r2fent r3f2es rff2 rf3fe
+rfi4le. r4fland rf3lic r3f4lä rf4lö r3flü r2fo2b rfolg4s r3fot r4frauc rf4ru
+rf4rü rf4sam rf2su rf2s1ä rf2ta rft4r rf2u rfzu3 4r1g r2g1a2d r2g1ah r2g1ak
+rga4ner r2g1ap r2garb rg3art. r2g1ask rgas4ta rga3su rgd2 rge4an rge2bl
+r2g1e2c r3g2el rge4l3er rgen6sem rgen4z3w r4ge4tap r2geto r7gie rgi4sel r2glan
+r2gleu r2glig rg2log rg2lu r2g3na r2gne r2g3ni r2g3no r2g3oa r2go4b r3gog
+rg3op r2g1or r2g3ral rg4rau r2greg r2g3res r2gret rg3rin r3grun rg3rüs rg3se
+rgs2ei rg4sel rg3s4i rg3sp rgs2pe rgs2po rgs2ti rgs4tr rgs2tu rg3su rg3sä rgö2
+r2g1öd r1h4 2rh. 2rha r2ha. r4haltb r3han r2he. r5hea 2rheb 2rhef 2rheit 2rher
+2rhi 2rhof rho2i3 2rhol 2rhot 2rhs rhu2s 2rhä 2rhöl 2rhü 1ri ria3ne ri2ano
+ria1s ri2ast ri3at ri4atr rib2bl ri1ce ri1cha richt8spo 3richtu ri2con ri2dau
+ri3de. 4ridee ri2de2l ri4ds r2ie rief1a 4riefm rie2f3r ri2e1i riein1 ri1el
+rie3l2a ri3els riene2 ri3eni rien3s rie4nu ri4enä ri1er. rie3re riere4n
+ri3ers. rie3sa ri1eu ri2f1a ri2fei ri2fer rif6f5end rif4fer ri2f1o ri2fr rif3s
+rif4ter ri2fä 3rig 4riga 4r3i2gel ri4gene 5rigj rig1l 4rigr 4rij ri2kar ri2kin
+ri2kn ri4kone ri2kor ri2kä 2rima ri2mag ri2mau ri2me. 2rimm 2rimp rim2s rim4sc
+rim4st rin2c r1ind rin4dex rin6dize 2rindu ri3n2e rine1i 2r1inf rin2fo 3r2infr
+rin2ga ring3le rin2gr 2r1inh 2rinit 4rinj 4rink rin2kl rin2ko rin2kr 2rinl
+6r5innenm 4r3inner 2r1innr r1innu 2r1inq 2r1ins 3r2ins. rin4sek rin2so r4inspi
+3r2insy 2rint 4rinte rin4t5re 2r1inv ri2ob 4r1ir r2is ris2a ri3san ri4sch3o
+ri4schw 3risik ri3s2ko rismu2 r3iso 2risol ri4s3p r3isr 3riss ri4s3t ris6t5ers
+ris4t3r r2it rit2a r3i2tal rit3ant 2ri3t4r rits2 rit2t3a 3ritter rit4to
+rit2t1r 5ritu rix1 2r1j 4r1k rka2b3l r2k1ak rk1all rk2am rk1are rk1asp rkauf4s
+r2kef r3kel r4kelem rke2n1 rken3s4t rk5ersta r2k1erw r3ket r2k1im rk4las
+rk4lau rk4lim r2klis rk2lo rk2lu rk4n rk5nu r2kob r3kol r3kon rk1o4ri r2kou
+r3kri rk3rin r2k3rom r2krou rk3räu rk2sei rk2sel rk2ser rk3shi rk2so rk2sp
+rkstati6 rk4stec rk4stoc rk2ta rk2tel rk4t3eng rk4tent rk4t3erf rk4terg
+rk4t3erl rkt3ers rk6tersc rk4t3erw rk4t3erz rk4teta rk2tin rk4t1o2 rkto4b
+rk2t3r rk4tri rk2tum rk2um rku2n rku2sa r2k1äh rk2ö r2küb 2r1l rl2ab r3lag
+r5land rlan4d3i r2l1ar r2l1a2sc r2l3aug rle2a r3lec r3lep r3lex rlg4 r3l2i
+rli4ne. rli2s r3l2o rlou1 rls2a rl2spr rl3ste rl2s5to rl3t r3l2u r3ly rlz2
+rl2ö rlös3s 4r1m r2mab r2m1ad rma2la rm1ald rm1ami r2m1ank r4mantr rm1anz
+r2m3aph r2marc r2marz rma4s3pe rmat2o rm3d2 rm1ef r4m3einh rme4na rm2ene
+r2ment r2meo r2m1erh r2m1erl r2m1erp rm2es rme1st rmes4z rmeta2 r2mide
+rmi6nanz rminen4 rmi6neng rmon3s4 rm1o2ri rmo1s rm3p2 rm3sa rm3s2k rm3t2 rmu2n
+r4muna r2muni rm2är 4rn rna2b r3nad rn4ade r3nage r2n1all rna4n rn4and rn3ani
+r2nanz rna2r rn3are r4n3ari r4n1ast r4n3att r2nau rn3aug rnd4 rn3de rn3dr
+r4nef rn2eid r4neif r4neis rn1ema rne2n rn1ene rn2eng r2n1ep r4n1erg rn4erhi
+r4n1erl r4n1ert r4n1erw r4nerz r5nes rn2e2t rne4tem rne4to rn2eu rne3uf r4nex
+rn3f rn3g2 r2nid r2nin r3nit rnk2 rnn2 r3nod rn2oh rn3oly r2n1op r2n1or rn3s2a
+rn3s4p rns2u rn3s2z rn3s2ä rn3t2a rn3t2e rn1ur r1ny rnz2 rn1ö rnö2d r1nü 2robj
+rob2l 1robo ro2bo2r ro2bre 2robs ro1c roch2a 3rock. r2o3de ro3e4 2roff ro3fl
+4rog. rog2a 3rogg roh1l 4rohn 3rohr ro2hö 3roi ro3in ro1ir rok2l ro3le ro2liv
+rol4lan rolle4 rol6lerg rolls2 rol3s 2roly 4rom. ro2mad ro2mal 3roman. 2romb
+romen3e ro2m1er2 2romn rom3s 4romt r2on ro3n4ab ro2nan 3rond 4ronk 3ronn rons2
+ron4tan ron6tend ron4t3r ron2t1u ro1ny ro1o2f ro1pe 2ropf 1ropl 2ropt r1or
+ro2r3al ro2rat 2rorc ro2rel ro2ro ror3th rort2s ror2ü ro3sh ro3s2i ro5s2k
+ros4sal ros4san ros2s1c ros4st ro1sta ros6t1r ro2sum 4r3osz ro2tan ro4tas
+ro4t3au ro3te ro2tei ro2t3ho ro2tru rot3s rots2o rot2ta ro3tu ro2tä 3roul
+ro3unt 5rout 4roy 4roß ro2ßi 4r1p2 r3pa r3pe rper3in rpf4 r2pli rp4lu rpo2st
+rpro1 rp3se rps3t r4pt r3pu 2r1q 2r1r rr2ab rrat2s rr1auf rrb2 rr1c r5rega
+r5regi rr2ei rre2le rre2pa rr2er rrer4s r3res rre2ve rr2hen rr2hos rr2i rri3k2
+rrm2 rrn3au rr2o rr3obs rro3m rro2re rrr2 rr2st rr3str rr3stu rr2th r3r2u rrz2
+rr1äm r3r2ü 4r1s r3sabo r2s1a2d rs2al r4samp r4s1amt rs2an r4sanf r4s3ang
+rs3anm r4sanp rs3ant rs3anz rs3ar rs4ark r4sarm r4sch3e4b r6scherl r3schu
+r2s1ebe rse2e r2s1ef r2sein rse2n rs2end rse4ne r2sepi rs1ere r2serh rs1ers
+r2serz rse2t rs1eta rs2ext r3s2hav r3shir r3sho rs2hor r4shu rs2il rs2ka
+rs2kel rs2ki rs2kl r4skor r3s4kri r4sky rs4mog r3s4no r3so r4sob r4s1op r4sord
+r4sorie r4s3ort. rs2p r4spara r3spe r4s3ph rs4por r4sput rss2 rst3abl rst3ala
+r4stale r4stans r4stant r2stas r7stati r7statu rst5eing r6st5eint rs4temp
+rster2 rs4t4erb rst3erl r3s4tern rst3erw rs2tev rs2t3h rs2ti r3stie r2stin
+rst3ing r3stink r2stip r2stit r3sto rs4tol rs4tor r4stot rs4tr r3stra rst3ran
+r6strang rs2tu rs4tuc r3stä r3s4tü rsuch4s r3suf r3sy 4r1t r2tabo rt1abs
+rta2ck r2t1ad r2t3ae r3taf rt3akr r4t3albe rta3l2e r2t1all rt1am rt2ame
+r3t2anb r2t1ang rt1ann rt1ant r2t1ar rt3att r3taufe rte1e2 r3teh rt1ein
+rt4eind r4t3einh rtei1s4 r2telf rtels4t r2temo rte2n1 rte4na rten3s4 r4t3ents
+rten3z rteo2 rt3erei r6tereig r4terfa r4ter4fo rt1erh rt1erk r4t3erla
+rter8löse rter6mit r4t3ernä rter4re rt1ers rt4ersp rt1erz r2terö rte3s2k
+r2texa rt3he r2t3hi rt3hol rt2hum r3tic r2tid rtik2 r2t1ima r4t3inf rt2is
+r2t1o4b r5top. rto1pf rt1or r4torg r4trak rt3rams rt3rand rt3rati rt3rec
+r3tre1s r4t3ris rt3rol rt3roma r3trop r2trou rtrü2c r4ts rt4seh rts2el rt3sex
+rts3ing rt2s1o rt2spa rt2spr rt4s3tan rts4tie rt3t4 rt1umb rt2u3na r2t1up
+r2t1urt rtu4t r2t3ute rt3z2 rt3äh rt1änd rt1ärm r3tü 1ru ru1a ru4ale ru3a2r3
+rube4 ruben3 rubens4 rub2i ru6ckerl ru2cku rude2a ru2dr 3ruf ru2fa ruff4 ruf2s
+rufs1p ruf4ter ru2g3r 3ruhm 2r1uhr 3ruin ru1ins ru1is 2rum 4r3umd 4r3umf
+4r3umg ru2mi 4r3uml 4r3umsa 4r3umw 4rumz 2r1una 2rund run4d1a runden5e
+run4d3er runds2 run2e runei2 4r1unf run2ga 2rungl 4r1u2ni r3unio ru4nis.
+run2kr 4r1unl 2r1unm 4runn 4runr r1unse 4r3unt 4runw 2rupd ru3pr 4r3u2r rur1e
+5ru3ro ru2si rus2p rus2s1p rus4st ru2st ru2tab rute4 ru4tei ru4t1el rut3h
+ru4t1o4 ru2t3r rut6scha 4ruz ru2z1w 2r1v rv2el rve4n1e rvenen4 r4ventz rve3s
+r3v2o 2r1w rwe4gel r3wei rwelt4s r5werk r5wert r2wo. r3woh r3wort rwun3s 4r1x
+1ry ry2c ry3sth rysti1 2r1z rz2ans r2zant r2zar r2zat r5zene rz1eng r4z3ents
+rze2p rze2ra r2z1erd r2z1erf r2z1erg rz1erk r2z1erl r2z1erw r2z1ess rz1id
+rz1int rzir3 rz2of r2z3ot rz2tan rz3te rz2th rzu4g3l r3z2wec r2zwir r2zwä
+r3zähn rz2än r1ß 4räb 3r2äd 4räf rä1fr 4räg 2räh 4räm 3rän. 3räni 3räns 2räp
+2räq 2r1är r2är. rä3ra rä1ro rä2sc räse2 räte1s 3rätse 4rätz rä2u 4räue räu2s
+räus4c räu7schen. 2räuss 4räut 2räuß 2räx r1ç 1ré 1rí rö2b3l rö2du 2rö2f 3röh
+2r1ök 1röl 2röl. rö3le röl2l r1ölp 3römi r1ör r2ös. rös1c r2öse 1rösl 3rötu
+4röß 1rü 2rüb 4rübu rü1ch rü4ckel rücks4 rü2hel rüher2 rüh1l 4rümm rün3z 1sa
+3sa. 3s2aa 2s1ab 4sabd sa2be 3sabet s3abi sa2bit sa2bl 4sabm sa2bor sa2br
+4s3abs sab2ä 4s1acc 5s2ache sa2cho sach3t s2ack 2s1ada 2s1adm 2s1a2dr 3safa
+sa4fe 4s3aff sa1f4r s2aft saf4tr 3saga sag2e 5sagen. 4s3agent 2s1agg sa2gio
+sa2git sag4n s1a2gr s2ahs 3s2ai sa3i2k1 sail2 sai4r 2s1ak sa2ka sak2e 3saki
+4sakk 4sakt 3s2al. s2al2a sa2l3an sa2lar sa3lat 3salb sal3bl 3sald sa4lerk
+3sali sa2l1id s1all sal4le. 3salo sal2se s1alt s2al3t4h 3salz 3sam 4s1a2mat
+4s1a2mei s3ameri 5samm 6s1amma 4s1amn s1am3p4 4samph sam4ta sam4to samt3st
+s1an s2an. 2s3a2na 2s3anb s2an2c 3s2and san4dan san4dri sand3s sa2ner 3sang.
+2s3anh 3sani 3sanken 2s3anl 2sa2no 2s3anp 2s3ans s4anse san4sk san3sp 4santei
+4s3antr 4s3anw 2sanz sa4nä 2s1ap sa2pe sa2po sap3p 3sapr 2s1aq 2s1ar 3s4ar.
+3sara 4s3arb 3s2ard s2are s3area sar2ga sa3rin s2ark 4sarm. sa2rom s3arr s2ars
+4sart sa4r1u2 sas2a 2s1asc 2s1a4si 2s1a4sp sas2tu 4s1asy sat2a sa4t3ant sat1ei
+2s3a4tem s3ath 3sat2i 2s3atl 2satm sat2o sa4tol sa2tom sa2tr s3atta 4s3attr
+3satz 5satza sat4zel sat4z3en s1au 3sau. 3sauc 3sau2e 2sauf 4s3aufb saug3le
+sau2gr 3saum sauri1 2saus 3saus. 4s3ausb 4sausf 4sausg sau2sp 4sauss 3sauste
+4s3ausw 2sauß s1av sa2ve sa2xi sa2y 4s3b4 sba4ne sbau6men sbe3r2e 1sc 2sc.
+2scab 2scac 2scal 2scam 2scar 2s1ce 4s3cei sc4h 4sch. 3schaf 3s2chal sch3ana
+4schanc 4schang 4schao 4schara 4sch3ar5m 4schb 4schc 2schd sch2e 4schech
+6schef. 6schefi 6schefs s4chei 4sch3ei. sch6ein. 4schemp s4cher sch5erfü
+3sches 4schess s2cheu 4schex 2schf 2schg 2schh schi4d schi4e s4chif 4schiru
+3schis 2schk s4chl sch4lac 4schle. 6schlein 4schloc 4schlöc 4schmas 4schmed
+2schmy 2schmö 4schmüh 2schn. 4schneb 4schnut 4schobj 4schorc 2schox 4schp
+2schq 4schrad 4schre. 4schrin 4s3chris sch3rom 4schron 4schrou 4schs schs2e
+sch3s2k schs4ti 4sch3t scht2a scht2i scht2o s4chu 4schunt 2schv sch4web
+4schweg 6schwerk 4schwet 4schwid 3schwu 2schz s2chä 2schäq 4schör 2scj 4s3cl
+2sco 3s2cop s2cr 2scs 2scu 4s3d2 sda3me sde1s2 sdien4e sdi1st sd4r 1se se3ar
+se3at. seb2 2s1e2ben 5sebä 2s1echo sech4st 2s1echt 4s1e2ck se2dik 3see see1i4
+se2e3ig se2el see3len se3en. seen2e se3er. see1ra seer2e se3e2r1i se1ers
+see3s4 see3t 4s3eff sef4l 3s2eg 4s3e2gal se2gl seg4r 3seh seh1a se2hag se2hel
+seher4e se4herk se2h1in seh3l seh3re seh5r2i seh1s seh3t se2h3ö se2hüb 2sei.
+2s1eic 2s1eid. sei3da 4s3eifer 4s1eig sei3le s2eim s1ein 5s4ein. 2seinb
+sein4du 2sei3n2e seine3i 4seinfl sein4fo 4seing 2s3einh 2seini 2seink 2seinl
+2seinn 4seinr s4eins. 4seinsc 4seinsp sein8stit sein6str 4seintr 2seinw
+2s3einz 2s1eis 3s2eit seit2s 3sek 4s1e2kel 4sekz s2el. se2l1a se3lad 3s2elb
+sel1ec se2lef 2s3e2leg 4selem se2ler sel3ers 2self. s3e2lit 2s1elix s2ell
+se2lob s2els sel3sz selz2 sem2a sem2e 2s1emis 4s3emp s4en. 3sena se4nad se3nal
+se4nas sen3au s2enb 2s1endl sen3d4r senen1 se4nene 4senerg se4ners se4ness
+s2enf 3s4eni se2n1im 3s2enk se2no se4nott se4noz 3sens s2ensa sen4s3e4h
+4sensem s4ensi s2enso senst2 sen8s7turm sent2a 2sentd 4sentf 4s1entg 4sentla
+4sentn sen3tr 4s1ents 2sentw 4sentwu 4sentwü 2sentz sen3tä se4n3u sen4zer
+sen3zw seo2r se2pen 3seq s4er. se2r3a2d ser3al ser3ass serb2 s3erbe. se2re2b
+6sereign se4r3eim 5s4eren se4r3enk s4erfe s2erfr s1erfü 4serfül ser3g2 s2ergr
+s1erh 2serhö 3seri 5serie ser3k4 4s3ermit s2ern. s3erneu 4s3ernt 2s1e2ros
+s1erot 3s2ers. 2sersa 4serseh ser6sehn 4ser4set se3ru se4ruh ser2um se4rup
+5s4er3v s1erz ser3äus s1erö 4seröf 5s4es. se3s4a se2sel se3sk 2s1essa se1sta
+se3stec se3stei se5stemp sest3ri set2a 2s3e4tap se2tat s1e2th 4s1e2tik se3tun
+se2ty 3setz 3seuc 4s1eul seum4sc se1u2n s1ex 5sex. 2sexa se2x3en s2exi s2exo
+4sexp sex3t4r 4sexz 4s3f4 sfal6l5er 4s3g4 sgang4 sga3su sge3s4a 2s1h 4sh. sh2a
+3s2ha. shal4li shalt2 shalt4s 4shan s2has. sh2e 3shi. 3shid s2hip shi4r sh3n
+4s3hoc 4s3hof 4shom 3s2hop sho4re 3s4how sh4r2 4shs s3hu s3hä 4s3hö 1si si2ad
+2siat 5s4i1c si2cha sid2 s2ide. s2i3do 2sidy 3s4ie sie2bu sieh1e sie4hes
+si3e2n3 si1err si1f4 3s4ig si2g1a2 si2gei sig4n si2g3r sig4st si2k1ab si2kak
+si2kar si2k1el siken2 sik3erl si2ket si2k3i sikin1 si2k3n siko3 si2k3r sik3s
+sik3t4 si2ku si2k1ä sil2br sil2e 3sili s1ill 3silo 2s1imm sim4st 3simu si3n4a
+2s1ind 2s1inf s3infor sing1a sin3g4le sin2g3r sings2 sing3sa sing3so 2s1inh
+s1in1i sini1e sinner4 2s1inno 2s1inq 2s1ins s2ins. 2s1int 2s1inv 3sio sion4
+sirn4 2sirr 3siru si2sa si4sam 3s2isc si4schu si2s1e2 si2sis s1i2so si2s3p
+sis3s4 3s4ist si2su 3s2it si2tal si2tau si2tra sit2u si2va sive3 si4v3erf
+siv1o4 si2vor siz2 2s3j 2s1k2 4sk. sk4a 4s3kab s3kad 4skalk 4s3kam 4s3kana
+3skanda 4skanä 4s3kap 4s3kar 4s3kas ska4te. 4skateg ska4tes ska4to 4skb ske2li
+4sken 3skep 4sker s3kh 3s2ki. 3s2kif 3s2kik s3kin 4skir ski1s s2kis. 3skiz
+sk4l 4s3klas 3s2klav 4s3klu 4sk4n 4skoh 4skol 4skom 4s3kon 3skop. sko2pr 4skos
+4skow 4s3kra s3kre 4s3kro 4sk3s 4sk3t2 skto2 3skulp 4skun sku2s1 4skv 4skä
+4skö 4skü 2s1l2 sl4a s3lab 3slal sla2ve s2law sl3b 4s3le sler3s s3li 3s4lip
+s3lo. slo3be s3loc s3loe s3lof 3s2low s3ly s3lä 2s3m2 sma3b4 sma3sc sme3na
+smi2t 2s3n2 snab4 sni4a sni3er. sni3ers 4s5not 1so 3so. 2s3oas 2s1o2b 3s2o3ba
+4sobj 4s3obo so1c so3et s1o2fe 3soft 3sog sog4l s1o2he 3sohl sohle2 2s3ohng
+2s1ohr 3soi 2s3ok 3sol. so3la so4lau 3sold 3sole so2l1ei so3li sol2la sol4ler
+so3l2o 4s3o2ly som2e 3son son2a sone4 son4s1o son3sä so3o 2sope 2s1opf 3sopr
+sop3s s1orc 2s3ord sore2 so2rei so2rel 4s1orga so1rh 2s1o2rie so2ro 3sorp
+3s2orti so4ru 3sos s2os. 4so4sk 4sosm 4s1ost 4s1osz soth1o 3sott soun2 sound1
+so3unds so3unt s1out 3sov 3sow 2s1ox 3soz s3o4ze 3so3ß 1sp2 2sp. 4spaa s2pace
+2spack 2spag 2spak 2spala 3spalt 2spalä spa2m s2pan. 3spannu 3spant 2spanz
+2spap 2s3para s4parka 2sparo 5s6parten 4spartn 4sparty 3spat. 2spati 4spatr
+2spau 3s2paz 3spaß 2s3pe. 2speg 3speic 4spein 4spensi spe3p4 s2pera 3s2perg
+s1peri 4sperle 2spero s2perr 2spers 2sperü 4spet 3s4pez 4s3pf4 2spha s3phe
+s4phin s2phä 3sphär 3s2pi4e 4spier spier4r s3pi2k 4s3pil 3spio 2spip 4s3pis
+2sp4l 4spla 4sple sp5le. 3s2pli 4s3plu 4splä 2s3pn 2spod 4spoe 2spog s2poi
+4s3pok 4spol s2pons 2spop s2pore s2porn spor6tag 4s3pos 4spote 4spr. 3s2prac
+2sprak s2pran 2sprax 3s4prec 4spred 4spreis 5s2pren 2s3pres s2pric 3spring
+4sprinz s2prit 2sprob 4sprog 4sproj 4sprop 3spross 2sproz 3s2pru 3spräc 2spräm
+s2prän 4spräs 3sprö 3sprüc 2sprüf 3sprün 4s3ps 2s4pt 2spub 2spud 3spuk 3s2pule
+2spun 4spunk 2spup 3s4pur spu4rer 2sput 4spy s2pä 2späd 3späh 2spär 2späs 2s1q
+4s3r4 srat2s sre3cha sreli1 sre4th srö2s srücker6 6s1s ssa3bl ssa3bo s5sack
+ss4agi s2s1aj ss3alba s2sall s4samt s2sanf s4sang ss2ann s4sano s4sans ss2ant
+s4sanz ss2ara s3sars ssa1s ss3att ssau3e ssau4r s4sce ssch2 s3schw s4sco s2scr
+s4seben ss1ec sse1e sseh2a ss4eind sse3int s4seis s3sel sse2lö s3sen. ssen6sem
+ss1epe sse6ratt ss2erf ss3erfü ss4ergr sser4hö sser6mit ss3erse ss4eru
+sser6wei sser4öf sses4sa s4s3estr sse3ta ss3i2ko s2sill s2simp ssing3s s2s1isr
+s3skala ss3l ss1off ssoi4 s3sol s4sop ss2orc ss2phi s3spi ss2pot s3spri s2spro
+s3sprä ssquet4 ss3s4 sssau4 sst2a s4stag ss3tak sst2e s3stel s3s2tep s3s4tern
+ss4teu ss2ti s3sto s3stran s3s4tras s3s4trat s3strec s3strom s3strä s3strö
+ss2tur s3stä ss4tör s3stü s2sumg s2sumr ss1ums ss2ur s3sy s3s2ä s1t 4st. s2ta
+2sta. 3staa 3stab. 2stabb 4stabel 4stabit 2stabl st2ac 3s4tad 4stadm 3staff
+2stag 3stagl 3s4tagr 3s4tah 2stak 3staks 2stala sta3lak 2s3talb s4talg
+s3ta3l2i 2stalk st1alp st1alr st1a2mi 1stamm 1stan 2stanb s4tand 2stanf s4tanh
+2stanl s4tanm 4st1ann st3ansp 2stanw stapo1 4stapol 4s3tapos4 4s3tapot st1app
+s4tar. s4tarb sta6rens 4stari s4tark s4tarm s4t2ars s4tart s4tase s4tasi
+stast4 s3tat. 2statb 3stati 7statth s4tau. 2stauf 2staug 3s4taur 4stausb
+4stausg 4stausr 4stauss s4taut s4t1a2ve 4stax 2stb 2st3c 2std 4ste. 4steam
+4stechn s2te2d st1edi 2stee 3s2teg ste2g3r 1steh s2tei 2steic st1eid 3steig
+stei4gr 2steil 6steinga s4teins stein6sp s2tel 2stel. s3tele s3telf st2ell
+stel6l5än 2steln 2stels 2stem ste4mar ste6ment 3stemm 2sten s5ten. ste4na
+s4t3ends st2ens4 s4tentf s4tents st1e2po 2ster 4s5ter. ste2r3a s6terben
+3sterbo 3s4tereo st3erfü 6sterinf 6sterinh 4sterm 3s4ternb 4ste2s1 ste3sc
+stes4se s4testn stes5tr 2s3tet ste4tag 3s4teti 3s4tett 3s2teu 1steue 4steuf
+st1eun st1ev s2tew 4stex s2texa 2stf 2stg 2sth st4hen s2t3hi st3ho s2thu 2stia
+2stib 1stich 2stie. 4stief. 4stiefl s2tieg s2tiel 2stien 1s2tif 2stig 3s4tigm
+2s3tik s2t2il 1s2tim 4stimma 2stimp st1inb s4tinf s3tinn s2tins 2s2tint 2stio
+2stip. s4tipe 4stipp. s2ti2r st1ira st1iri 4stis s4tisl st1ita 2stite 1stitu
+2stiv 2stj 2stk 4stl 2stm 2stn s2to 2sto. sto2bl 4stocht 2stod 4stod. 1stof
+s4toff 2stok 4s5tole sto3mi 4ston s4to4ne 4stoo 2stopo 4stor. s4torb 2store
+2s4torg 2stori 2storp 2stors 2stort s4tory sto3s2t 4stote 2stotr 4stou 2stow
+2stoz 1stoß 2stp 2stq s2tr 2strac 4s3trad st4rade stra4fa 4s5trag 3strah
+4strahi 4strai 4strak 2stral s5trank 4strans 1strap 1stras 4straum 3straß
+2stre. s4trea 4stref 4streib 3st6reif 2strep 2stret 4streuh 2strib strie3s4
+2s4trig 1s4trik 2s5tris 2striu s3troc s3trog 3s4troh 3strom. s4trome 4stropf
+2stros st4ross 1stru 2strua 2strub s4trud 2strug 3struk 2strun 4strup 4sträc
+4s5träg 4sträne 1strö 2ströp 1strü 4s4t3s2 sts4t 2st3t4 st2u 1stub 4stuch
+3s4tud 2stue 3stuf 2stug st3uga 3stuh s2t3uk 2stumo 2stum2s stum4sc 2stumt
+2stun. st3una 1stund 2stune 2stung s2t3uni 4stunn 2stuns 2stunt stu3ra stu5re
+2st3url 4sturn 2st3urt 3s2turz 2stus 1s2tut 2stuö 2stv 2stw stwor2 2sty 4sty.
+1s2tyl 4styp 4stys 2st3z2 1s2tä 3stäb 3städ 4stäg 4stäp 5s4tär 3stätt 2s3täus
+1s2tö 4stöch 2s3töl 2stön 3stör 2stöt 1stüc 4stüch 3s4tück 3stüh 4stür. 4stüre
+3stürz 1stüt 2stütc 1su su1an 3su2b3 su4ba2 4subi su4br 3su1c su2cha su2cho
+suchs3p 3sud su2eb 2s1u2f su3fi 2s1uh 3sui su1is su1it. su2k su3l2i sul3t
+3sulta su2m1a s2ume su2mei su2mel sument4 su6ments 2sumf s3umfa s3umfe su2min
+3summ sum1o2 su2mor 3s2ump s3umsa 2sumse s2umsp 2s3umst 2s3umwa su2n 3sun.
+2s1una sunder4 sun6d5erh sunds4 su4ne 4s1unf 6sungena s1ungl sung4s 4s1uni
+2s1unm 2s1uns s4uns. s4unst 2sunt 2sunw s4unwa 3s2up sup3p4 su2ra sure4 su2rer
+3surf 2s1urk s1url su2r1o s1urt su2s1 su3s2a s3u2t su4te su3tr 3suv 4s3v2
+svoran4 2s1w 4s3we swe6gers sweh2 4swie 4swil 4swis 4swit 1s4y 2sy2l1 sym3
+sy2n3 sy4nä 3sy4s3 2s1z2 4s3za 4s3zei 4szel 3s2zena 3s2ze3n2e 4s3zent 4s3zer
+s2zes 4szet 4szeu 3s2zew 4s3zie 4s3zo 4s3zu 4s3zw 4szä 4s3zü 1sä 3s2äb 3s2äc
+3s2äg s1äh 4s3ähn 3säl 4s1ält 2s1äm 4s3änd 4s3äp 2säq 2s1är 3s2ärg 3s2ät 3säul
+4säuß 1sé 1sí 1sö sö2c s1ö2d 2sö2f 2s1ök 2s1öl 2s1ös 1sü 2sü4b 3süc sü2d1
+süden4 3sün 3s2üs 3süß 1ta 3ta. 4taa 5taan 4tab. ta2b3an 2t1abb 2tabd 3tabel
+2taben 4tabf 2tabg 4tabh 2t1a2bit 2tabk 2tabla 4tabm 2t3abn 2ta4br 4tabs
+t1abst 2t3abt 4tabw 4tabz 2t1ac 4tachs 3tacu t1ada 2tadd ta2der tadi3 t1adm
+ta2dol t1a2dr ta3d2s tad6t3 ta2er 3taf. 3tafe 4tafet 4taff t1afg t1afr 3tag
+ta2ga ta2g1e2i tagen1 t3agent 4t1agg 4ta3gl 4t1a2go tag4san tags3c tag4st tah2
+tahls4t ta3i2k tai2l1 ta1ins tai4r ta1ir. ta1i2s 2t1a2ka ta3kes 2t1akk ta2kro
+tak4t1o2 t2aktu 2takz 3t2al. ta2la ta3lag tal1an ta3lat tal3au 4talb. 4talbk
+tal3d4 3tale ta4l3end tal3eng ta4lens tal6ents ta4lerg ta2let tal2ga tali6ene
+tal4l3ac tall3ei tall3s2 tal2l1ö2 2t1alm. 3talo ta2lop ta2l1o2r tal2se tals3en
+t1alta tal3th talt4r ta2lu 2tam 3tam. 3tame 5t2amen t1a2mer tamm1a tam4m3er
+t1ampl 3tams t1amt 2t1a2na tan3ab 4tanal ta4nat 2tanb 3tanc tan3da tand4ar
+tan2dr tand4st ta4nerf 4tanf 4tangeb tan4gra 2tanh t2anho t4ani 3tanj tan2kl
+4t3anl t1anm 4t1anna 3t2anne t1ano 2tanp t1ans t2ans. 4tansi tan4tan t4ante.
+4tantei 2tantr 2tanwa 2tanwä t2anz. t1anza 4tanzei 2t1anzu tan2z1w 4tanzü
+2t1a4nä tao2 ta3or t4ape ta2pes 2tapf ta2pl ta4poka 3tapol t2appe ta2ra 2tarab
+3tarabb ta3rak 2taram tar3ap t2arau 2tarb 3tarba 3tarbek 3tarber 3tarbi
+3tar3bl 2tarc 3tarchr t2ard t2arei ta2rel ta2r1er tar3g ta1r2h 3tari tark4l
+t2arko 4tarkt t2arl 2t1arm t2armä ta2rom 2tart t2ar2ta tar6ter6e 3t2arth
+t1arti 3t4artis tar4to tar2tr ta2ru 2t1arz 3tarzu 3t2as. ta3sa 3tasc ta5se
+4t1asp 2t3assi 3tast tas4tem tas4to t4at. ta2ta2b ta2tan 3tatb t4ate tat1ei
+t5a2tel ta2tem 3taten ta2t1er t3atl ta2tom ta2tr 3tatsa 2tatt tau2b1a 3taubh
+tau2bl tau2br tauchs4 tauch5sp 4taud 2t1auf 3taufe. 4taufg 4taufl tau3f4li
+t3aufo taufs4 3taug 4t3auge t1auk 3taum 2t1ausb 3tausc tau6scha tau6schm
+tau6schr tau6schw 2tausd t2ause 4tausf 4t3ausg t1ausk 4tausl 2tausr 4t3auss
+2t5ausw 4tausz 4tauu 3tav 4tava ta2van 3tax 4t1axt 3taz 4t3b4 tbauer4 tbe3r2e
+tblock5e tblocken8 tby4t 4t1c t3cha t3che tch2i tch3l t3chr t2ch1u tch1w t4ck
+t3cl tcor2 t3cr 4t5d4 tdar2m1 tdun2 1te 3te. te2a2 tea3c te3ad te3ag 2teak
+te3al 3team te3an te3ar tea4s 3teba t4ebb 2t1e2ben t2ech 2teche 3techn te2chu
+2teck te2cki tecks4 2t1ecu te2dit te1em teen1 te2er. te1erw tee3t 3tefa 2teff
+2t1egg 2teh 3teha te2hac 3tehi te2him t1ehr 3tehä 3tehö 3tei. 3teic tei1fl
+teik2 3t2eil tei2la tei6lent teim2 2tein t2ein. t2eine teinen4 tei6nens
+tein6hab t3einkü te2is t1eis. t1eisb te5isch. t1eiw tei3z te2kel tekt4 3tel.
+3te2la tel3ab tel1ac te3lan te4lant tel1au 3telb4 3teld4 tel1ec tel3ehr 2telem
+tel3eng te2ler tele3s te2leu 4t3elf. 3telg tel1in te2lit 3telk tel6lant tel3le
+tel6lein tel3li 4tellu 3teln te2lob te4lost 3telp 3tels tel3s2k 3telt4 tel3ta
+te2lä te2l1ö 3tem. 3tema te2map te2mau t2emb te2m1ei te2m1er te2mi tem3i2m
+tem3ing 2temm te2m1o2r 3temper 2tempf 4tempfi tem3s te2mu te4mun 3ten t6en.
+ten1a2 te4nad te4n3an te4nas te4nat ten3au ten3da 4t3endal tend4an 4tendap
+4t5endf 4t1endl t6endo 4t5endp ten3d4r te2n1e2b te2nef te2neh ten3ei te3n4ei.
+tene4m tenen1 te4n3end te4nene te4neng te4nens 4t3energ te4n3ern te4ness tenf4
+4t1eng. teng2a ten4gag 4t3engla te2ni te4nil ten1im te4n3in tenk4 ten3n2
+te2nol ten3se 4t3ensem ten6serg tens2p tens3th t1entb 4tentd ten3te 4t3entl
+4t3entn ten6tric 4t3en4tro 2t1ents 4t5entw 4tentz te2ny ten3ä te3nö teo2f
+2tep. 2t1e2pi 2teppu tept2 3t4er. t4era tera2b ter3a2c te2rad te1ral tera2m
+ter4ane te2r3ap ter3a2s 4terbos 2t1erbs 2t1erbt 3terc ter3d 4t3erde. terd2s
+3tere. te2re2b te2rec t3ereig 3tere2m te4r3emi 3teren te4r3end te4rene te4reng
+te4r3ent 3terer terer3k terer3l te4r3erp te4rers te4rerw 3teres te2ret t4erfr
+terg2 ter3ga 6tergebn t6ergem t6erges t6ergew ter3gl 6tergrei t4ergru t6erhall
+t4erhan t4erhau t4erhei 7t2erhi t2erho t2erhu t4erhäu 6terhöhu te3ria ter3iko
+2teril terin5d 3terinf 3terinh ter3k 4terklä t4erli t4erlä 3term t2ern.
+ter4nar 2t6ernc ter4obe 2teros t4erp t4erra ter4re. t4erro t4ers. t2erse
+terst4 t4erst. t6erstad ter6stat t4ersti t4erstr t4erstu t4erstä t4erstü tert4
+ter3ta t4eru2 te4r1uf t4erv 4t3erwäh 4tery ter3z2a 2t1erzb t4erzei 4terzeu
+ter5zo ter3zw t1e2r1ö 3tes tes3a2c tesa2k tes2c tes4pen te2spr 2t1essa tes3si
+tes3tan tes4tel tes6terg tes6terh tes6terk t3est3ri te2su tet2 3t2et. te4tabl
+2te2tap te2tat 4tetl 3teuf 3teum 3te1u2n 4teunu 2t1eup 3teur. te2va te2vi
+tewa2s 3tewo t1e1xa 2t1e2xe te3xel 2t1e2xi 4texp tex4ta 2t1exz 6t3f6 4t1g2
+tga4s3er t3ge tgenen3 tger2a tger2i tg4r t1h 4th. 2th2a 3t4ha. 3t2hag 4thak
+3thal. t2hali 3thalp t2han. t3hand t3hap 4t3hau 2thb t2h2e 1the. 3thea 2t3heb
+2t3hef 2t3hei t4he1in t4hek 3t4hema 2themd t4heme 2themm 1then t4hene t4heni
+3theo t3herd t4herm thero3 t3herr 2t3herz 4t3hess 2thf 1t2hi 3thi. thic3k4
+t3hiel thi3er. 2t3hil 2t3him t3hin thi3nu 2t3hir 2thk 2th3l 4th3m2 thmu2 2th3n
+1t2ho 2t3hob t3hoc tho3chr t3hof 2t3hoh t4hol. t4holo 2tholz tho1s 2t3hot
+3thotr 2thou4 t3hov 2thp 1th2r2 2ths 2tht2 2thub 2thuh 4t5hun 2thut 2thv t2hy
+2thä 4thäl 4t3hö 2thü 1ti ti2ad ti3ag tial2l ti3a2m ti2are 3tib 2tic 3ticc
+ti1ce t1id t2id. 4tidee ti4d3en4d tie3br 3tief. 4tiefel 3tiefl tie2fr tieg4
+2tieh ti2e1i ti1el ti2el. tiel3a ti3e4n1 tien3s 3tiera tie4rei tie4reu ti2ern
+tie3s2t ti1eu 4tieß 3tif. ti3fe ti1f4r 3tig ti2gan 4t3i2gel ti4gerz ti2git
+tih2 ti2kam ti2kar ti4kau ti3k2en tik4ere ti2kin ti4klu ti2kn tik1r ti2kra
+ti4k3rei ti2krä ti4lant ti2lar ti2lei ti2lel 3tilg 2tillu ti3lo tilt4 ti2lu
+ti2lö ti2ma2g 4timm timm1a tim4man t3immat timmer4 tim6merg 3timo 2timp tim2s
+3tin. ti3naf ti3nak ti2nam ti2n3an 4t3ind ti5n2e tine1i 2t1inf tin2g1a tin4g3l
+ting3s t1inh 3tinis t1in1it 4tinj 2t1inka tin2k1l tin2kn tin2kr 2t1inku t2inn
+ti2nor t1ins 3t2ins. t3insa 4t3inse tin4spa tin4sum t2insä t1int ti3nu tin2um
+4t1inv 3tio ti2osk tioxi3 3tip ti3p4l ti4que. 3tirad ti1rh ti4ron 3tis
+ti6schei tisch3l tisch3w ti2sei tis2el ti3sk 2t1isl ti2sp 2t1isr tiss4 ti3s2th
+tis3ti ti1s4tr ti2su tit2a ti2tal 3ti3te ti1th ti3ti 2ti3tu tium4s 3tiv ti2van
+ti2vel ti4vene tiver2 ti4verh ti4verk ti4verl ti2v1o ti4v3r ti2za ti2zir 2t1j
+4t3k4 4t3l2 6tla tlan2g tl4e t2lef tlei6der tle2ra 6tli tlings5 tlit1 tlung4
+t5lö 4t3m4 tma2st tmen8schl tmen6t5 tmo4des 4t3n4 t5na tnes2 tnes4s 1to 3to.
+to4as to5at t2oba to3be 2tobj tob2l t1obs 3tobt to1c t3ochs 3tocht to6ck5ent
+3t4od tod1er2 to4d1un tof4fa tof6f5ent tof4f3er 2toffi toff3s 3tog 2t3ohr 3toi
+toi4r 4toiz 3toj 3tok4 3tol to3le 4tolp 4tolz tomar4b 2tomg to2min 2tomk 3tomo
+to2m1u to4mun 3ton to2nan tond2 to2n2eh toner6ke to4n3ig to3ny 3too 3top.
+to2pak to2pan to2pat to4pfe top1hi 3topo 2to4pt 3tor t4or. 4torc t1ord t2ordi
+4t3ordn t4ore to4rein to2rel to2rem to3ren tor4fan t1or3g 4torga t5orient
+tor3int 5tork to2rop t4ors 4t1ort. tor3ta t1orth 4tortn 4tort2s to4ru t2orw
+to4rän to2rö tos2e to3s2h tos2p 4toss 3tost 3tote to2tho 3totr tots2 to2tä
+5t2ou touil2 to3un 3tow 3toz 4toß to1ßu 2t3p4 tpf4 tpi2n 2t1q 1t2r4 2tr. 5tra.
+3trac tra3cha tra3chl 2t3rad. 5trade tra4dem t3radie 2tradp tra4fah tra4far
+3t4rag 3trahi 6trahl 2trahm 5t4rai 3trak 4t3rake 5t4rakt 5tral tra4leb tral3l
+3t4ran. 4trand 4trang t3rann 5t4rans tra2st 4traub. 4trauc t4raue t4rauf
+2traup 6traß 4t5re. 2trea t3reak 4treb tre2br 4trec t3rech t4reck 5treck.
+tre5cke 2t3red 3tref 4trefe 5treff 4trefl 4trefo 4treg 2t3reh t4rei. 3t4reib
+4treic 4treif 2t3reig 2t3reih t4reik 4t3rein 2t3reis tre7isch. 4treit t3reiz
+4t3rel t4rem t4ren. 5trend 6trendi 5trennu t3rent 2trepe 2t3repo 3trepp t3repr
+t4rer t4res. tre2ta t4rete tret3r tre4tri 2t3rett 3treuh 4t3rev 2t3rez 2t3rh
+3tri t4rib t4rick t4rid2 5trieb trie3fr tri4ena tri2er tri4ers 4trig. 2trige
+5t4rigg tri3gl t4rik tri4ke. tri4kes 5triko t3rind 4tring tri3ni 4t3rinn t4rip
+4tript 4t5riv tri2x trizi1 3tro. tro3b4 4trock. 3troe t4roi tro4kes trol4la
+6trom. tro6mans 4tromb tro4men tro2mi 4tromk 4troml 4troms 4tromt 3tron tro3na
+t4rop 3tropf tro3sm 3trost 2trout 5troy 3trua 3trub 2t3ruc 4truf 4truk trum2
+trums1 t3rumä t3rund 5trunk 5t4rup t3russ 2t3rut tru2th 4truw try1 5träc 2träd
+3träg 5träne 4träng 4träs t1räts 2träuc 4träus 4träuß 4träß 5t4ré 4t3röc 2tröh
+6tröm 3tröp 3trös 4t3röss 3tröt trü1be trü1bu 2t3rüc trücker6 t4rüg 3trümm 2ts
+4ts. ts3ab t3sac t4sachs t2s1a2d ts1ahn ts5alben t2sall ts2ame t4samp t4s1amt
+t2san ts3ane ts3a2r t2s1a2s3 t2sau ts2av t4sch3am t6schart t3sche t4schef
+t3schl tsch4li t4schro t3schü ts2cor t2s1e2b tse2e t2sef ts1eh tse4he. t3seil
+t3seme ts1eng ts2ens t2s1ent t2s1ep t2s1er t6s5essen tse2t ts1eta t2seth
+t2s1eti t2s1e2v t2sex t3sexi t2s1i2d t2si2k ts3iko tsing4 t2sini ts1ir 4tsk
+t3skala ts4kele t4s3ko ts1off t3sol t3som t2s1op tso2r t2s1ori ts3ort. t3s2ouv
+t2spac t2spal ts1par ts4pare ts1pas t2spat ts3pate t3sped t3spei t3s2pek t2sph
+t3s2pi t4s3pic t4spins t2spo t3s2pon t3s2por t2spro ts2pul ts4put t2spä ts5s4
+t1st4 t4stabe t2staf t4stag ts3tak t4stale t4s3tanz t4stas t4stat. t2stea
+t3stein ts4terb t3s4tern t3s4tero t4s5th t3stif t3stim t4stit t4stoch t4stoi
+ts4tol t4stren ts4tric t4strie ts2tu t5stub t4sty t4s3täti ts4tüm t2s1u 5tsubi
+ts3un t4sw tswa2s t3sy t2säh ts1än ts1äus t2sö 4t1t tt1ab tt2ac tt3achs tt1ad
+tt2ag tta6g5ess t4t1ah tta2ke tt2al tta4n t4tana t2tanm tt2ant t4t1ap tt1art
+tt1ebe tt1eif tt1ein t2t1eis t3tel tte4la tte4l3e4b tte4len tte4lin ttel1o
+t2temu tte4na t4tentb t4tentf t4tents t2teo tt4ere tte4rik tte2ro tt2erö
+tt2es1 tte4sa tte2so tte4s3ä2 tt3hi t2t3ho tt2häu t3ti t4tid t4t3igi t4tinf
+t4tins tt2int tt4lef t4torg tto1s t2trou tt3rü tt2sal tt2sen tts1p tt2spe
+tt2spr tt4s3tät tt3s2z tt1u2f tt3z2 tt1äh t3tü 1tu 3tua tu4ale tu1alm tu1alv
+tu3an 2tub2 tuba3b 3tuc tu2chi tu1cho 2tud tudie4n3 3tue tu2ere 2tuf tuf2e
+tu3fen t3u2fer 3tuff tu2gan 4tuh tuh4ler tu1ist tu2kr tul2i 3tum. tum2b5l
+3tume 4t3umf 2t3umg 2t1umh 2t3umk 2tuml 3t2umo 2t3umr 4t3umsat 2t1umsc tum2si
+tum2so tum4s5tr 2t3umt 2t1umw 2t3umz 3tun. 2t1una 2t1und tund2e 3tune tun2en
+2t3unf 3tung. t3unga 3tunge tung4s 2tunif 2tu2nio 2tuniv 2t1unm 3tunn t1u2no
+t3uns 3tuns. 4t3unt 2t1unv 2t1up. tu2r1ag tu2ran turan4l tu2ras tur1c tu2r1e2b
+tu2rei tur3eis tu4rene tu2r1er tu4res tu2re4t tu2r3e2v tur3f4 turg2 tu2rid
+turin1 tur4mun 3turn tu2r3o turo2p tu4ru tu2rä 3tus tu2sa tu4schl tu2se tu2so
+tu3ta 4t1v2 t3vo tvoran4 4t3w t5wa2 twi4e t4wist 1ty1 2t1y2a 3typ ty2pa tys2
+2t1z t2za2 tz1ag tz3ar tz1au tz1ec t2z1e2d tz1ehr t2z1eie t4z1eis tze4n1
+tz2ene tzen5s4t t4z3entg t4zentl t4z3ents tz2ere tzer6gre tz1erw t3zer3z
+t3ze2s3 tze2t tz1eti t2z1i2d tzig4s tz1int t2z3om tz2th tz2tin tzu2gu t2zuni
+tzwan4d3 tz1wi t3zwie tz1wu tz1wä t2z1ä t3zäh 1tà 1tä 4täb tä1c 4täd t2äf 3täg
+4tägy 4tägä 2täh 4täll 2t1ält 4tä2m t1ämt t1ängs 3tänz 4t1äp 2täq tä4reng
+tä2ru 2tärz tä2s t2ät 3tätigk 4tätt 2täug 2täuß 2täx 2tää 1tö 3töch 4töck
+2t1ö2d 2tö2f 4t1ök 2töl. 3tön t1öst 3töt 2tü 4tüb tü3ber. 3tüch tück2s 3tüf
+4tüh 3tüm 3tür. tür1c 3türe 3türg 3tür3s 3türw 4türz 3tütc 3tüte 4tütz 2ua
+u3a2b u1a2c uad4r ua2g u1al. u1a2l1a u1alb u1ald uale2 u3a2leb u3a4lent
+u3aler2 ua4lerg ual3erk u3a2let u1alf u1alg u1alh u3a2lid u1aln ua2lo u1alp
+u1alr u1als u1al5t4 ua2lu u1alw u1alz u1a2l1ä u1am uan2a u1ans uant2 uan3ta
+u3ar. uara2b u1ars uar4t3an ua3sa uasi1 ua2th uat2i uat2o u3au u1ay 2u1b ubb2l
+ube2be u8be8cken. ube2e u2b1ehe u4b3eins ube4n1a uben3o ub2er u4b3erde ubert4
+ub4es ub1eul u3bit ub2l ub3lic ub3lu ub4lut ub3läu u2bob u2bop u2b3oz ub3ric
+u2b3rit ub4rü ub2san ubsau2 ub4sche ub2s1o ub2sp ubst2 ub3t4h 4uc uc1c uch1a
+u1cha. u1che uch1ec u2ched uch1ei ucherin8t u3ches u1chi uch3im uch1in uch3l
+uch3m uch3n uch1op u2ch3r uch4sel uch2so uch2sp uchst2 uch6t5erf uch6t5ert
+ucht3re u1chu uch1w uch1ä uch3ü u1ci uck3elf u2ckem u4ckent uck2er ucker8geb
+u2ck3i uck4sti u1cl 2u1d u3d2a ud2e ude3i4 udein7 udel3se ude2n1 uden3e
+uden3s2 udert4 udes2 udi3en uditi4 ud2ob u2don ud3ra u3dru 2u1e ue2ck u2ed
+ue2en4 u2eg u2eh ue2k u4ela ue2le ueli4 uel4lau ue2mi uen1 u3en. ue4n3a2 u3end
+uene2 ue2ner uen4gag uenge2 uen2gl u3e2ni uenk4 ue2no uen6zene uen2zu uen2zw
+u2ep ue2r3a2 uera4t uerb2 uer6baut uer3d2 uere2 ue2rec uer4ei. ue4rein
+ue4r3emi u3eremp u3e4r3ent ue3r4erb u3ererf ue4rer4g uerer4h uerer4l uerer4m
+ue6rersc uerer6sp ue6rerst uer3esk ue2ret u3erex uer3g2 u3erin4t u3erl. u3ern
+uer4nan uer4ne uern3s4t ue2r3o4 u3errü uer3sc uerst6 uer3t4 u3eruh u3erum
+u3erunf u3erunt u3erwi uer3z2 ue2r1ä uer2ö ue2ta ue4tek ue2ti u2ev ue2x1 uf1ab
+u3fac ufa2ck u3fah uf1ak u3fal ufall4 u3fam ufa2n uf3ane u2f3a2r u3fas ufa2t
+uf1au uf1aß u2f1ei ufel4s3a u2f1em u3fen. u2fent u2ferf u2f1erh u4ferla
+u4ferle u4ferne u2f1et 2uff uf3fe uff4l uf2fro u2f1id u2fim u2f1ins uf3l u2fob
+ufo2r uf1ori uf3r uf2spo uf4stab uf4s3tic uf5sä 2uft ufta2b uft1eb uft3erd
+uft3er4g ufter4l uf4tin uft3s2 u2fum u2f1än u2f1äs u2f1ä2ß 2u1g ug2abe u4gabte
+u2g1a2d u2g1ak u2gani u2g1ans u2ganz u2g1ap ug1ar ug1au ug3d4 u3ge. ug1ei
+u2geig u2gein uge4lob ugen3s2 u2g1erf u2g1erl u2gerr u2gerv u2g1esk ug2et ugg2
+ugge4st ug2gl ug3g4t ug3hu u2g1i2d u2gim ug1in u2gl u6gleitb u6gleitu u4glic
+u4glis ug3liz u4g3lo u4glu u4g1lä u4g3n ugo3 ugo4b ug3oc ug3om u3gon ugo4p
+ug1or u3gos u2greg u4g3reis u2gres ug3rie ug3ro u2grou u2g3rä ug3rüs ug3sei
+ug3span ugs4por ug4spr ug4spu ugs4tan ugs4to ug3s4tr ug3stu ug4stur ug3stä
+ug3s4tü u2gum ug4unge ug2uns ugu3te u2gö u2gü u1h uh2a 2u5he uhe3a2 uhe1s 2uhi
+2uhl uh1la uh2lar uh4l3ent uhl3erb uh2li uh1lä 2uhm uhr1a uhrei4s uh2r3er3
+2uh3ri uh4rin uh2r3o uh2ru uh4rü uhs4 u2hu uh1w 2uhü 2ui ui1ch ui2che ui4cker
+u1ie ui1em u3ig u4ige uil4les u1im u3in. u3isch. u3ischs uis2e uisi4n ui4s5t
+uit3s u1j uk2a u1k2e uke2n1 u1ki 2u1k2l ukle1i uk4n uko2m1 u1kr uk2ta uk2t1el
+uk4tent uk2t1er uk2tin uk4t3o4ri uk4t3r ukts2 uk2tum u1ku uku2s ukä2 uk1äh
+u3käu uk2ö uk2ü u1l ul1am ulan2e ul2ar ula2s ulb4l ul4dan ul2dei ul2dr uld2se
+2ule u2l1el ul1emb ule4n ul1er2h ule2t ul1eta 2ul3f4 ul1id uli2k ul1ins ul3ka
+ul2kn ull1au ul3le ul4lerk ul3l2i ul2lo ull3s2 ulm2e ulni2 ulo2i u2lop u2l1or
+ulp1h ul2pha ul2sa ul4sam ul2s1ec ul2sei ul2ser uls2th ul2sum 4ult2a ult3ar
+ul4tri ult3s ul2vr ulz2w ul1äm u2lü u2mab u2m1ad u2m1a2k um1all um1ang um1anz
+u2m1ap um1a2r u2marc u2marm u2mart u3mat u4matl u4matm u2m1aus u2maut 1umd2
+u3me. u2m1ef u2m1ein umen1e um5engel umer2a u2m1erf um1erg u3merk u2m1erl
+um1erw umes2t 1umf 1umg um1ide um1ind um1inh um1ir 1umk 1uml 2umm um2mei
+u2m3ot ump2fa ump4fin umpf4li um2pho 1umr um4sam um4s3an 1umsat um4s1er um2sim
+um2s1pe um2sum um3t4 u2m3um u2m1u2r 1umz u2m1äh un1 4un. 2una. 1unab un2a3br
+un2ag un2al u3n2am u2n3an u2nap u2narb 2un2a1s4 un3at 2und. un2da unda2b
+und3ak un4dap 1undd 2unde un3de. underer6 und3erf underten8 under8tend und3erz
+und3erö un2dex 1undf 2undg un2dim 1undn undo2b un2dop un2dor 4un2d3r 4unds.
+2undsc und3sp und3st un2d1um 1undv 1undz undü4 u3ne une2b une2d un3eid un3ein
+un3eis un2emi une4n1 unen2t u4nerk u4n3erz. un2es4 unf2 un3fa unft4s un2gam
+un2gat 3ungena unge3r4e 1unget 1ungew ung5h un2glu 1unglü un2go un2gr ung3ri
+ungs3 ung4sa ungs5tr u3nic 3u2nif uni3k4 un2im 1unio un2ir un3iro un3isl
+u3n2it 1u2niv 2unk un2k1a2 un3ker un2k1es un2ket un2kne unko2p un2kro unk3s2
+unk4tit unk2tr unk4tri unlö2 unna2 un2n1ad unn2e2 unne4n u2nob uno4r un2os
+1unr uns2 2uns. unsch5el un3se 1un3si un3sk un3sp unsta4g unste4c uns4t1r
+4unsy 4unsz 1unt un3ta un3te unte4ri 4unti un3tr unt3s 2untu 3unty 2u2nu u3nuc
+unvol2 unvoll3 1unw 3unwe 4unwä u2ny 2unz un3z2a unz2e un2är 2uo u1o2b u3of
+u3or. u1or3c uore4 u3o2ret u3ors u1ort u1orw uos2 u1os. uote2 u1o2x u1pa 3upd
+u1pe2 uper1 upe4re uperer4 up2fa u2pfe u2pf1i up2fu 3upg u3p4i up4lu up2pl
+u1pr upra3 u2p3ras up4t3a2 upten1 up4tene upt3erf upt3erg upt3erk upt3ers
+up4tid up4tim up4t1o up4tr u1q 4ur. u1ra u2rab u3raba ura2be u2r1akt u2ral4t
+u2r1a2m ura4na uran3a4t u3rand ur1ang uran4ge ur2anh uran5s ur1anz ur3ap
+u2r3ar ura4ri u3rasc ur3asp ura4str ur4ate ura3to u2r3att u2r1au ur3b2a 2urc
+urch1 urcht3e urd2 ur3da ur3di ur1eff ur1eig u2rele ure2n ure4na uren6gag
+u4rense u4rentn u2r1ep ur1er3h urer3k ur2ert u2rerw ur1eta ur2eth ure3u 2urf
+ur2f3l ur2fro urf4spr urf3t ur6gense urg3inn urg1l ur2gla ur2gri urgros4
+urg3s4 uri2c ur1ide uri3en u2rind urin8stin ur3ku ur3l ur4mant ur4matt ur2mau
+urm2ei ur4mern urmet1 ur2mum ur2mun ur3n2e 4u1ro ur1off uro1s4 urost2 ur3p4
+2urr ur3re 3ur3sac ur2san ursau4 ur2s1er ur4s1of ur2spa urst4r ur3sze urt2
+2urta ur2tai urt3ein ur3ti ur2tro urt3sc u3ru uruf4 ur2z1a2 ur2z1ec ur2zep
+ur2zi ur2z1op urzt4 ur2z1w ur2zä 2u1rä ur1äl ur1ä2m ur1än 2u1rö urü2 2us
+us3a2b u4s3af usa2gi u4s1amb u4samt u2sang us2ann us3ark u2s1a2s3 u4schab
+u4schak u3sche. u4schef usch5eic u4sch3eu u3schi usch3mü u3schu usch5wer u3se.
+u3s2e3b u2s1ec u2s1ei u3seid u4sense u4sentl u3sep use3ran use4rec u2s1erl
+u2serp us1erw u2s1ese u2sex u3si. u2sid usi3er. usi5ers. us1inn us1is. us3kl
+us3oc us1oh u3sol u2sop us1ou u2spac us3part u2s1pas us1pe u3s2pec u3s2pek
+u2sph us1pic u3spit u3s4piz u2spo us2por u2spu usrich7 us2s1eb usse4g uss2el
+usse4n us2sep us5ser. uss3erf usser4z us4sesp us2sez us2sof us2sum u1stal
+us3tau us4tein u1stel ust3erl us2th us3ther us3t2in us3tr u3s4tras us6tris
+u1stu u2stun u2stur u2sumd u2sumg u2sumz 3usus u2säh u2sü 2u1t u3taf u2t1alt
+u4t1a2m ut2ans u2t1ap u2t1ar uta2s u2taut ut3c ut1e2d u3teh ut1ei. ut1eie
+ut1ein u3tek ut1ela u3tem ute2n1 uten2a u2tent u4tentf uter3a ute4ral ute5r4er
+ute6ring ute4ros ut2es u3t2et u2t2ev u2t1ex utfi2 ut3hel u2t3hi u2t3ho u2thu
+u2thy u2tid uti2vi utli4n uto3 uto4ber u3tom u2tops utor2a u4tord utos4 4utr
+ut3rea u2trou ut3rü 4uts ut3sau2 ut4schl ut4schm ut4scho ut4schö ut3ser ut3s2k
+ut2säu ut3te ut5t2l utt4le utts2 utu2b u2tum utu4n u4t1une utu4re utu3ro
+utu5ru u4tz ut2zeh utz3eng utz2er ut2zet ut2z1in ut2z1w ut1äh u2tär u2töl u3tü
+2u3u4 uufe2 uum1 uuma4 2u1v4 u2ve. uve3rä u1w 2u1x ux2e ux2o ux3t2 u1ya 2u1z
+u2z1ec uz2er uzo2f uz3ot uz1we uz3z2 2u1ß u2ß1u u1äm uä2s u1äu uö2d u1ök u1ü2
+3va. 2v1ab vab4r va1c va1f4 vag2a va2la 2valu 2vanb 2vang 2varb v1arm va1s2
+2v1ass v4at va2t1a2 va2tei va4t3eng va4tess va2t3h va4tid vatik2 va4tim
+va4t1in vati8ons. va4tord va4t3r vat3s4 va2t1u 2v1au 2v1b 2v1c 2v1d2 1ve2
+ve3an ve3ar veau1s ve3b4 ve3d ve3fa ve3g ve3h2 2veig v2eil 2vein veit2 veits3
+ve3la 2velan ve4l1au v1ele ve3lei ve3li ve3lo ve3ma ve3me 2vemu ve3nal ve4nas
+ven2c ve3ne ve3ni ve4nin ven6t3ag ve3nö ve3of ver1 ver3a ve3rad 2veral ve3rand
+ver4ane vera4s ver6bart ver3b2l ver3d2 vere2 ve4rek verf4 ver3fa ver3g4 ve3ri
+ve4rin ver3k vern2 ver4sep vert4 ver5te ver3u4 ves1 ve3sa 2ve3s2c 2ve3s2e
+ves3ti ve3t vete1 vete3r ve3v ve3w ve3x 2veü 2v1f4 2v1g 2v1h vi2ad vi3ar
+vi4a3t vi2c vi3de vid3s2t vie2h1a vi2el viela2 viele2 vi2er vie4rec vie2w1
+vig2 2vii v2il vi2l1a vi4l1e2h vi2lei vi4lers vi2l3in vi2lä 2v1i2m vima2 vi4na
+2v1in3d ving5 2v1int vi3sa vise4 vi3s2i vi3s2o vi2sp vis2u viv2 vi3z vize1
+vi2ä 2v1k 2v1l2 v3le3 v2lie 2v1m vm2e 2v1n2 1vo 2v1ob vo2be vob4l vo3ga
+voge2l1 vo2gu vol2a vol2l1a vollen6 vol6lend vol6lert vol2li 2v1op vo2r1 vor3a
+voran8schl vore2 vor3g vo3ri vo4rie vo5rig vorm2 vormen4 vor3o vort4 vot2a
+voy1 2v1p vr2 v1ra v2ree 3v2ri v1ro 2vs vs2c vs2e vs2p v1sta v1steu v3s2z 2v1t
+vue3 vu2enu vu2et 2vumf 2vumg 2vumk 2v1v 2v1w 2v1z vö2c 2v1ü w2a 1waa wab2bl
+wa3che wach8stub wach4t4r 1wack waffe2 waffel3 1wag wa5ge 3wage4n wa2g3n wa3go
+1wah wahl5ent wah4ler wah2l1i 1wal wala3c wa2lar 2walb wal2d3a wal4din wa2les
+wa3li wal2m1 wals2 wal2t1a wal6tere wal6terl wal4to wal4tur 3walz wa3na
+wan2d1a2 wandels6 wan2dr w3anf 2wang wan3g2e wang4s 1wann wan6z5en6d wan4zer
+wa2p 1war2e ware1i wa3ren 1warn wart4e war2th 1was wa3sa was2c wa4scha wa3sche
+wa4sch3l wa4schw wa3sh was3s wass4e2 wa3su 2w1b2 wbu2 2w1c 2w1d we2a we2b1a
+webe1i we2b3l we2bo we2b3r webs2c we3cke. we5cken. we3ckes we2e2 weed3 we2fl
+1weg we2g1a we4g1ei weg5ersc we4g3l we4gn we2g1o2 we2g3r weg3s wegs2a wegs4t
+1weh weh4r3er wei2bl weib4r wei3dr 2weie weifel6d wei2gr weigs4 wei3k4 3weil
+wei3nel weins3a weinsau6 wei3sc weis6sel weis6spi wei2t3r wei5ze wel5le4
+wel6schl wel6schr wel2t1 wel4t3a2 welte2 wel6t5en6d wel4th welt3i wel4to
+wel4t3r wen3a2 wendes4 wen2gl we3n2i wen2ka wen4kla wen4k3ri we2r3a2 wer5be
+werbe3i wer2bl werb2s 1werbu werd2 werde3i 5werdens 1werdu werer2 wer2fl 2werg
+wer6gels wer2g3o wer2gr werin2 we4r3io 1werk. wer2k1a 1werke wer2ki wer2k3l
+wer2kn wer2ko wer4kre wer2ku wer2s wer2t1a wer3t3ei wer6teig werter6k
+wer6t5erm wer2th wer4t1o2 wer4tre wer4t3ri wer4tum wer2tä we2rö 1wes2e we2s1p
+we4st wes4t1a weste2 west3ei wes6ten6d wes4tex wes4ti wes4t1o4 west3r wes2tu
+1wet 2wets wett3s 2w3ey 2w1g whi4 w3ho w2i wicht4s wi1cka 1wid wi2e 2wieb
+1wied wie3l wie3n2e 1wild wim2ma wim4m3u win2a win4d3e4c win4dei win6d5erz
+1win2d5r 2wing win2g3r win2kl win8n7er8sc win2no win3s wint2 1wi4r wire3
+wisch3l wi5s2e wi2sp 1wiss wiss4z wi3th 1witz. 1witzl wiz2 2w1k 2w1l 2w1m 2wn
+wns2a wn3sh 1wo1c wo2cha woch2e4 1woh woh4lei 1wolf wolf2s3 wol2la wol4ler
+wor3a wor3d wo2r3i worn2 wort1a wor4tel wor6terh wor4t3r wort3s2 wo4r3u wor3ü
+wot2 2w1p w2r w3ro 4w1s ws2e w3s2h w3s2k ws2t 2w1t wti2 1wuc wuch4sc wuch4st
+w1u2f wul2 wul3se wund4e wung3r wungs4 wun2s wunsch5l 4wur. wur2fa wur2f1o
+wur2fr wur2s 1wurst wus2 wus3te 1wu4t1 2w1w 2w1z w2ä 1wäh 1wäl 2wäng 1wäs
+wäs2c wäss4e 2w3äu 1wöc wöl2fo wört4h 1wüh wül2 wün3 1würf 1würst x1a 1xa.
+2xa2b 1x2ad 1xae xa1fl 1x2a3g2 2xal xal2l xa2m xand4 x2an3t2 x2anz 1x2as 2x1b4
+2xc x1ce x1ch x1cl 4x1d xda4 1xe 2x1e4g 2xek xe2l x1ele x1em 3x2em. x2ems x2en
+xen3s2 x2er. x2ere 2xerl xers2 2x1eu 2x1ex 4x1f 2x1g 2x1h xi1c xich2 2xid
+xi2dan xide2 xi2dei xi2d1em x1i2do xi4ds 3x2ie xie3l xi3g xi2ler xi2lo xi2l1u
+xim2 xin3s2 x2is1 xi2sa xis2c xi2se xi2so2 xi2sp xis3s2 xis3t xis4tä xi2su
+x1i4tu xive4 2x1j 2x1k2 xkal2 4x2l2 x3le x3lä 2x1m 2x1n 2xod 2x3oe4 x1or 4x1p
+xpor6ter xpor4t3r x1q 2x1r 4x3s2 4x1t xt1a x3tan xt2ant x3tas xtblo4 x2t1e2d
+xt1ein x2t1el x4tent x2t1er2f x2t1ev xtfi2 x2t3h x2tid xti2la x2til2l xt1o2
+x4tor xtra3b4 x2t3ran x2trau xt3rec xt3s2 x2t1um x2t1un x2t1ä x3tät 1xu xu1a
+2x1u2n xu2s3 xuss4 2xv 2x1w 2xy 3xy. 3xys 2x1z 2x1ö2 2yab 1ya2c y2ach y2ag
+ya1h y1al. y1a2m y2ana yan2g y1ank y2a3ra ya2s3 yat2 ya3z y1b y1c y2chi y3chis
+ych3n y1d4 y3dr ydri4 ydrid1 y1e y2ec ye2d y2ef y2el yen4n y2ere yer2n1 y2es
+yes2p y3est ye2th y1f2 y1g ygi2 ygie5 yg2l y1h yhr2 y3i4 y1j y1k2 yke3n yk4l
+yk3s2 y1l yl1a2c y2l1a2m yla2n yl3ane y3lant yl4ante yl4anti y4lantr y3lat
+ylau2 yl3c yle2 y2le. yl1em y2l1es y2l1et yli4n yloi4 yloid1 yloni1 yl1ora
+yl3s2 ym4a ym4e ymp4 ym2pha ympi1 yn2eu yn3k2 y2n1o yno4d yno4t yob2 yoga3
+yom4 yon2a yon4i y1ont y1o1s2 y2ost y1ou 2y1p ypa2 yp1ab3 yp1an yp2e2 y2pf
+y2p1i2d y2p1in y2p3l ypo3 y4p3s yp3t ypu2 y2p1um y1q y1r yra3k y3r2e y3ri
+yri2a yri1e yri3en y3ro yros3t yrr2 ys2an ys2c ys2e1 ysein2 y3s2h y4s3l ysme3
+ys4po ys1pr yst2e yst4h ys2the ys3to ys3tr ys4tra y4stro y3s2ty ysu2 y2s1ur
+y3s2z y1t2 y2te. y2tes yt4h ythe1 y3to1 ytos2 y4t3r yt3t y1u2r y1v y1w y1y
+y1z2 yze3r2 y1ät 2z1a2b zab3l za1c 2z1a2d 2z1af za3gr 3z2ah zah3le zah4ner4
+2z3ak 4zakk 2z1al 3zali 2z1a2m z1a2n z2an. 4za4na 2zanb za3ne 2zanf 2zangs
+3z2ank zan2ka 2zanr zans4 zanti1 za4pf z1aq z1ar 3zar. 2zarb 2zarm 3z2aro
+zar2tr 2z1as za2sc zast4 z3at zat2e za2to 3zaub z1au2f 2z3aug 3zaun z3aur
+2z1aut 4z3b4 zbe3r2e zbü1b zbübe3 2z3c 2z3d2 zdan2 zdi1st zdä1 3ze. 2z1e2ben
+ze1c 2z1e2cho ze1e2 zeeu3 2z1eff z1e2ga zehe4 zehen1 zeh2l ze3ho z2ei1f4 zeil2
+zei3la zeile4 2z1ein ze3in. z2e1ind zei4ne z2eino ze3inse ze2i1s4 zei3sk
+zeist4 3zeit zei2t1a zei4t3er zei4to zei2tr zei4t3ri ze2l1a zela2d zel3d
+2ze2lek 2zelem ze2len ze2l1er ze2l1in 2z1e2lit zel3la zel4l3ac zel6lein
+zel6ler6t zell3s2 zelm4 ze2l1o zels2 zel3sa zel3sz zelu2 ze2l1ä zembe2 2z1emp
+5zen. ze4n1ac ze4nas zen3au ze3n2em zenen1 4zenge. z4engl 2zengp zen3n ze2n3o
+ze4not 4zensem zens2p zen4tha z2entn zent3s 2zentw 2zentz zen4z3er zen2zw
+zeo4r 3z2er. ze2rad ze1ral ze2re2b z2erfe 4z3ergeb z4erges z4ergl zer4gon
+2z1ergu 2z1ergä z2erhe 2z3erhö zerin6te z2erko 3zerl. zer4lau zer4le. 4zerleb
+zer4len 2zerlö 3z2ern zer4nan zer4n3e4b zer4nei 2z1erq 4z3erreg zers2 z2ers.
+2z1er4sa zerta2 zer4t3ag zert4an zer6teng zer6tere zer6terl zer4tin zer4to
+6z5ertrag zer6trau z1erwe 2z1erz zer2ze 4z3erzi 2z1erö zer2öf ze3sch zes1e
+ze2sp ze4spo ze4spr zes2sa zes4seb zes4sei zessen4 zes6s5end zes6sent zes4ser4
+zes2sp zes2st ze1sta ze3stau zes5tr zes6tra ze2sä 2zeta 2z1e2th ze2tr 2zetts
+zeu2g3r 2z1eul ze1ur 2z1e2x1 4z3f4 zfeue2 2z3g4 zger2a zger4s3 2z1h2 z2hen
+zhir3 zi3ar zich2o zi2dei zie4ler zie2l1i zien3s zi1erh zi1es zi3ess z2il
+zil2e zill2 2zimp zim4t3 2z1ind zin2e zin3ei 2z1inf z1inh zi4n3in zin1it
+2z1inj zin2na zin4o zin2sa zin4ser 4zinsuf 2zint 2z1inv zi2o3 zirk2 zirk6s
+2z1i2so zisse4 zi3s2z zi2tan zite4 zi1th zithe2 zi4t1o4 ziv2 2z1j 4z1k4 2z1l2
+zlei3ti zle1s z3ly 2z1m2 zme2e 2z3n2 z3oas 2z1ob z1of zo2gl zog4s3 2z1oh
+zol2la zoller4 zol6lert zon3s4 zon4t3er zo2o 2zope 2z1o2r zo3re 3z2orn zor4ne
+2z1osz 2z1ou 4z3p4 2z1q 4z3r2 4z1s4 z3sa zsau2 z3sh z3sk zspor2 z3sz 2z1t
+zta2n zt3ane z2t1au z4tehe ztein1 zt3eins zt2el z4t1ent z4t1erz zte3str zt3he
+z3t4hem z3t4her zt3hi zt3ho z3thr z3thy z3tic zt3rec zt3s2 z3tü zu1 zu3a zub4
+3zuc zuch2e zud4 zudi4 zu2el zu3e2r1 zu3f4 zu2gar zu4gent zu3g1l zu4gla zu4glö
+zu2go zug1un 2z1uhr zu3hu zui2 zu3k zul2 2z1um. zum2a 2z1umb zumen2 2zumf
+2zumg zum2i 2zuml 2zumr 2z1ums zum2u 2zunab zun2e 2z1unem zunf4 zung4 4zunget
+2z1ungl z1uni 2zu2nio 2zuniv 2zunr 2z1uns 2zunt zuo2 zup2fi zu3pl zu3r4a
+2z1urk 2z1url 2z1urn 2z1urs 2z1urt zu3s4 zusch4 zu3t2 zut4r zut4u zut3z zuz2
+2z1v zw2 z1wac 2zwag 2zwah 2zwal 2zwap z1war 2zwa2s 2z1wed 2zweg 2zweh z2weig
+2zweil zweiter6 2z1wel 2z1wen 2z1wer 2z1wes z2wic zwi4e 3zwing 2zwirt z2wisc
+2zwiss z2wit 2z1wo z1wur 2zwäs z1wör 2z1wü zy1an. zy2le 4z1z z3z2a zza3b4
+z4z3al zz4at z2z1id zzin1 zz1ini zzi1s4 zzug4s zz2ö zä2 2z1äc z2äh zä3hi 2z1äm
+z1än z1äp z1är 2z1äus 2zäuß 2zö2f 2z1ök z1öl 3z2öll 2zöls 2zön 2zü4b 3züc
+zür1c 2ß3a4 2ß1b4 2ß1c 2ß1d2 1ße 2ß1e2b 2ß1ec 2ß1ef 2ß1e2g 2ß1ei ße2la ße2le
+2ßelek 2ß1emp ße4n3a2 4ßenerg ße2ni ße2no ßens4t 2ß1entl 2ßentz 2ß1e2p ßer3b
+ßer2ei ß2ers. 2ßer4se ßer3t ß1erw 2ß1es2s 2ß1est3r 2ß1ex 2ß1f4 2ß3g2 ßge2bl
+2ß1h 1ßi ßi2g1a2 ßig4s 2ß3i2k 2ß1il 2ß1im 2ß1in 2ß1j 2ß3k4 2ß1l ßler3 2ß1m
+2ß1n2 2ß3o2 ßos2 2ß1p2 ß1q 2ß3r2 ßrö2 2ß3s4 ßsau4 ßsch2 2ß1t ßt1in ß3tü 2ß1um
+ß1unf 2ßunt 2ß1v 2ß1w 2ß1z2 2ß1ä 2ß1ö2 2ß1ü4 á1n â1t ä1a ä1b ä2b3l äb2s ä1ce
+ä1che äche1e äche4n ä1chi äch3l ä2chr äch4s3a äch2s1o äch2sp äch2st ächt4e
+ä1chu ä1ck ä1d ä2da äde1s2 ä2d1ia ä2dr äd2s 2ä1e äe2x äfe4n äf2fl äfig3 äfigs4
+äf3l äf3r äf4ro äf2s äf3t2e äft4s3 ä1g ä2g1a ägd2 äge1i äge2r3a äge3s ä2g3l
+äg2n ä2g3r äg4ra äg3s4ta äg3s4tr 1ä2gy 1ä2gä äh1a 2ä3he ä4h1ei äher8gebn
+äher5t ä1hi äh1in ähl1a äh3l2e äh4l3e4be äh5ler 2ähm äh3na äh3ne 1ähnl 2ähr
+äh2rel äh3ri 2ähs 2äht ä1hu äh1w 2äi ä1im ä1is. ä3isch. ä1isk ä1j ä1k äka2la
+äk3l ä2kle äk4li ä2k3r ä1la älbe2 äl2bl älk3 älks4 äl2l1a äl2p3 äl4schl äl2st
+äl3te ä1lu 2äma ä3me ämer2s ämi3en 2äml ämoni3e 2ämp ämp7f4e äm2s ämt2e 2än.
+änd2e än2dr 2än2e äne2n1 äne1s 2än2f5 änft2 2än3g2e änge4ra 2än2g3l än2gr
+äng3se 2ä3n2i än3k2e än2k3l än2kr änk2s än3n4e4 2äns än4s1a än2s1c äns2e
+änte3le 2änz ä1on äo3s2 ä1pa 1äpfel äp2pl äp2pr äp2s1c äp4st 1äq ä2r3a4 är4af
+är2b3le är1c 2ärd ärde4s 2äre ä2r1ei ä2r1e2l är2em äre2n ä2rene är2er är2es
+ärf2s är3ge ä2rind är1int är3ke ärk2s ärm3arm ärme1e ärm3ent ärm2s är1ob är1of
+äro2p är3re ärse2 är2seb är4seh ärs1er är2si är3spu är2st är3str 2ärt ärt4e
+är2th ärt2s3 1ärz är3ze är2zu är2zw är1ä ä1rö ä2rü 2ä3s2e äse3g äse1i4 äse5ref
+äser4ei äse4ren äser2i äse3t ä3s2kr ä2s1p 2äs2s1c äss2e äss5erkr äss5ersa
+äss3erw äs2sp äs2s3t ä4s3t äst2e 1ästh äs4tr ä3su ä2t3a2 2ä3te äte3a äte1e
+äte1i äte3l2 äte2n äteo2 ät1ob ä2t3r ät2s3a ät4schl ät4schr ät2s1i2 äts3l
+äts1or ät2s1p ät2s3t ät2sä ät2tei ätte4n ät4tr ätze3l ät2zw 2äub äu2b3l äu2br
+äu1c äu3d äude3 äuder2 äu3el 2ä2uf 1äug äug3l 2äul 2äum äu2ma äum3p äumpf4
+äum4s5 2ä2un äun2e äu3nu 2äu3r2 äure1 2ä3us. 2äusc äu4schi äu4schm äu3s2e
+äuse1i ä3usg ä3usk ä3usn äu2sp äus2s1c äut2e äu2tr 1äuß ä1v 1äx ä1z ä1ß äß1erk
+äß1ers 1ää è1c è1m è1n è1r é1b é1c é1g égi2 é1h é1l élu2 é1o é1p é1r é1s é1t2
+é1u2 é1v é1z2 ê1p ê4t 1ën í1l ño1 órd2 ö1b öbe4l3i öb2l ö2b3le ö2b3r öb2s3 ö1c
+öch1l ö2chr öch2s öch4ste öchst5ei öchst3r öchs4tu ö3cke ö1d ödel3l öde1r ödi3
+ödien3 ödin3 öd2st 1ödu ö1e 1öf öf2fl öf3l ö1g öge3le ögen2s1 ö2g3l ö2g3r ö1he
+öhe4n1 öhl2e4 öhre4 öh3ri ö1hu ö3ig. ö3isch. ö1ke 1ö2ko3 ök3r ök2s ö2l 3öl.
+öl1a2 öl1ei öl1em öl4en öl2f1ei ölf2er öl1in ölk4e öl2k3l ölks4 öll1a öl3le
+3ölm öl2nar ölo2 öls2 öl3sa öl3sz öl3tu 1ölu ölz2w ö1m öm2s ön2e ö3ni önizi1
+önn2e ön2s ön3sc ön3sp öo1 öo2ta öoti1 2öp ö1pe öpf3l öp4s3t ör3a2 ör2b3l ör1c
+ör2dr ör3dra ö2r1ec ö2r1ei ö2r1e2l ö2r1em öre2n1 ö2r1ene ö2rent ö3r2erb
+ö2r1er2e örer2f ö2rer2g ö2rer2l ör2err ör2erw ö3r2erz ör1ess ör2f3l ör2gl
+ö2rim ör2kl örn2e örner4v ör1o2 örpe2 örs2e ör3sk ört2e öru4 ö2r1une ö2sa 2ösc
+ö2sch3a ösche2 ö4sch3ei öscher3 ö6sch5erf ö6sch5eri ö2schi ö2sch1l ö2sch3m
+ö2schn ö2schw ös1ei ö2sein öse3str ö3set 2ösl ö2sp ös2s1c ös2st ö2st öst1a2
+ös3te ös2th ös3tr ö3su ö1t ö4t3a öte4n1 ö2t3r öts2 öt2sc öt2tr ö1v2 ö1w ö1z
+öze3 özes4 ö1ß ößen3 öß2ti 1üb 2übc 2übd üb4e2 übe3c übe3le übe4na übe3ne
+über3 ü4bet üb3l üb3r üb2s3t üb1ä 2üc ü1che üch3l üch2s1c ücht4e ü3cke4n
+ück1er ück3eri ücker6ke ü4ckers ü2ckin ü4d3a4 üde2c üde2l ü3den. üden2g
+ü3d2ens üd3o4 üd3r üd3s2 üd3t4 üdu2 üe2 üeb3 ü1ei ü2f1a ü2f1ei ü2fent üfer2
+ü2f1erg üf2fl ü2f3i üf3l ü2fo üf3ter ü2fum ü2f1ä ü1g üg2e üge2l1a2 üge4lec
+üge6lei6s üge2lo üge2lä ügen3s ü2g3l ü2gn üg3s2 üg4s3t üh3a2 ü1he ü2h1ei
+ü2h1eng ü2h1ent üh1er ü2herf ü2her2k ü2her2z ü2hex üh1i4 ühla2 ühl2er ühl4sta
+ühl4sti üh1lä üh3mo üh3ne ühn2s üh1o2 üh3r2e ühr3ei. ühre2n1 ühren3s4 üh1ro
+ühr3ta üh1s ühs2p üh3t2 üht4r ü1hu üh1w ü1k2 ül1a ül2c ü3l2e ü4l3ef üle2ra
+ül2l1a2 ül2l1ei üll2er ül2lid ül2lo ülls2 ül2lö ü1lu ü2lö ü2ma ü2ment üme2ra
+ü2m1id ü2m1in ü2m1u 2ün ü4n3a2 ün2da ün2dr ünd3s ü2n1erd ünf1 ünf3li ün2g3l
+ün2s ün3sc ün3se ün3sp ünster3 ün3str ün2za ünzu2 ün2zun ün2zw ü1pe üpf3l ü1pi
+üp2pl ür1a ü2r1ei ü2r1e2l ür2fl ür2fr ür4g3en4g ürge4ra ürk2e ü1r2o3 ürom2
+üror2 ürr2 ür2s ür3sc ür3se ür3si ür3sp ürte2l3 ürt4h ürz2a ür2z1in ür2z1w
+ür2zö üs2a ü2schl üs2e üse1e2 üse3l2 üse4n üse1r4 üse1s üse3t üs2s3a üs2s1c
+üss2e üs4s1o üs2st üst3a2 üste2n 2üt ü2t1al üte3m üte4n üten3s ütent4 üten3z2
+üte2ra üte2r1e üterich6 üter3n ü2t3h ü2t3r üt2s1 ütte4n üt2tr üt3z2e üt2zw ü1v
+ü1z 2ü1ß
+}
+`
@@ -0,0 +1,297 @@
+package main
+
+// hyphenRU содержит шаблоны переноса для русского языка из проекта hyph-utf8
+// (hyph-ru). Авторы шаблонов и условия их распространения указаны в
+// исходном файле: https://github.com/hyphenation/tex-hyphen.
+const hyphenRU = `\patterns{
+.ави2 .ади2 .ад1р .аи2 .ак1в .ак1р .аль5 .ас1п .ау2 .аш1х .аэ2 .бе2з1а2
+.без1на .бе2з3о2 .без1р .бе2з1у2 .бе2с1т .би2б1л .бу1г .взъ2 .во1в2 .воз1на
+.во2п1л .во3п2ло .во2ск .во2с3тор .вс6п .въ2 .вып2ле .выс2п .гос1к .дво2е
+.де2зи .ди2а .ди2сто .до1см .за3в2ра .за3п2н .зас2 .зау2 .заш2 .звуко3 .зо2о3
+.иг1л .иг1р .ие2 .из1н .изо2бл .из1р .ии2 .ио2 .ис1ти .ис1то .ис5тр .иу2 .ию2
+.кон2трн .ле1м .ль2 .ме2ж3 .ме3ж4ам .ме3ж4ах .ме3ж4е .ме6жи2о .мо2г1л .на5в6
+.на2и .на1ч2н .на1ш2ко .неа2 .небе2з1о2 .не1в .не1з2 .не5л .нем2но .не3о2тр
+.не1х .ни1с2к .нос5к .оа2 .обе2з1о2 .обе2с1т .об1ла .об1ле .об5лив .об5лит
+.об1ло .об1лу .обо1ль .об3о2ст .об1ре .об1ру .ог5н .оз2 .ос2пар .ос1пин .от3в
+.ото1м2 .от1р .от1хл .по1в2 .под1во .по2дыг .по2дым .по3дыми .по2дын .по2дыс1
+.по2дыт .по2дыщ .по1ж2 .по2ст1ин .пре2ж1д .пре1л .при1г2н .при3к2н .при1м2н
+.прис2к .прос2 .про3сл .про1сну .ра2зо .ра2с1та .ра2с1те .ра2с1тек .ра2с1теч
+.ра2с1ти .реги6о .ро2х1 .сек1с2т .сеп5т .соп1л .тек1с .топ1л .тран2с1 .трех1
+.ть2 .уг1ле .уг1ло .уд2л .уд2р .уе2 .ук2 .ур6в .ую2 .фи2зо .хим1ч .хла2 .ча2е
+.чер2ст1 .че2ст1в .четырех1 .эо2 .эя2 .юа2 .яи2 а1а аа2п1 а1ба а1бе а1би а1бо
+а1бр а1бу а1бх а1бы а1бье а1бьи а1бью а1бья а1бя а1ва а5ве а5ви а2в1ля а1во
+а2вот а2в1п а2в1ра а1ву а1вы а1вье а1вьи а1вью а1вья а1вэ а1вю а1вя а1га
+ага5с6 аг1ва аг1д а1ге а1ги а1гл а1го а3гу а1да ад1а2ген а5дви а1двор а1де
+а1ди ади2о 1адм а1до а1дра ад5рез ад1руга а1ду а1дцат а1ды а2дын адь2 а1дьи
+а1дью а1дю а1дя а1е ае2ди а1жа а1же а1жж а1жи а1жм а1жо а1жу а1жье а1жьи а1жью
+а1жья а1за аз1ве аз1ви аз1во а1зе а1зи а1зо аз1р а2зри а5з6у а1зы а1зье а1зью
+а1зья а1зю а1зя а1и а3и2г1р аи6з5 айм2а а1ка а1ке а1ки ак1н а1ко акоп5л ак1с
+а1ку а1кы а1ла а1ле а5ли а1ло а1лу а1лы аль1д а1лье а1льи а1лья а1лю а1ля
+2а1ма а1ме а1ми ам1но а1мо а1му ам1ч а1мы а1мье а1мьи а1мью а1мья а1мя а1на
+ана2с3н а1не а1ни а6нинс ан2кро а1но а2н1об ан1р ан2скр ан2сп анс1у ан2сур
+ан2сц а1ну а2н5уз а1ны а1нье а1ньи а1нью а1нья а1ню а1ня 2а3о ао6к ао2ст а1п
+1апп ап1рел а2п1с а2п1т а1р6а а1ре а1ри а1ро ар2т1ор арт2р а1ру а1ры а1рье
+а1рьи а1рью а1рья а1рю а1ря а1са а1се а1си ас1к а2скоп ас5лет ас5лям ас5лях
+ас5ми а1со ас3по ас1пу асс2ме асс6п а1ста аста2п1 аст1ву а1сте а1сти а2с1тир
+а5стры а1сту а1сты а1стье а1стью а1стья а1стю а1стя а1су ас1х ас1ч а1с2ше а1сы
+а1сье а1сьи а1сью а1сья а1сю а1та 1атак ат5ви а1те а1ти ат1л а1то а6томн а1тр
+а1ту атх1л а1ты а1тье а1тьи а1тью а1тья а1тю а1тя а1у а2уле а2ум а2ун а2ус
+аут1р ау2чи а2уэ ауэ1р а1фа а1фе а1фи а1фо аф1ри а1фу а1фья а1фя а1ха а1хе
+а1хи а1хо а1ху а1ца а1це а1ци а1цо а1цу а1ча а1че а1чи а1ч2не ач1т а1чу а1чье
+а1чьи а1чью а1чья а1ша а1ше а1ши аш1лив а2ш1лы а1шо аш1та а1шу а1шье а1шьи
+а1шью а1шья а1щ а1э1 а2эр а1ю а1я ая2з бас1м 6б1б 1бв б1ва б1во б1вя 6б1г 2б1д
+1бе бег1л бег1н бе2д1р без5в без1д2 бе2з1у4с бе2зы безы2з1в бе2с1к бес3п бесс2
+бе2ста бес1х бес1ч б1ж б1з6 5би2о био5с бис2к1в би5стр 2б5к 1бл 6бл. б1лав
+1б2лаго 2блас б1лег 2б1лен бле2с1к б5лиза бл1исп б1лож б1лом 2бль 2б1ля 6б1м
+2б1н бо1д6р бо2ес бо1жж бо1з боз2л бо3м2ле бо2мч бо1рв бо1с бо2сс 5бот 5боц
+2бр. бра6сл б1раст 1бри б1рыв 2брь 6б1с2 бст6 2б1т 1бу буг1л б1ф б1х 2б1ц б1ч
+2б1ш 6б1щ 6бь. 1бю 1бя 1ваг ва1д ва2дл ва2дн 1вак ван5с6 вах1 5вая в1б в6би
+в1в в1г в1д вдо1с 1вег ве2д1р вез1до 1велл 1вер. верт1ля ве2с1к ве2ст1в вет3в2
+вет4в3л 1вз2 вз6р взыс5 ви2ам виа1с ви5аф ви2б1р ви2зн ви5ол 1вих 1вич в1к
+вк1н в6кус 1вл 2в1лаб в1лаг 2в1лен 2в1ли 3в2лия 2вль 2в1лю 2в1ляе 2в1лял
+2в1ляю в1ма 2в1ми в1мо в1н 6вн. 1в2нук 1в2нуч в2нуш во1дво во2ж3ж воз1в
+во3з2дан вои2с 1вок во1п вос1к во2с1пе во2с3ток во2с3точ во2стр 1воя 1вп впо6л
+1вр2 2вр. вра2ж5д в5рас 6в5рац 2в1ре. 2в1ро вро5т 2в1ры. в1с2 в6сег 6вск 1в2сп
+1в2сх 1в2сю в1т 2в1терп вто3к2 5вуа ву5г 1вуд ву1з ву1ст в1ф в1х в6ход в2хож
+в1ц в1ч в2чер вче6т5 в1ш 1в2шив в1щ 1вы вы5п выпу2к1 вы1ск вы1сп вы1тв вы1х
+вы1ш 6вь. 1вю 1га га1ст га2у 2г1б г1г ге6об ге2од ге2оп ге2ос ге2оц г1з ги2б1л
+ги2д1р ги1с 2г1к гко1в 6гл. 6г5лай г1ляе г1лят г1ляю 2г1м 6гн. г2нив г2ном
+го1з го2зл го1п 1гор го2с1а го2сб гос3с 2г1п 1гр 6гр. г2раб 6грек грив1к
+гро2м1ч 2гроп 2г1с гс2шиб 2г5т г1ч 2г1ш да4о 6д1б д1ва д1ве д1вид 1движ 1двиз
+5двину д1вис д1вод 1д2ворь д2воя дву2х1 дву1ш д1г2 д1д дд2в 5деб де2ес де2з1а2
+де2з1о2 де2о дес2к де1ст де1х 1дж 6дж. 2джс 6дз. д1за д1зв 1дзе. д5зем д5зи
+д1зо ди2ад ди2ам ди2в1л 2д1инсти ди2об ди5он ди2о5с ди2с1е дис1тр ди5х 2д1к
+д1л 1д2лев 2д1м дмо1с д1н 6дн. 1д2невк 1дневн 1д2невок дно5д 2дны 1дняш 5до.
+до6бла 2доблач до1бр 1дов до1д2 до1з 2докт до1п до1рв до2ру до1сн до1с2п 1дот
+6дотд дох1л 1доч до1ш2 д1п 6др. 1д2раж 1д2разн д1рас д1реж 1дресс 2д1ро. 1дроб
+1дро2г1н дро2ж3ж 6д5роз 1дром 2дрс д1руб д1рыв дря2б1 д1ряд 2д1с дс3кн дс2н
+д1т 6дт. 1дун ду2о ду1п ду2п3л ду1ст 2д1ф д1х д1ч 2д3ш2 дым1н 6дь. 1дье
+2дь3те. 5дью 1дья дэ1г е1а еа6да еа2де еа2з еа2т1р е1ба е1бе е1би е1бо е1бр
+е1бу е1бы е1бье е1бью е1бья е1бю е1бя е1ва е1ве е1ви е2в1мо ев2ним ев2нят е1во
+6евол ево2с е2в1рит 3европ е1ву е1вы 6евыд е1вье е1вью е1вья е1вю е1вя е1га
+е1гд е1ге е1ги ег1ла. е1глам ег1ло ег1лы е1го е1гу е1да е1де е1ди е1д2лин е1до
+е2д1о2щ е1ду е1ды е1дью е1дю е1дя е1е е1жа ежа6т е1же е3жи еж1м е1жо еж1р е1жу
+е1жье е1жьи е1жью е1жья е1за ез5ви ез1во е3звон е1зе е1зи е1зо е1зу е1зы е1зью
+е1зья е1зю е1зя е1и еи2г еи2д еи2м е1ка е1кв е1ке е1ки ек1н 2е1ко ек1сту е1ку
+е1ла е1ле е1ли е1ло е1лу е1лы е1лье е1льи е1лья е1лю е1ля е1ма е1ме е5ми ем1не
+ем1ного е1мо е1му ем1ч е1мы е1мье е1мьи е1мью е1мья е1мя е1на е1не е1ни е1но
+2енр ен1ри е1ну е1ны е1нье е1ньи е1нью е1нья е1нэ е1ню е1ня 2е1о ео2б еоб1л
+ео2дет е2оди ео2ж ео2кон е1о2кр е5ол. е3ола е5олы е3он. е2она е2оро ео2ру ео1с
+еоу4 ео6хв е5охл ео2ч ео2щ е1па е1пе е1пи епи1т2р еп1ле еп1ли. е1по е2пси
+еп1та еп5те еп5тич еп1то еп5тур е1пу е1пы е1пье е1пьи е1пью е1пья е1пя е1ра
+ер1ват е1ре ере5гн ере1дв ере1д2р ере1зв ере1п ереп2л ере1с2с е1ри ерис2
+ери1ск ер6кл е1ро еро6б ер1тя е1ру е2р1у2п е1ры е1рье е1рью е1рья е1рю е1ря
+е1са е1сб е2с1би е1с2г е1сд е1се е1си е1ск е1с2клад ес2кле ес2кош ескрип1
+ес5кур е1см е1со е1сок. ес1п ес2пас е1с2пот е2с3пу е1ста е5ста. е1ств е1сте
+е1сти е6стиг е1стр е1сту е1сты е1стье е1стью е1стья е1стю е1стя е1су е1сы
+е1сье е1сьи е1сью е1сья е3та е3те е3ти ет1л е3то ет1р ет2рд е3ту е3ты е1тье
+е1тьи е1тью е1тья е1тю е3тя е1у2 еу3то е1фа е1фе е1фи е1фо е1фу е1ха е1хе е1хи
+е2хк е1хо ех5об ех1о2к е1ху е2х1у2ч е1ца е1це е1ци е1цо е1цу е1ча е1че е1чи
+е1чу е1чье е1чьи е1чью е1чья е1ша е1ше е1ши е1шл е1шо е1шта еш1то е1шу е1шью
+е1ща е1ще е1щи е1що е1щу е1щью е1э е1ю е1я 2жаве 2жавл жат1в ж1б 1жг 1жд 6жд.
+2ждл 2ждь 5жев же5д2 жео2 же1с2п ж1ж ж2же ж1з жи2в1л жи2л1от жи2л1у2п 2ж1к ж1л
+ж1ма 1жму ж1н ж1п ж1с ж1т 2ж1ц ж1ч за1вче за1г2 за1др зае2 за1з2 за1кв з1акт
+зан5с6 за1р2д за1р2ж за1с зас2н зас4по зат2 за5тв за3тм за5у за1х зач2т за1ш
+за2шк зая6 2з1б з5вет зве2т3в з1вк зв2н з6вон 1зву з2вук 3з2вуч з1вя з2вяк з1г
+з5гна з2г1ни з2г1ну 2з1да з1дв зд2ва з1де з1ди зди2с 2здн з5дом з1ду з1ды з1дя
+6з1ж з1з зз2л зи6ни 2з1инт 2з1инф зи6оно 3зис 2з1к з1л 6з1м 2зна. 6зная з1не
+з1ни з1но з1ну 2зны з1ню 5зо. зо1б зо2бил з1общ зо1д2р зо1з2 зок2 з1окс 1зол
+зо3м2л зо1м2н зо1рв з1орг зо1с2 зо1щ з1п з1ра з2рак з2рач з5рез 1зри з1род
+6з1ру з1ряд 2з1с з1т з6ть 1зу з1ц з1ч з1ш 6зь. 5зью з1э и1а и2а1г и2ап иас2
+иа1ск и2аф и1ба и1бе и1би и1бо и1бр и1бу и1бы и1бье и1бью и1бю и1ва и1ве и1ви
+и1во и1в2с и1ву 2и1вы и1вье и1вью и1вья и1вя и1га и5гд и1ге и1ги и1гл иг1н
+и1го и1гу и1да и1де и1ди иди5а иди3ом и1до и1др и1ду ид1ц и1ды и1дю и1дя и1е
+и2евод ие2ди и1жа и1же и1жж и1жи и1жо и1жу и1за из1в из2ва и1з2вез из2гн
+изг1не из1д и1зе и1зи и1зна и1зо изо2б1р изо2о изо1т и1зр из1реч и1зу и1зы
+изыс1 и1зью и1зю и1зя и1и и1ка и1кв и1ке и1ки ик1н и1ко икс1ту и1ку и2к1ч и1кю
+и1ла и2л1а2ц и1ле иле1п иле2п1л и1ли и1ло и1лу и1лы иль1д и1лье и1льи и1лья
+и1лю и1ля и1ма и1ме 2имене 3и2мено 3и2мену и5ми им1н и1мо и1му и1мы и1мье
+и1мьи и1мью и1мья и1мя и1на и1не 5инж и1ни и1но ино1д2ра ино1с инс2 5инсп и1ну
+и1ны и1нье и1ньи и1нью и1нья и1ню1 иню2ш и1ня и1о иоб1ре и5оле и2опр и2о1с2к
+ио5сп ио2ста и2ох и2оц 6ип и1па и5пе и1пи и1пл и1по и2пси ип1та ип1те и6п5тиз
+ип1то ип1ту и1пу и1пы и1пью и1пю и1пя и1ра ир5в и1ре и1ри и1ро и1ру и1ры и1рье
+и1рьи и1рью и1рья и1рю и1ря и1са ис1б и1се и1си ис1к иск1н ис1м и1с2ни и1со
+ис1п и1ста ист1в и1сте ис1тек ис5тец и1сти и2с1тин и1стра и1сту и1сты и1стье
+и1стью и1стья и1стю и1стя и1су ис1ч и1сы и1сье и1сьи и1сью и1сья и1сю и5та
+ит1ва ит1ве ит5ву и1те и1ти и2т1л и1то и6тот ит1р и1т2раг и1т2рес и1т2рон и1ту
+и1ты и1тье и1тью и1тья и1тю и1тя и1у иу2г иу6р иу2ч и1фа и1фе и1фи и1фо и1фу
+и1ха и1хе и1хи и1хо и1ху и1ца и1це и1ци и1цо и1цу и1ча и1че и1чи и1чу и1чье
+и1чьи и1чью и1чья и1ша и1ше и1ши и1шл и2ш1лы и1шо и1ш2п и1шу и1шье и1шью и1шья
+и1ща и1ще и1щи и1що и1щу и1э и1ю и2юл и2юн и1я ия2д 6й1 й2дв йер1в йко5п й2ль
+й2мс й2нв й5о й2с1б йс2ко й2сн й6с5ф й2сш йх2ск 1кав ка1д ка2дн ка2д1р 1кае
+ка2ж1д 2казк каз1на кам5н 1кап ка2п1л ка2п1ре ка1сп ка1ст 1кат ка3ус каш3л
+1каю 2к1б 6кв. ква2д1р к2вак 2к1г к1д 6кеа ке5гли ке5д кеп1ти ке1ст к5ж 1кив
+ки5о ки3о2с3к ки4с3л 2к1к 1кл 2кл. 2к1ла. 2к1лак 2к1ли. к5лий 2к1ло. 2кль
+клю1ч клю2чн 2к1м 2кн к1на 3к6ниж к1но кно2п3л 5коа к2о1бес 1ковы ког2н
+ко1знан ко2мин 1комп 1кон 1коо копу5 кор1в 5коры 1кос ко2св ко1ск кос1мо
+ко2с3н ко5ств кост1ля ко2тл 1кош к1п 1кр 6кр. кри2о5 кро2пл 2кс к2св к1ск к2сл
+кс1п к2ст1ак к5сте. кс1тр 2к1т 5к6то. кт2рис кус1к 6к1ф к1х 2к1ц к1ч 2к1ш
+1ланд 1ла2пь лау1 л1ба л1би л1бо л5бы л1в л2вк л2вн л2вст л1г л2гат л1д6
+ле2б1л лег5л 1леде лен2д1р ле2о леп5ло ле2п1т ле1т2р л1жа л1же 5л6жеш л1жи
+л1за л1зе л1зо л1зы ли2б1р ли2в1л 5лиг ли2к1в ли6ос ли2п1л 1ли2п1т ли5стр
+ли2тоб ли2т1уп ли6х5в 5лицо 5личи л1к лк1н л1л л2ль ллю1 л1м 2л1н ло6бор
+ло1д6р ло1з ло1пл 2л1орг лос5ка ло1ску лох5л л1п 6л1с2 лс2то л1т л2тк л6т5л
+лу3б2р лу1д2 лу2д3к лу2д3л лу2д3н лу1с лу5т л1ф лф2т л1х6 л1ц л1ча л1че л1чи
+л1чу л1чь л1ш6 л1щ 6ль. 1льо ль2тот 6льш 1лью люк1в 1лют. ма2вз 1маг маг1н
+мад1ри ман2д1арм ма1сб ма2с1л м6ат мат1в ма2т1р ма2у ма6чт 6м1б 2м1в2 м1г 1мед
+ме2д1осм межо2т1 1мей 1мен. ме2о ме2с1к мете2о ме2ч1т м1ж м1з мз6д ми6з5ан
+2м1изд 5мий 5минг ми2ок миро3з2 м1к 1мкн 2м1л м6ль 6м1м м2мк м2м1н м1на м1нее.
+м1ней. м5неп м5ний м5нов много1 м1ное 1м2нож м1нос м5нот мо2ж3ж моз2г1л мо1м
+1мон мо3о мо1п моск1в мо1ско мосо2м3н 5моти мо2т3р 6м1п мп2л мпо2ч м1с 6мс.
+мс2н м2с1ор м2сти 6м1т му1г му5с6к м1ф м5х м1ц 6м1че 2м1ш 1мще 1мы. мы4с3л
+6мь. м5э 1на. 1на1г 1над на1з2 на3ивн на3из наи1с2к на3ит на1кв на1мн
+на1м2ного нао2т 5нап на1рв 1на1с2 нау6ч на1х 5нац на1шл на1шп на5э 3ная н1б
+2нбе н1в2 н1г нгоу5 н1д н2дв н2дг нде2с1 нд6з н2дл н2дн нд2сп н6дц не2а3по
+не1в2д 2невн нев2п не2вра не1гл не1гн недо1с не1др нее6 не1зн неи2 не5кст
+не1мн не3о2гр не3о2дин нео2п нео2пр нео2р не3о6с нео2х нео2ц не1п2 не5рж
+не2рот нес2к не1с2н не1с2п не1ст не5с6х не1сч нет2л не1т2р неу5стр нея6 н1ж
+н1з 5ниб 3ник ни5кт нила6 ни1п ни1стр 1ниц н1к нко1п нк5ро н1л н1м н1н 1но.
+но5е но1з 1ной 1ном ном5н но5о но1п ноп2л но2пт но1тв 2нотд 1нох но5ш 1ною.
+н1п 6нр. 1нрав 2н1с н2с1ля н2с1м н2сн нсу2р н2сф н1т нтиа2 нтио2 н2тк н2тл
+нт2р н2тр1а2г н2трок н2тш ну1ск ну1т2р 3ную н1ф н1х 2н1ц н1ч 2н1ш н2шн н1щ 3ны
+6нь. 1ньо 1нью 1ня 2няш 2о1а2 6о5ба об1в о1бе 1обес о1би 1о2биж 1о2б1лач
+об5лик об5лич об2луди о1бо 1о2боз об1о2с3н об5рад о2б1раж о2б1раз об5рам
+об1ращ о1бу 1объ о1бы о1бье о1бьи о1бью о1бья 2ов о1ва о1в2в о1ве о1ви о3в2люб
+о1вм о1во ово5стр о2в1па о2вры о1в2се о1в2т о1ву о1вы овыс2п о1вье о1вьи о1вью
+о1вья о1вя 2о1г о1га о1ге о1ги о1го о1гу о1да 6одар о5двиг о1де о1ди оди5ап
+од2лит о1до о2д1о2бол о2д1о2дея одо1с о2дотр од1ра о2д3раж од1рос о1дру одс2п
+о1ду о1ды о1дью одь1яч од1э о1дю о1дя о1е о2евр ое2д ое2с о1жа о1же о1жже о1жи
+о1жм о1жо о1жу о1жье о1жьи о1жью о1жья 2о1за о2з1вол оз1до оз2дор оз5дю о1зе
+о1зи о2з1но о2з1ну о2зня о1зо о2з1об озо2б1л оз1ро о1зу о1зы о2зым о1зье о1зьи
+о2зьт о1зью о1зья о1зя 2о1и ои2г6 ои2з ои2ме ои2му ои6о о1ка о2к1а2у о1кв о1ке
+ок1з о1ки 6окл ок5не ок1ну о1ко 6окол 1окт о1ку 2ол о1ла о1ле о1ли о3ло о1лу
+олу3д4 олуо2 о1лы оль1д о1лье о1льи о1лья о1лю о1ля 2ом о1ма о1ме о1ми ом1ного
+ом2ня о1мо ом1р о1му о1мч о1мы о1мье о1мья о1мя о1на о1не о1ни о2нн о1но онс2
+он2трат он6тру о1ну о1ны о1нье о1ньи о1нью о1нья о1ню о1ня о1о2 о5ом о3отр
+о1па о1пе о1пи 2опир о1по оп1та о5пте оп1ти о1пу о1пы о1пье о1пьи о1пью о1пья
+о1пя о1ра орас6пр ор2б1л о1рват о1ре о1ри ор1исп о1ро ор5ть ор5тя о1ру о5ру.
+2о1ры о1рье о1рью о1рья о1рю о1ря о1са о1сб ос5ба о3с2бер о1се о1си ос1ка.
+ос1кам ос1ках ос1ке ос1ки о1с2кла ос1кой ос1ку. ос1мет ос5ми ос1мос ос2н о1сне
+о1сним ос5нит ос3ного ос3ною 2о1со о1спе ос6пле о1с2пор о5спу ос1пы ос2св
+ос2с1м о1ста 2остал о1сте о1сти о1с2то о1стр о1сту о1сты о1стье о1стьи о1стью
+о1стья о1стю о1стя о1су 2осф о1сче о1с2шив о1сы о1сье о1сьи о1сью о1сья о1сю
+о1та от1в о6тва 1отд 2о1те о3ти 5откр от1л от2лев о1то ото1д2ра ото2чь 1отп
+2о1тр от1раз 3о2т1ряд о1ту оту2а от1у2ж от1у2т от1у2ч оту2че о1ты о1тье о1тьи
+о5ть6м о1тью о1тья о1тя 2о1у2 2оф о1фа о2ф1ак о1фе о1фи о1фо офо2р о2ф1ра о1фу
+о1фье о1фьи о1фью о1фья о1ха о1хе 2о1хи о1хо 6охор ох1рис 2о5хро о5х6т о1ху
+о1ца о1це о1ци о5ча о1че о1чи о1чл о1чу о1чье о1чьи о1чью о1чья о1ша о1ше о1ши
+о1ш2л о2ш3лы о6шн о1шо о1шу о1шье о1шью о1ща о1ще о1щи о1щу о1щью 2о1э оэ5ти
+о1ю о1я оя2в оя2д оя2з оя6р па2в пав1л па5во па5др па1с2к па2ск1в па2с1то
+пах1л п1д п6е 1пе. 1пенз пе2п1л пеп1т пер1в пере3о6с пер2м1ал пе6с5к пе2тл
+1печ 1пис пи2ск пи5с2коп 2п1к 6пл. п1ла. 1плав 1плаз 3план пле2в1р п2леде
+п1лен 1п2ленк 1п2ленок 1п2леноч 1пле2с1к 1п2лет п3леть. 1плик п5лова 1плос1к
+1плы 6пль. 2п1лю. 2п1люсь. п1лютс п1ля п2ляс п2ляш 2п1м 2п1н 1по по5б по3вли
+пог6 по2д1ж по2д1о2к по2д1о2си по1д2раг по2д1руб по2д1рул по2д1рум по2д1руч
+по2д1у2ро по2дь пое2 по1з поз2л по1мн по1п пос2 по1ск по1см по5сс по1сх 5посы
+по1х поэ1м 2п1п ппо1д 1пр 6пр. пре2до2т пре2дох прей2с1к при1вк при1в2н при1л
+приль2 при1с прис2п при1т при2тч приче2с1к про1д2л про1д2ра 5прое6 про1р
+про1ск 6прь. 2пс. 1п2салм 2псе 2п1сис 3п2сих п1ск 2псо 2п1ст 2псу 2псы 6пт.
+пт1в п5тил 1птих 2п1том п1тр 2п3ту п1туа п1ты п1тя 1пу. пу2б1л пуг1л пуг3н
+2п1ф пх6н 2п1ц 2п1ч 2п1ш п1щ 6пь. 1пя раа6 1раб раз1в ра2зобл ра3зорен ра3зори
+1ралг ра2п1л ра6сля рас1пы ра2с1та ра2с1тер рас1т2л ра2с1то расто2пл рас1тра
+рас1трог ра2с1ту ра6стуш ра2с1тя рас3тян ра2так рат1в ра5ун ра5ус рах1л раэ2
+р1б р1ва. р1вар р1вац р1веж р1вей р1вен р1ви 1р2ви. 1р2вите. р1во р5вя р1г
+р2гв р2гг р2г1л р2г1н р2гот р1д р6дв р2дл р2дн р2д1ц р2дч 1реги рег1ли ре1г2н
+ре2д1о2бе ре2д3о2ли ре2д1о2пе ре2допр ре2дос ре2д1о2се ред1р ре2д1у2г рее2
+1реза ре1зр рей2х ре2к1ват 1рекла рем1н рео2д ре2ос рео2ц реп5ло ре1р2 ре1с2п
+ре1сч ре1т2р ре2х1р р1ж р2жн р1за р1зе р1зи р1зо р1зя ри1дв ри1жм ри1зв ри1мч
+рис2м 1рисо 2рисп риу2 р1к рк1н рк6ни р1л р2ль р1м р2мк р2м1н р2мс р2мф р2м5ч
+р6мщ р1н ро5бр ро2г1не ро2г1ну ро1дв рое6х ро1зв ро1зр рои2с рооп1р ро1пл
+рор2в 2р1орг ро1с2кл ро2с1л ро1см ро5спа ро5спл 1росш ро1х ро2х1н ро5шт р1п
+рпус1к р1р рро1 ррос6 р1с р2сн рс6п р1та р2т1акт рт1в р1те р1ти р2т1л рт1лю
+р1то р2т1об р1тр р1ту р1ты р1тью р1тю руг1в руг1л руг1н р2узл 2р1укс р1ф р1ха
+р2хв р1хе р1хло р1хов рх1оп р5хот р6хре р1хуш р1ц р1ч р1ш р2шк р2шн р2ш1р р1щ
+р2щ3в2 1рыб ры2г1н рыт1в рых1 рю5ква рю5кве 1са са2б1л само1 2сбу 1св сва6е
+свах2 с1вен сверх1 свер2хи све2т све2т1л с2воя сг6 с5ге с2гор с1да с5ди с1до
+с2добн 1се се1гн сего1 сегод2 се1з секс1т сер5ва сер1ве 1сж 6с1з 1си си3ом
+си2п1л 2ск. с6как с2катн 1с2каф ск2вер с2клер 1с2клон 2скн ск1ну ско2б1л 6скон
+1скоп 5скоя 1с2креб 1с2кре1ст 1сл 6сл. 3с2лав 2с1лиру 3с2лов 2с1лок 2с1лоц
+3с2луж 2сль 6слям 2смен. 5смес смо2г1л 2с1му сму2г1 5смы с1н с5на. 5с2наб
+с5ное с5ной с5ном 2сны 1со со1бр со5вл со2в1м со1д2ра со1ж со1з со3з2да со1л2г
+со1м2 со5о со1р2в со1с2 со6с5н со2сь со1тв со2тле со5щ с1па 1с2паль с2пеш
+с1пил с1пит с1пл 1сп2лю. сп2люсь. 1с2посаб 3с2посо 1ср 6ср. с2раб с2рез с1с
+6сс. с2сб сс1во 2сск с2сн с3с2не с2сори 6сст 6ст. 2ств. ст5вер ств2л 1с4творч
+2стерл 2стк ст1ли 2стн 1сто сто1пл 6стр. 1стров 6студы 5сты 2сть. 6стьд 6стьс
+с2тяну 1су су2б суб1а суб1л су1гл су2ев су2ни супе2р1 1сфе 2сфор 1схе с1хо с1ц
+с2цена 1счас с1чат сче2с1к 1счит с1чл 6с5чу с1ш6 с1щ 1съ2 съе3д съе3л съ2е3ма
+съе3мо съе3х 1сы сы2п3ле сып1лю 6сь. 2сэ2 1ся 2сяз 1такт таме2н 2тамп та1ст
+6т1б 2т1вей 1т4верд т2вл тво1з т1вой 1т4вор т1вою 6твь. 2т1г т1д2 тег1н 1тека
+те2к1л тек1ста 1текш теле3о тем5н те2ос те2п1л те2р1ак тер1в тере2о 1терл
+те1ст тет1р2а те6хо 1тече 5течь т1ж т1з ти5а ти2в1л 5тиге ти2г1л 5тиз. 6тинж
+2т1инф ти5ок ти1стр т1к 1т2кан 1ткн тк2но 6тл. 1тле т1лог 2т1м 2т1н то1бр то1д
+то2дн то2ж1д то1з 1толк 6томс 2томщ 2тонг тооп1 1торс 1торц то1с2 1точн 1тощ
+2т1п 2тр. 2трабо т2рав 2трб 6трв 2трг 2трд трдо2 1треб 6т1ред т1рез 1т2ре2з1в
+1тре2с1к тре2х 1триб 2трм 2трп 2трр 1труб 6труп 2трф т4рщ т1рыв 1т2ряс 1т2рях
+2т1с т2сд тс2к тс2н 6т1т 5туды туп1л ту2пр 2туч 5тушев 2т1ф т1ха т1хо 2т1ц т1ч
+6тч. т1ш2 6тш. 2т1щ тыс5к 2ть. ть6му 2т1э 1тяну у1а у6але у6ас у1ба у1бе у1би
+у1бо у2б1р у1бу у1бы у1бье у1бью у1бья у1бю у1бя у1ва у1ве у1ви у1во у1ву у1вы
+у1вье у1вью у1вя у1га у1ге у1ги у1го у1гу у1да уд2в у1де у1ди у1до у2д1р уд2рс
+у1ду у1ды у1дьи у1дью у1дю у1дя у1е уе2ди уе1р у2ес у1жа у1же у1жи у1жо у1жу
+у1жье у1жьи у1жью у1жья у1за уз5дю у1зе у6зел у1зи 1узл у1зо у1зу у1зы у1зья
+у1зя у1и у1ка ук1в у1ке у1ки ук5н у1ко укос6 у1ку у1кья у1ла у5ле у1ли у1ло
+у1лу у1лы уль1д у1лье у1льи у1лья у1лю у1ля у1ма у1ме у1ми ум1ног у1мо у5мр
+у1му у1мы у1мье у1мью у1мья у1мя у1на у1не у1ни у1но у1ну у1ны у4ныв у1нье
+у1ньи у1нью у1нья у1ню у1ня у1о уо2к у5ол у1па у1пе у1пи у1по у1пу у1пы у1пье
+у1пью у1пья у1пю у1пя у1ра ур1в у1ре у1ри у1ро у1ру у1ры у1рье у1рьи у1рью
+у1рья у1рю у1ря у1са у1се у1си ус1ка ус1ке ус1ки ус5ков ус1ком ус2кр ус5ку.
+у1см у1со ус2по у1ста у1сте у1сти у1сту у1сты у1стье у1стью у1стья у1стя у1су
+у1сф ус1ч у1сы у1сье у1сью у1сья у1сю у1та у1те у1ти у1тл ут5ла у1то у6трь
+у1ту у1ты у1тье уть6м у1тью у1тья у1тю у1тя у1у уу2с у1фа у1фе у1фи у1фо у1фу
+у1фье у1фьи у1фью у1фья у1ха у2хв у1хе у1хи ух1л ух1м у1хо ух1о2к у2х1р у1ху
+у1ца у1це у1ци у1цу у1ча у1че у1чи у1чу у1чье у1чьи у1чью у1чья у1ша у1ше у1ши
+у5шл у2ш1лы у1шо уш3п у1шу у1шье у1шьи у1шью у1шья у1ща у1ще у1щи у1що у1щу
+у5э уэ5ла уэ5ле у1ю у1я уя2з 1фа фаг1н фар5в фа5у ф1б 2ф1в ф1г фе1д фе2д1р
+фени6 фе2с1к 1фи фи1д фи2дн фи6нин фи3о фи1с2к ф1к 1фл 2ф1лен ф1м 2ф1н 1фо
+2ф1орг фото1 1фр6 6фр. фра5с фре2с1к 2ф1с ф1т 1фтонг 1ф2тор ф2узл ф1ф ф1ш 1фы
+6фь. х1б 1хв 6хв. 2х1ве 6х5ви 2х1г х1д6 хе6о5 х1з 1хи хие2 хи2зы х1к х5ла.
+1хлеб х1ли х2лип х1ло. 1хлор х1лу х1лы 1х2лын х1ля х2ляб х1ма х5мет х1ми х1н
+х4ны 5хоз хоз1ар 5хом хо2пе 3хор х5осм х1осн хо1тв 5хоу х1п 1хр хри2п1л
+хро2м1ч 6хрь. 2х1с х1т 1ху. 2х1у2г 6хуем. 6хуй. х1у2ро 6хую. 6хуя. х1ф6 х1х
+х1ц 6х1ч х1ш х1э 5ца. 1цам ца2п1л 1цах. ц1б 1цв 2ц1г ц1д 1це це1д це2д1р
+цей6т5 6ценни 2ц1з 1ци ци2к1л ци2ф1р 2ц1к 2ц1л 2ц1м ц1н 1цо 2ц1о2д 2ц1от 2ц1п
+ц1р 2ц1с 2ц1т 1цу. ц1ц 1цы 5чан чар3т част1в ча2т1л ч1в 1чел чет1вер чех1л
+1чив 3чий 1чик чи2с1л 6ч1к 1чла 1чле 6ч5лег 6ч5леж 2ч1м 3ч2мок ч1н 1чо ч1с 1чт
+6чт. 2чтм чу2ж1д 1чх ч1ч ч1ш 6чь. шаг1н 6ш1б 1ш2в 6шв. шео2 ше1с 1ши2б1л
+ши2в1л ши2ф1р ш1к ш2кив 1ш2кол 6шл. ш2лем ш6леш 5шло ш2лют. ш1ля ш1м 1ш2мы2г1н
+ш1н 1шпе 1шпил ш2пр ш1с 1ште 5штр 1шту 2ш1ф ш1ц ш5ч 6шь. 1шю ще1д ще2д1р ще1с
+1щи щи2п3л 2щ1н 6щь. 6ъ1 ъе2 ъем3н ъе3х ъю6с ъю6т ъ1я2 ы1ба ы1бе ы1би ы1бо
+ы1бр ы1бу ы1бы ы1бье ы1бьи ы1бью ы1бья ы1бя ы1ва ы1ве ы1ви ы1во ы1ву ы1вы ы1вя
+ы1г ы1га ы1ге ы1ги ы1го ы1гу ы1да ы1дв ы1де ы1ди ы1до ы1ду ы1ды ы1дю ы1дя ы1е2
+ы1жа ы1же ы1жж ы1жи ы1жм ы1жо ы1жр ы1жу ы1за ы1зв ы2з1вол ы1зд ы1зе ы1зо ы1зр
+ы1зу ы1зы ы1зя ы1и2 ы1ка ык1в ы1ке ы1ки ык5н ы1ко ы1ку ы1ла ы1ле ы1ли ы1ло
+ы1лу ы1лы ы1лье ы1льи ы1лья ы1лю ы1ля ы1ма ы1ме ы1ми ы1мо ы1му ым1ч ы1мы ы1мя
+ы1на ы1не ы1ни ы1но ы1ну ы1ны ы1нье ы1ньи ы1нью ы1нья ы1ню ы1ня ы1па ы1пе ы1пи
+ып1ле ы1по ы1пу ы1пы ы1пье ы1пью ы1пя ы1ра ы1рв ы1ре ыре2х ы1ри ы1ро ы1ру ы1ры
+ы1рье ы1рью ы1рья ы1рю ы1ря ы1са ы1се ы1си ыс5ки ы2с1ку ы5см ыс2мей ы1со ыс6па
+ыс6пл ы1ст ы1ста ы1сте ы1сти ы1сту ы1сты ы1стью ы1су ы1сы ы1сье ы1сьи ы1сью
+ы1сья ы1та ы1те ы1ти ы1то ы1т6р ы1ту ы1ты ы1тье ы1тьи ы1тью ы1тья ы1тя ы1у2
+ы1ха ы1хе ы1хи ы1хо ы1ху ы1ц ы1ца ы1це ы1ча ы1че ы1чи ы1чу ы1чье ы1чьи ы1чью
+ы1чья ы1ша ы1ше ы1ши ыш1ле ы6шн ы1шо ы1шу ы1шью ы1шья ы1ща ы1ще ы1щи ы1що ы1щу
+ы1я2 ь1б ь1ва ь1ве ь1ви ь1г ь1де ь1ди ьдо1 ь5дь ь5дя ь1ж ь1з ь6зн ь6зя. ь1к
+ь2к1ло ьк5н ь1м ь6мс ь1н ь2нул ь1п ь1с ь2сн ь2сти ь2стя ь1т ьти5с ь5фе ь2ф1ра
+ь1х ьхо2 ь1ч ь1ш ь1щ ь6ща ь6ще ь6щу ь1э э6в э2д эд1р э5зи 1э2к э5ка эк1в э5ке
+эк1з эк1л экс1 экс2и эк2ск э5лы эль5 э1ля э1нь э1о э5ри эро1 эс1к эс5м эс2па
+э6ф э5ш э1я ю1а ю1б ю1ба ю1бе ю1би ю1бо ю1бу ю2бч ю1бы ю1бя ю1ва ю1ве ю1ви
+ю1во ю1ву ю1вы ю1га ю1ге ю1ги ю1го ю1гу ю1да ю1де ю2д1ж ю1ди ю1до ю1ду ю1ды
+ю1дью ю1дя ю1е ю1жа ю1же ю1жи ю1жо ю1жу ю1жье ю1жьи ю1жью ю1жья ю1за ю1зе ю1зи
+ю1зо ю1зу ю1зы ю1зю ю1зя ю1и юй2д1 юйдо6 ю1ка ю1ке юк1з ю1ки юк1н ю1ко ю1ку
+ю1ла ю1ле ю2ли ю1ло ю1лу ю1лы ю1лю ю1ля 2юм ю1ма ю1ме ю1ми юмини5 юм1н ю1мо
+ю1му ю1мы ю1на ю1не ю1ни ю1но ю1ну ю1ны ю1ню ю1ня ю1о ю1па ю1пи ю1по ю1ра ю1ре
+ю1ри ю1ро ю1ру ю1ры ю1рю ю1ря ю1са ю1се ю2с1к ю1со ю1ста ю1сте ю1сти ю1стр
+ю1сту ю1сты ю1стью ю1стя ю1су ю1сы ю1сю ю1та ю1те ю1ти ю1то ю1ту ю1ты ю1тя
+ю1фа ю1фе ю1фя ю1ха ю1хе ю1хи ю1хо ю1ху ю1це ю1ци ю1ша ю1ше ю1ши ю1шо ю1шу
+ю1ща ю1ще ю1щи ю1що ю1щу ю1ю 2юю. ю1я 2юя. я1ба я1бе я1би я1бо я1бр я1бу я1бы
+я1бью я1бя я1ва я1ве я1ви я2в1л я1во я1ву я1вы я1вью я1вя я1га я1ге я1ги яг1л
+яг5н я1го я1гу я1да я1де я1ди я1до я1ду я1ды я1дью я1дю я1дя я1е я1жа я1же
+я1жи я1жо я1жу я1жье я1жьи я1жью я1жья я1за яз1в я1зе я1зи я1зо я1зу я1зы
+я1зью я1зья я1зю я1зя я1и я1ка я1ке я1ки як1н я1ко я1ку я1ла я1ле я1ли я1ло
+я1лу я1лы я1лю я1ля я1ма я1ме я1ми я1мо я1му я1мы я1мя я1на я1не я1ни я1но
+я1ну я1ны я1нье я1ньи я1нью я1нья я1ню я1ня я1па я1пе я1пи я1по я1пу я1пы
+я1пье я1пью я1пья я1пя я1ра я1ре я1ри я1ро я1ру я1ры я1рье я1рью я1рья я1ря
+я1са я1се я1си яс1к я1со яс6т я1ста я1сти я5стр я1сту я1сты я1стье я1стью
+я1стья я1су я1сы я1та ят1в я1те я5ти я1то я1ту я1ты я1тье я1тью я1тья я1тю
+я1тя я1у я1ха я1хе я1хи ях1ле я1хо я1ху я1ца я1це я1ци я1цу я1ча я1че я1чи
+я1чу я1чье я1чьи я1чью я1чья я1ша я1ше я1ши я1шо я1шу я1ща я1ще я1щи я1що я1щу
+я1ю 2яю. я1я 2яя.
+}
+`
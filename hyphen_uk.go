@@ -0,0 +1,399 @@
+package main
+
+// hyphenUK содержит шаблоны переноса для украинского языка из проекта hyph-utf8
+// (hyph-uk). Авторы шаблонов и условия их распространения указаны в
+// исходном файле: https://github.com/hyphenation/tex-hyphen.
+const hyphenUK = `\patterns{
+.'-8 .’-8 .а-8 .б'8 .б’8 .б-8 .бд6 .бе4з3 .бе4з'3 .бе4з’3 .безві4д3 .бе5зе.
+.бе5з4о3д .без5о4соб .без3ро4з3 .безу4 .блі4ц3ана .блі4ц3криг .блі4ц3опит
+.блі4ц3торг .блі4ц3тур .блі4ц3і4спит .бр6 .в'8 .в’8 .в-8 .вб6 .вб6'6 .вб6’6
+.вв6'6 .вв6’6 .вг6 .вд6 .вж6 .вз6 .вз6д6 .ви3в4ч .ви3м4р .вк6 .вл6 .вм6 .вм6'6
+.вм6’6 .вп6 .вп6'6 .вп6’6 .вп6х6 .вс6 .вс6т6 .вт6 .вш6к6 .ві4д3 .від'3 .від’3
+.ві5д4а .ві5д4ер .ві5д4озв .ві5д4ом .ві5д4ун .ві5д4і .віді3м4р .г-8 .д'8 .д’8
+.д-8 .дж6 .дз6 .дл6 .до3в4ч .дої4в .дої4л .дої5ль .дс6 .е-8 .ж-8 .з'8 .з’8
+.з-8 .зав3м4р .за3в4ч .за3м4р .заї4к .заї4ц .заї4ч .зб6 .зв6'6 .зв6’6 .зг6
+.зд6 .зд6з6 .зл6 .зм6'6 .зм6’6 .зс6 .зс6к6 .зс6т6 .зч6 .зш6 .зш6к6 .зі3м4р
+.зґ6 .и-8 .й-8 .йш6 .к-8 .кл6 .кп6 .кс6 .кх6 .кш6 .л-8 .лк6с6 .лс6 .ль6 .м'8
+.м’8 .м-8 .мс6 .мф6 .мі4ж3 .н'8 .н’8 .н-8 .на3в4ч .на3м4р .напі4в3 .наї4д .нб6
+.о-8 .ом4рі .п'8 .п’8 .п-8 .пе4ом. .пере3 .пере4д'3 .пере4д’3 .пере4д3бач
+.пере4д3виб .пере4д3г .пере4д3д .пере4д3м .пере4д3ост .пере4д3пла .пере4д3пок
+.пере4д3р .пере4д3св .пере4д3умов .пере4д3усі .пере4д3фр .пере4д3ч .пере3м4р
+.по3в4ч .по3м4р .пона4д3 .пона5д4и .пона5д4я .пона5д4і .пої4 .пр6 .при3в4ч
+.при3м4р .пс6 .пх6 .пі4в3 .пі4д3 .під'3 .під’3 .пі5д4е .пі5д4и .пі5д4о .пі5д4у
+.пі5д4і .р'8 .р’8 .р-8 .ро4з3 .ро4з'3 .ро4з’3 .ро5з4а .ро5з4е .роз3м4р .ро5з4і
+.рт6 .с-8 .ск6 .ск6л6 .сл6 .сп6 .сп6'6 .сп6’6 .сп6л6 .сп6х6 .спі4в3 .ст6 .сх6
+.сх6л6 .т'8 .т’8 .т-8 .тк6 .тр6 .тх6 .ть6 .у-8 .ум4ре .ум4ри .ум4ру .ум4рі
+.ф'8 .ф’8 .ф-8 .фл6 .х-8 .хл6 .ц-8 .ць6 .ч-8 .чере4з3 .чере4з'3 .чере4з’3 .чх6
+.ш'8 .ш’8 .ш-8 .шк6 .шл6 .шп6 .шт6 .щ-8 .ь-8 .ю-8 .я-8 .є-8 .і-8 .ї-8 .ґ-8 6'
+8'8'- 8'8а- 8'8б- 8'8в- 8'8г- 8'8д- 8'8е- 8'8ж- 8'8з- 8'8и- 8'8й- 8'8к- 8'8л-
+8'8м- 8'8н- 8'8о- 8'8п- 8'8р- 8'8с- 8'8т- 8'8у- 8'8ф- 8'8х- 8'8ц- 8'8ч- 8'8ш-
+8'8щ- 8'8ь- 8'8ю- 8'8я- 8'8є- 8'8і- 8'8ї- 'ї4в 'ї4д 'ї4ж 'ї4з 'ї4л 'ї4м 'ї4с
+'ї4х 8'8ґ- 6’ 8’8’- 8’8а- 8’8б- 8’8в- 8’8г- 8’8д- 8’8е- 8’8ж- 8’8з- 8’8и-
+8’8й- 8’8к- 8’8л- 8’8м- 8’8н- 8’8о- 8’8п- 8’8р- 8’8с- 8’8т- 8’8у- 8’8ф- 8’8х-
+8’8ц- 8’8ч- 8’8ш- 8’8щ- 8’8ь- 8’8ю- 8’8я- 8’8є- 8’8і- 8’8ї- ’ї4в ’ї4д ’ї4ж
+’ї4з ’ї4л ’ї4м ’ї4с ’ї4х 8’8ґ- 8-7 -'8'8 -'8а8 -'8б8 -'8в8 -'8г8 -'8д8 -'8е8
+-'8ж8 -'8з8 -'8и8 -'8й8 -'8к8 -'8л8 -'8м8 -'8н8 -'8о8 -'8п8 -'8р8 -'8с8 -'8т8
+-'8у8 -'8ф8 -'8х8 -'8ц8 -'8ч8 -'8ш8 -'8щ8 -'8ь8 -'8ю8 -'8я8 -'8є8 -'8і8 -'8ї8
+-'8ґ8 -’8’8 -’8а8 -’8б8 -’8в8 -’8г8 -’8д8 -’8е8 -’8ж8 -’8з8 -’8и8 -’8й8 -’8к8
+-’8л8 -’8м8 -’8н8 -’8о8 -’8п8 -’8р8 -’8с8 -’8т8 -’8у8 -’8ф8 -’8х8 -’8ц8 -’8ч8
+-’8ш8 -’8щ8 -’8ь8 -’8ю8 -’8я8 -’8є8 -’8і8 -’8ї8 -’8ґ8 --8 -а8'8 -а8’8 -а8а8
+-а8б8 -а8в8 -а8г8 -а8д8 -а8е8 -а8ж8 -а8з8 -а8и8 -а8й8 -а8к8 -а8л8 -а8м8 -а8н8
+-а8о8 -а8п8 -а8р8 -а8с8 -а8т8 -а8у8 -а8ф8 -а8х8 -а8ц8 -а8ч8 -а8ш8 -а8щ8 -а8ь8
+-а8ю8 -а8я8 -а8є8 -а8і8 -а8ї8 -а8ґ8 -б8'8 -б8’8 -б8а8 -б8б8 -б8в8 -б8г8 -б8д8
+-б8е8 -б8ж8 -б8з8 -б8и8 -б8й8 -б8к8 -б8л8 -б8м8 -б8н8 -б8о8 -б8п8 -б8р8 -б8с8
+-б8т8 -б8у8 -б8ф8 -б8х8 -б8ц8 -б8ч8 -б8ш8 -б8щ8 -б8ь8 -б8ю8 -б8я8 -б8є8 -б8і8
+-б8ї8 -б8ґ8 -в8'8 -в8’8 -в8а8 -в8б8 -в8в8 -в8г8 -в8д8 -в8е8 -в8ж8 -в8з8 -в8и8
+-в8й8 -в8к8 -в8л8 -в8м8 -в8н8 -в8о8 -в8п8 -в8р8 -в8с8 -в8т8 -в8у8 -в8ф8 -в8х8
+-в8ц8 -в8ч8 -в8ш8 -в8щ8 -в8ь8 -в8ю8 -в8я8 -в8є8 -в8і8 -в8ї8 -в8ґ8 -г8'8 -г8’8
+-г8а8 -г8б8 -г8в8 -г8г8 -г8д8 -г8е8 -г8ж8 -г8з8 -г8и8 -г8й8 -г8к8 -г8л8 -г8м8
+-г8н8 -г8о8 -г8п8 -г8р8 -г8с8 -г8т8 -г8у8 -г8ф8 -г8х8 -г8ц8 -г8ч8 -г8ш8 -г8щ8
+-г8ь8 -г8ю8 -г8я8 -г8є8 -г8і8 -г8ї8 -г8ґ8 -д8'8 -д8’8 -д8а8 -д8б8 -д8в8 -д8г8
+-д8д8 -д8е8 -д8ж8 -д8з8 -д8и8 -д8й8 -д8к8 -д8л8 -д8м8 -д8н8 -д8о8 -д8п8 -д8р8
+-д8с8 -д8т8 -д8у8 -д8ф8 -д8х8 -д8ц8 -д8ч8 -д8ш8 -д8щ8 -д8ь8 -д8ю8 -д8я8 -д8є8
+-д8і8 -д8ї8 -д8ґ8 -е8'8 -е8’8 -е8а8 -е8б8 -е8в8 -е8г8 -е8д8 -е8е8 -е8ж8 -е8з8
+-е8и8 -е8й8 -е8к8 -е8л8 -е8м8 -е8н8 -е8о8 -е8п8 -е8р8 -е8с8 -е8т8 -е8у8 -е8ф8
+-е8х8 -е8ц8 -е8ч8 -е8ш8 -е8щ8 -е8ь8 -е8ю8 -е8я8 -е8є8 -е8і8 -е8ї8 -е8ґ8 -ж8'8
+-ж8’8 -ж8а8 -ж8б8 -ж8в8 -ж8г8 -ж8д8 -ж8е8 -ж8ж8 -ж8з8 -ж8и8 -ж8й8 -ж8к8 -ж8л8
+-ж8м8 -ж8н8 -ж8о8 -ж8п8 -ж8р8 -ж8с8 -ж8т8 -ж8у8 -ж8ф8 -ж8х8 -ж8ц8 -ж8ч8 -ж8ш8
+-ж8щ8 -ж8ь8 -ж8ю8 -ж8я8 -ж8є8 -ж8і8 -ж8ї8 -ж8ґ8 -з8'8 -з8’8 -з8а8 -з8б8 -з8в8
+-з8г8 -з8д8 -з8е8 -з8ж8 -з8з8 -з8и8 -з8й8 -з8к8 -з8л8 -з8м8 -з8н8 -з8о8 -з8п8
+-з8р8 -з8с8 -з8т8 -з8у8 -з8ф8 -з8х8 -з8ц8 -з8ч8 -з8ш8 -з8щ8 -з8ь8 -з8ю8 -з8я8
+-з8є8 -з8і8 -з8ї8 -з8ґ8 -и8'8 -и8’8 -и8а8 -и8б8 -и8в8 -и8г8 -и8д8 -и8е8 -и8ж8
+-и8з8 -и8и8 -и8й8 -и8к8 -и8л8 -и8м8 -и8н8 -и8о8 -и8п8 -и8р8 -и8с8 -и8т8 -и8у8
+-и8ф8 -и8х8 -и8ц8 -и8ч8 -и8ш8 -и8щ8 -и8ь8 -и8ю8 -и8я8 -и8є8 -и8і8 -и8ї8 -и8ґ8
+-й8'8 -й8’8 -й8а8 -й8б8 -й8в8 -й8г8 -й8д8 -й8е8 -й8ж8 -й8з8 -й8и8 -й8й8 -й8к8
+-й8л8 -й8м8 -й8н8 -й8о8 -й8п8 -й8р8 -й8с8 -й8т8 -й8у8 -й8ф8 -й8х8 -й8ц8 -й8ч8
+-й8ш8 -й8щ8 -й8ь8 -й8ю8 -й8я8 -й8є8 -й8і8 -й8ї8 -й8ґ8 -к8'8 -к8’8 -к8а8 -к8б8
+-к8в8 -к8г8 -к8д8 -к8е8 -к8ж8 -к8з8 -к8и8 -к8й8 -к8к8 -к8л8 -к8м8 -к8н8 -к8о8
+-к8п8 -к8р8 -к8с8 -к8т8 -к8у8 -к8ф8 -к8х8 -к8ц8 -к8ч8 -к8ш8 -к8щ8 -к8ь8 -к8ю8
+-к8я8 -к8є8 -к8і8 -к8ї8 -к8ґ8 -л8'8 -л8’8 -л8а8 -л8б8 -л8в8 -л8г8 -л8д8 -л8е8
+-л8ж8 -л8з8 -л8и8 -л8й8 -л8к8 -л8л8 -л8м8 -л8н8 -л8о8 -л8п8 -л8р8 -л8с8 -л8т8
+-л8у8 -л8ф8 -л8х8 -л8ц8 -л8ч8 -л8ш8 -л8щ8 -л8ь8 -л8ю8 -л8я8 -л8є8 -л8і8 -л8ї8
+-л8ґ8 -м8'8 -м8’8 -м8а8 -м8б8 -м8в8 -м8г8 -м8д8 -м8е8 -м8ж8 -м8з8 -м8и8 -м8й8
+-м8к8 -м8л8 -м8м8 -м8н8 -м8о8 -м8п8 -м8р8 -м8с8 -м8т8 -м8у8 -м8ф8 -м8х8 -м8ц8
+-м8ч8 -м8ш8 -м8щ8 -м8ь8 -м8ю8 -м8я8 -м8є8 -м8і8 -м8ї8 -м8ґ8 -н8'8 -н8’8 -н8а8
+-н8б8 -н8в8 -н8г8 -н8д8 -н8е8 -н8ж8 -н8з8 -н8и8 -н8й8 -н8к8 -н8л8 -н8м8 -н8н8
+-н8о8 -н8п8 -н8р8 -н8с8 -н8т8 -н8у8 -н8ф8 -н8х8 -н8ц8 -н8ч8 -н8ш8 -н8щ8 -н8ь8
+-н8ю8 -н8я8 -н8є8 -н8і8 -н8ї8 -н8ґ8 -о8'8 -о8’8 -о8а8 -о8б8 -о8в8 -о8г8 -о8д8
+-о8е8 -о8ж8 -о8з8 -о8и8 -о8й8 -о8к8 -о8л8 -о8м8 -о8н8 -о8о8 -о8п8 -о8р8 -о8с8
+-о8т8 -о8у8 -о8ф8 -о8х8 -о8ц8 -о8ч8 -о8ш8 -о8щ8 -о8ь8 -о8ю8 -о8я8 -о8є8 -о8і8
+-о8ї8 -о8ґ8 -п8'8 -п8’8 -п8а8 -п8б8 -п8в8 -п8г8 -п8д8 -п8е8 -п8ж8 -п8з8 -п8и8
+-п8й8 -п8к8 -п8л8 -п8м8 -п8н8 -п8о8 -п8п8 -п8р8 -п8с8 -п8т8 -п8у8 -п8ф8 -п8х8
+-п8ц8 -п8ч8 -п8ш8 -п8щ8 -п8ь8 -п8ю8 -п8я8 -п8є8 -п8і8 -п8ї8 -п8ґ8 -р8'8 -р8’8
+-р8а8 -р8б8 -р8в8 -р8г8 -р8д8 -р8е8 -р8ж8 -р8з8 -р8и8 -р8й8 -р8к8 -р8л8 -р8м8
+-р8н8 -р8о8 -р8п8 -р8р8 -р8с8 -р8т8 -р8у8 -р8ф8 -р8х8 -р8ц8 -р8ч8 -р8ш8 -р8щ8
+-р8ь8 -р8ю8 -р8я8 -р8є8 -р8і8 -р8ї8 -р8ґ8 -с8'8 -с8’8 -с8а8 -с8б8 -с8в8 -с8г8
+-с8д8 -с8е8 -с8ж8 -с8з8 -с8и8 -с8й8 -с8к8 -с8л8 -с8м8 -с8н8 -с8о8 -с8п8 -с8р8
+-с8с8 -с8т8 -с8у8 -с8ф8 -с8х8 -с8ц8 -с8ч8 -с8ш8 -с8щ8 -с8ь8 -с8ю8 -с8я8 -с8є8
+-с8і8 -с8ї8 -с8ґ8 -т8'8 -т8’8 -т8а8 -т8б8 -т8в8 -т8г8 -т8д8 -т8е8 -т8ж8 -т8з8
+-т8и8 -т8й8 -т8к8 -т8л8 -т8м8 -т8н8 -т8о8 -т8п8 -т8р8 -т8с8 -т8т8 -т8у8 -т8ф8
+-т8х8 -т8ц8 -т8ч8 -т8ш8 -т8щ8 -т8ь8 -т8ю8 -т8я8 -т8є8 -т8і8 -т8ї8 -т8ґ8 -у8'8
+-у8’8 -у8а8 -у8б8 -у8в8 -у8г8 -у8д8 -у8е8 -у8ж8 -у8з8 -у8и8 -у8й8 -у8к8 -у8л8
+-у8м8 -у8н8 -у8о8 -у8п8 -у8р8 -у8с8 -у8т8 -у8у8 -у8ф8 -у8х8 -у8ц8 -у8ч8 -у8ш8
+-у8щ8 -у8ь8 -у8ю8 -у8я8 -у8є8 -у8і8 -у8ї8 -у8ґ8 -ф8'8 -ф8’8 -ф8а8 -ф8б8 -ф8в8
+-ф8г8 -ф8д8 -ф8е8 -ф8ж8 -ф8з8 -ф8и8 -ф8й8 -ф8к8 -ф8л8 -ф8м8 -ф8н8 -ф8о8 -ф8п8
+-ф8р8 -ф8с8 -ф8т8 -ф8у8 -ф8ф8 -ф8х8 -ф8ц8 -ф8ч8 -ф8ш8 -ф8щ8 -ф8ь8 -ф8ю8 -ф8я8
+-ф8є8 -ф8і8 -ф8ї8 -ф8ґ8 -х8'8 -х8’8 -х8а8 -х8б8 -х8в8 -х8г8 -х8д8 -х8е8 -х8ж8
+-х8з8 -х8и8 -х8й8 -х8к8 -х8л8 -х8м8 -х8н8 -х8о8 -х8п8 -х8р8 -х8с8 -х8т8 -х8у8
+-х8ф8 -х8х8 -х8ц8 -х8ч8 -х8ш8 -х8щ8 -х8ь8 -х8ю8 -х8я8 -х8є8 -х8і8 -х8ї8 -х8ґ8
+-ц8'8 -ц8’8 -ц8а8 -ц8б8 -ц8в8 -ц8г8 -ц8д8 -ц8е8 -ц8ж8 -ц8з8 -ц8и8 -ц8й8 -ц8к8
+-ц8л8 -ц8м8 -ц8н8 -ц8о8 -ц8п8 -ц8р8 -ц8с8 -ц8т8 -ц8у8 -ц8ф8 -ц8х8 -ц8ц8 -ц8ч8
+-ц8ш8 -ц8щ8 -ц8ь8 -ц8ю8 -ц8я8 -ц8є8 -ц8і8 -ц8ї8 -ц8ґ8 -ч8'8 -ч8’8 -ч8а8 -ч8б8
+-ч8в8 -ч8г8 -ч8д8 -ч8е8 -ч8ж8 -ч8з8 -ч8и8 -ч8й8 -ч8к8 -ч8л8 -ч8м8 -ч8н8 -ч8о8
+-ч8п8 -ч8р8 -ч8с8 -ч8т8 -ч8у8 -ч8ф8 -ч8х8 -ч8ц8 -ч8ч8 -ч8ш8 -ч8щ8 -ч8ь8 -ч8ю8
+-ч8я8 -ч8є8 -ч8і8 -ч8ї8 -ч8ґ8 -ш8'8 -ш8’8 -ш8а8 -ш8б8 -ш8в8 -ш8г8 -ш8д8 -ш8е8
+-ш8ж8 -ш8з8 -ш8и8 -ш8й8 -ш8к8 -ш8л8 -ш8м8 -ш8н8 -ш8о8 -ш8п8 -ш8р8 -ш8с8 -ш8т8
+-ш8у8 -ш8ф8 -ш8х8 -ш8ц8 -ш8ч8 -ш8ш8 -ш8щ8 -ш8ь8 -ш8ю8 -ш8я8 -ш8є8 -ш8і8 -ш8ї8
+-ш8ґ8 -щ8'8 -щ8’8 -щ8а8 -щ8б8 -щ8в8 -щ8г8 -щ8д8 -щ8е8 -щ8ж8 -щ8з8 -щ8и8 -щ8й8
+-щ8к8 -щ8л8 -щ8м8 -щ8н8 -щ8о8 -щ8п8 -щ8р8 -щ8с8 -щ8т8 -щ8у8 -щ8ф8 -щ8х8 -щ8ц8
+-щ8ч8 -щ8ш8 -щ8щ8 -щ8ь8 -щ8ю8 -щ8я8 -щ8є8 -щ8і8 -щ8ї8 -щ8ґ8 -ь8'8 -ь8’8 -ь8а8
+-ь8б8 -ь8в8 -ь8г8 -ь8д8 -ь8е8 -ь8ж8 -ь8з8 -ь8и8 -ь8й8 -ь8к8 -ь8л8 -ь8м8 -ь8н8
+-ь8о8 -ь8п8 -ь8р8 -ь8с8 -ь8т8 -ь8у8 -ь8ф8 -ь8х8 -ь8ц8 -ь8ч8 -ь8ш8 -ь8щ8 -ь8ь8
+-ь8ю8 -ь8я8 -ь8є8 -ь8і8 -ь8ї8 -ь8ґ8 -ю8'8 -ю8’8 -ю8а8 -ю8б8 -ю8в8 -ю8г8 -ю8д8
+-ю8е8 -ю8ж8 -ю8з8 -ю8и8 -ю8й8 -ю8к8 -ю8л8 -ю8м8 -ю8н8 -ю8о8 -ю8п8 -ю8р8 -ю8с8
+-ю8т8 -ю8у8 -ю8ф8 -ю8х8 -ю8ц8 -ю8ч8 -ю8ш8 -ю8щ8 -ю8ь8 -ю8ю8 -ю8я8 -ю8є8 -ю8і8
+-ю8ї8 -ю8ґ8 -я8'8 -я8’8 -я8а8 -я8б8 -я8в8 -я8г8 -я8д8 -я8е8 -я8ж8 -я8з8 -я8и8
+-я8й8 -я8к8 -я8л8 -я8м8 -я8н8 -я8о8 -я8п8 -я8р8 -я8с8 -я8т8 -я8у8 -я8ф8 -я8х8
+-я8ц8 -я8ч8 -я8ш8 -я8щ8 -я8ь8 -я8ю8 -я8я8 -я8є8 -я8і8 -я8ї8 -я8ґ8 -є8'8 -є8’8
+-є8а8 -є8б8 -є8в8 -є8г8 -є8д8 -є8е8 -є8ж8 -є8з8 -є8и8 -є8й8 -є8к8 -є8л8 -є8м8
+-є8н8 -є8о8 -є8п8 -є8р8 -є8с8 -є8т8 -є8у8 -є8ф8 -є8х8 -є8ц8 -є8ч8 -є8ш8 -є8щ8
+-є8ь8 -є8ю8 -є8я8 -є8є8 -є8і8 -є8ї8 -є8ґ8 -і8'8 -і8’8 -і8а8 -і8б8 -і8в8 -і8г8
+-і8д8 -і8е8 -і8ж8 -і8з8 -і8и8 -і8й8 -і8к8 -і8л8 -і8м8 -і8н8 -і8о8 -і8п8 -і8р8
+-і8с8 -і8т8 -і8у8 -і8ф8 -і8х8 -і8ц8 -і8ч8 -і8ш8 -і8щ8 -і8ь8 -і8ю8 -і8я8 -і8є8
+-і8і8 -і8ї8 -і8ґ8 -ї8'8 -ї8’8 -ї8а8 -ї8б8 -ї8в8 -ї8г8 -ї8д8 -ї8е8 -ї8ж8 -ї8з8
+-ї8и8 -ї8й8 -ї8к8 -ї8л8 -ї8м8 -ї8н8 -ї8о8 -ї8п8 -ї8р8 -ї8с8 -ї8т8 -ї8у8 -ї8ф8
+-ї8х8 -ї8ц8 -ї8ч8 -ї8ш8 -ї8щ8 -ї8ь8 -ї8ю8 -ї8я8 -ї8є8 -ї8і8 -ї8ї8 -ї8ґ8 -ґ8'8
+-ґ8’8 -ґ8а8 -ґ8б8 -ґ8в8 -ґ8г8 -ґ8д8 -ґ8е8 -ґ8ж8 -ґ8з8 -ґ8и8 -ґ8й8 -ґ8к8 -ґ8л8
+-ґ8м8 -ґ8н8 -ґ8о8 -ґ8п8 -ґ8р8 -ґ8с8 -ґ8т8 -ґ8у8 -ґ8ф8 -ґ8х8 -ґ8ц8 -ґ8ч8 -ґ8ш8
+-ґ8щ8 -ґ8ь8 -ґ8ю8 -ґ8я8 -ґ8є8 -ґ8і8 -ґ8ї8 -ґ8ґ8 2а1 8а8'- 8а8’- а3а 8а8а-
+аа4дрес 8а8б- абия4к 8а8в- авої4д 3а4вторит аві4а 8а8г- 3а4гент 3а4грес 8а8д-
+3а4декват 3а4дитив а3е 8а8е- ае4тил ае4фект 8а8ж- 8а8з- 3а4зарт 8а8и- а2й
+8а8й- ай4с3берг 8а8к- 3а4ктив 3а4ктуал 3а4курат 3а4куст 3а4кцепт 3а4кциз 8а8л-
+3а4лергі альбі5он 8а8м- 3а4матор 8а8н- ана3в4ч 3а4наліз 3а4натом а3о 8а8о-
+ао4браз ао4пис ао4пік ао4ха ао4хот ао4щад 8а8п- 3а4парат 3а4пеляц 3а4постол
+3а4птеч 8а8р- 3а4ргумен 3а4ромат ар4т3афіш ар4т3взвод ар4т3десант ар4т3кафе
+ар4т3майс ар4т3медіа ар4т3мейс ар4т3мейст ар4т3мін ар4т3о4бстр ар4т3о4дин
+ар4т3о4збр ар4т3під ар4т3рин ар4т3у4стан ар4т3факт ар4т3хім ар4т3центр 8а8с-
+3а4соці 3а4спект 8а8т- 3а4тлет а3у 8а8у- ау4год а3у4дар ауді4о ау4т3екол 8а8ф-
+8а8х- 3а4халі ахої4д 8а8ц- 8а8ч- 8а8ш- 8а8щ- 8а8ь- а3ю 8а8ю- а3я 8а8я- а3є
+8а8є- а3і 8а8і- а3і4стор а3ї 8а8ї- 8а8ґ- 8б8'- б'4єть 8б8’- б’4єть 8б8а-
+баге4р3мейст бак3а4наліз бактері4о бале4т3мейст бальне4о ба4с3антра ба4с3енер
+2б1б 8б8б- 6бв. 8б8в- 8б8г- 8б8д- 8б8е- беза4варі без3а4дрес безві4д3
+безе4місі безу4гл безу4пин бе4з5і4дей без5і4мен бей4сбол бе5кон 8б8ж- 6бз.
+8б8з- 8б8и- 6бй. 8б8й- бйор4нс 2б1к 8б8к- 6бл. 8б8л- 3блаж 3ближ 3близ 3блиск
+3блок бло4к3пост 3блоці 6б6ль. 8б8м- 6бн. 8б8н- 8б8о- бо4г3дан бори4с5п
+бо4р4т3мех бо4р4т3о4пер бо4р4т3про бо4р4т3рад бо4р4т3і4нж бо4є3гол бо4є3гот
+бо4є3зап бо4є3здат бо4є3комп бо4є3пост бо4є3прип 2б1п 8б8п- 6бр. 8б8р- 3бран
+бран4д бран4д3мейст 3брати 3брест 3бризк 3британ 3бруд брі4дж3порт 2б1с 6бс.
+8б8с- 6б6ст. 6б6с6тв. 6б6с6тр. 6б6с6ьк. 2б1т 8б8т- 8б8у- 2б1ф 8б8ф- 2б1х 8б8х-
+2б1ц 8б8ц- 6б6ць. 2б1ч 8б8ч- 2б1ш 8б8ш- 2б1щ 8б8щ- 8б8ь- 8б8ю- 8б8я- 8б8є-
+8б8і- біблі4о бі4о3 8б8ї- 8б8ґ- 2в'3 8в8'- 3в'4яз 2в’3 8в8’- 3в’4яз 8в8а-
+ва4ль4д3мейст 4в3антрац 2в1б 6вб. 8в8б- 3в4бив 3в4б4лаг 2в1в 6вв. 8в8в-
+3в4веден 2в1г 8в8г- 2в1д 6вд. 8в8д- 3в4дал 3в4довз 3в4довол 6в6др. 6в6дь.
+8в8е- ве4ль4т3мейст вер4х3н 2в1ж 6вж. 8в8ж- 3в4живан 2в1з 6вз. 8в8з- 6в6зь.
+8в8и- ви3й4д вий4м ви3й4т вина3й4д вина3й4т виу4ди ви3у4ч вия4в вия4сн виї4
+2в1й 6вй. 8в8й- 2в1к 6вк. 8в8к- 3в4к4лад 2в1л 6вл. 8в8л- 3в4лад 3в4ласн
+3в4лашт 3в4лов 6в6ль. 2в1м 6вм. в3м'4я в3м’4я 8в8м- 2в1н 6вн. 8в8н- 8в8о-
+водо5з4бір водо5с4ток воль4т3ампер воль4т3метр во4с5ко во4станнє во4євод
+во4єнач 2в1п 6вп. 8в8п- 3в4певн 3в4п4лив 3в4поряд 3в4п4равн 2в1р 6вр. 8в8р-
+3в4разлив 3в4р4одлив 3в4рожай 2в1с 6вс. 8в8с- 6в6с6тв. 6в6с6ть. 6в6сь.
+6в6с6ьк. 3в4сюд 2в1т 6вт. 8в8т- 3в4т4рут 3в4т4руч 3в4тіл 8в8у- в3у4дар 2в1ф
+8в8ф- 2в1х 6вх. 8в8х- 2в1ц 8в8ц- 6в6ць. 2в1ч 6вч. 8в8ч- 2в1ш 6вш. 8в8ш- 2в1щ
+6вщ. 8в8щ- 8в8ь- 8в8ю- 8в8я- 8в8є- 8в8і- від3в'4я від3в’4я ві5д4ен ві5д4е4о
+від7зна від5о4браж від5о4браз ві5д4ом віду4ч 8в8ї- 8в8ґ- 8г8'- 8г8’- 8г8а-
+8г8б- 6гв. 8г8в- 2г1г 6гг. 8г8г- 6гд. 8г8д- 8г8е- гелі4о ген3а4наліз ге4о
+ге2ть3ман 8г8ж- 8г8з- 8г8и- 8г8й- 2г1к 8г8к- 6гл. 8г8л- 3глад 3глиб 3глин
+3глоб 3глуз 3глуш 6г6ль. 3гляд 3глян 6гм. 8г8м- 6гн. 8г8н- 3гнан 3гнил 3гноз
+3гнучк 8г8о- гоме4о го4с4п5роз гос4п3у4год го4ф3мейст 2г1п 8г8п- 6гр. 8г8р-
+3грав 3град 3грай 3грам 3гран 3грати 3граф граф3о4браз 3граш 3граю 3грає 3грес
+3грець 3гроб 3грож 3гроз 3громад гро4с3мейст 3груван 3грунт 3груп 3грів 3гріт
+3гріш 2г1с 6гс. 8г8с- 6г6с6тв. 6г6сь. 2г1т 6гт. 8г8т- 8г8у- 2г1ф 8г8ф- 8г8х-
+2г1ц 8г8ц- 2г1ч 8г8ч- 2г1ш 8г8ш- 8г8щ- 8г8ь- 8г8ю- 8г8я- 8г8є- 8г8і- 3г4ідро
+гі4д5ро5мет гіпер3а4кт гіпер3е4місі 8г8ї- 8г8ґ- 8д8'- 8д8’- 8д8а- 3д4ан 8д8б-
+3д4бав 3д4бал 3д4бан 3д4бат 3д4бає 8д8в- 3двиг двох4а5том 3двою 3двоє 3двій
+3двір 3двічі 8д8г- 2д1д 8д8д- 3ддя 8д8е- декре4т3мейст дер4ж5а4дм дер4ж5а4том
+дер4ж3без дер4ж5бюдж дер4ж5вид дер4ж5дум дер4ж5замов дер4ж5ком дер4ж5нафт
+дер4ж3резерв дер4ж5реєс дер4ж5служ дер4ж5стр дея4к д4ж 6дж. 8д8ж- джен4тль д4з
+6дз. 8д8з- 4д7зем 6д6зь. 8д8и- дисбаланс ди4с3гарм ди4с3квал ди4с3комф
+ди4с3конт ди4с3кред ди4с3крет ди4с3крец ди4с3крим ди4с3кусі ди4с3куту ди4с3лок
+ди4с3парит ди4с3перс ди4с3петч ди4с3пле ди4с3плей ди4с3пози ди4с3проп ди4с3пут
+ди4с3тил ди4с3триб ди4с3троф ди4с3функц 8д8й- 2д1к 8д8к- 6дл. 8д8л- 6дм. 8д8м-
+6дн. 8д8н- д4ні3п4р 8д8о- до3в'4ю до3в'4є до3в’4ю до3в’4є до3з4вол до3з4віл
+д4о3й4м до4к3мейст до5о4р дорого5в4каз доу4к доу4м дої4д дої4ж дої4м дої4ст
+дої4х 2д1п 8д8п- 6др. 8д8р- 3драж 3дром 3друж 3друк 3дряп 3дріб 3дріма 2д1с
+8д8с- 6д6с6тв. 6д6с6ьк. 2д1т 6дт. 8д8т- 8д8у- д3у4сім 2д1ф 8д8ф- 2д1х 8д8х-
+2д1ц 8д8ц- 2д1ч 8д8ч- 2д1ш 8д8ш- 2д1щ 8д8щ- 6дь. 8д8ь- 2дь1к 2дь1с 6д6ь6сь.
+2дь1т 2дь1ц 8д8ю- 8д8я- 8д8є- 8д8і- ді4алог ді3й4д дій4ма ді3й4т ді4о ді4оген
+ді4єві4д3 8д8ї- 8д8ґ- 2е1 8е8'- 8е8’- е3а 8е8а- еа4варі еа4дрес еа4кт 8е8б-
+8е8в- еви3в4ч е3в4каз 8е8г- 8е8д- едо3в4ч е3е 8е8е- ее4місі ее4стет ее4фект
+8е8ж- 8е8з- 8е8и- е2й 8е8й- 8е8к- 3е4колог 3е4коном ек2с1к ек2с1п ек2с1т
+ек2с1ц 8е8л- 3е4легант 3е4лектр 3е4лемент 8е8м- 3е4моці е3м4рій 3е4мігр 8е8н-
+ена3в4ч 3е4нерг енерго3з4береж енерго3з4беріг ень7о4кисл е3о 8е8о- ео4браз
+ео4бур ео4голош ео4зор е3о4кисл ео4пал ео4пис ео4плачув ео4сві ео4ха ео4хот
+ео4цін ео4щад 8е8п- 8е8р- 8е8с- 3е4стакад 3е4стет 8е8т- 3е4тап еті4о е3у 8е8у-
+еу4бог еу4год еу4стр 8е8ф- 8е8х- 8е8ц- 8е8ч- 8е8ш- 8е8щ- 8е8ь- е3ю 8е8ю- е3я
+8е8я- е3є 8е8є- е3і 8е8і- е3і4стор еі4стот е3ї 8е8ї- 8е8ґ- 8ж8'- 8ж8’- 8ж8а-
+6жб. 8ж8б- 6жв. 8ж8в- 3жвав 8ж8г- 8ж8д- 6ж6дь. 8ж8е- 2ж1ж 8ж8ж- 8ж8з- 8ж8и-
+8ж8й- 2ж1к 8ж8к- жко4м5а4том 8ж8л- 8ж8м- 3жміть 8ж8н- 8ж8о- жона3в4ч 2ж1п
+8ж8п- 8ж8р- 3жріть 2ж1с 8ж8с- 6ж6сь. 2ж1т 8ж8т- 8ж8у- 2ж1ф 8ж8ф- 2ж1х 8ж8х-
+2ж1ц 8ж8ц- 2ж1ч 8ж8ч- 2ж1ш 8ж8ш- 8ж8щ- 8ж8ь- 8ж8ю- 8ж8я- 8ж8є- 8ж8і- 8ж8ї-
+8ж8ґ- 8з8'- з'я4в 3з'4ясо з'4єдн 8з8’- з’я4в 3з’4ясо з’4єдн 8з8а- за3в'4ю
+за3в'4я за3в'4є за3в’4ю за3в’4я за3в’4є за4вві4д3 зай4м зай4ня з3а4кт за3м'4я
+за3м’4я з3а4наліз за5о4р за4п3част за3у4ч за3ю4ш зая4в за3я4ло заї4д заї4ж
+заї4з заї4л заї4м заї4х 8з8б- 3з4бага 3з4баланс 3з4б4рой 3з4б4рою 3з4б4роє
+3з4був 3з4бут 6зв. з3в'4я 3з4в4'яз з3в’4я 3з4в4’яз 8з8в- 3зваж 3зван 3звед
+3звел 3звест 3звись 3звич 3звищ 3зворуш 3звук 3звуч 3звіт 6зг. 8з8г- 3з4год
+6зд. 8з8д- 3з4дат 6з6дв. 6з6дн. 3з4довж 3з4доров 6з6дь. здій4ня 3з4дійсн 8з8е-
+зе4кономити 8з8ж- 2з1з 8з8з- 8з8и- 8з8й- 3зйом 2з1к 6зк. 8з8к- 6зл. 8з8л- 6зм.
+з3м'4я з3м’4я 8з8м- 3змін 3зміш 6зн. 8з8н- 3знав 3знай зна3й4д зна3й4т 3знак
+3знал 3знан 3знат 3знаход 3знач 3знаю 3знає 3зниж 6з6нь. 3знім 8з8о- зо4ка
+зо4ке зо4ки зо4ку зо4кі зо6о 2з1п 8з8п- 6зр. 8з8р- 3зрозум 3зрюв 3зрів 3зріл
+3зрін 2з1с 8з8с- 6з6сь. 2з1т 8з8т- 8з8у- з3у4дар зу4роч 2з1ф 8з8ф- 2з1х 8з8х-
+2з1ц 8з8ц- 2з1ч 8з8ч- 3з4чеп 2з1ш 8з8ш- 2з1щ 8з8щ- 6зь. 8з8ь- 6з6ьб. 2зь1к
+6з6ьк. 2зь1с 2зь1т 8з8ю- 8з8я- 8з8є- 8з8і- зі3в'4ю зі3в'4я зі3в'4є зі3в’4ю
+зі3в’4я зі3в’4є зі3м'4я зі3м’4я 8з8ї- 8з8ґ- 2и1 8и8'- 8и8’- и3а 8и8а- иа4варі
+8и8б- 8и8в- 8и8г- игої4д 8и8д- и3е 8и8е- ие4місі ие4стет 8и8ж- 8и8з- 8и8и- и2й
+8и8й- ий4ня ий4ти 8и8к- 8и8л- 8и8м- 8и8н- и3о 8и8о- и3о4кисл 8и8п- 8и8р- 8и8с-
+8и8т- и3у 8и8у- 8и8ф- 8и8х- 8и8ц- 8и8ч- 8и8ш- 8и8щ- 8и8ь- и3ю 8и8ю- и3я 8и8я-
+и3є 8и8є- и3і 8и8і- и3і4стор и3ї 8и8ї- 8и8ґ- 8й8'- 8й8’- 8й8а- 2й1б 6йб. 8й8б-
+2й1в 6йв. 8й8в- 2й1г 6йг. 8й8г- 2й1д 6йд. 8й8д- 8й8е- й3е4стет 2й1ж 8й8ж- 2й1з
+6йз. 8й8з- 8й8и- 2й1й 8й8й- 2й1к 6йк. 8й8к- 6й6кл. 2й1л 6йл. 8й8л- 6й6ль. 2й1м
+6йм. 8й8м- 3й4ма 3й4менн 3й4мищ 3й4мовірн 6й6мс. 3й4му. 3й4муть 3й4міть 2й1н
+6йн. 8й8н- 6й6нс. 3й6о 8й8о- йо4сві 2й1п 6йп. 8й8п- 2й1р 6йр. 8й8р- 2й1с 6йс.
+8й8с- 6й6ст. 6й6с6тв. 6й6с6тр. 6й6сь. 6й6с6ьк. 2й1т 6йт. 8й8т- 6й6тс. 8й8у-
+й3у4бог 2й1ф 6йф. 8й8ф- 2й1х 6йх. 8й8х- 2й1ц 6йц. 8й8ц- 2й1ч 6йч. 8й8ч- 2й1ш
+6йш. 8й8ш- 3й4ш4л 3й4шов 2й1щ 8й8щ- 8й8ь- 8й8ю- 8й8я- 8й8є- 8й8і- 8й8ї- 8й8ґ-
+8к8'- 8к8’- 8к8а- каза4х3стан ка5нал капе4ль3мейст 2к1б 8к8б- 6кв. 8к8в-
+кварти4р3мейст квар4т3плат квої4д 2к1г 8к8г- 2к1д 8к8д- 8к8е- 8к8ж- 2к1з 8к8з-
+8к8и- киї4венер 8к8й- 2к1к 6кк. 8к8к- 6кл. 8к8л- 6к6ль. 8к8м- 8к8н- 8к8о-
+кому4ненерг кон4тр3арг кон4трре контр3у4дар конце4р4т3мейст корої4д коу4роч
+8к8п- 6кр. 8к8р- кра4н3мейст 6кс. 8к8с- 2к1сп 2к1ст 6к6ст. 6к6сь. 2к1ськ 6кт.
+8к8т- 6к6тр. 8к8у- 8к8ф- 6кх. 8к8х- 8к8ц- 8к8ч- 6кш. 8к8ш- 8к8щ- 8к8ь- 8к8ю-
+8к8я- 8к8є- 8к8і- 8к8ї- 8к8ґ- 8л8'- 8л8’- 8л8а- 2л1б 6лб. 8л8б- 2л1в 8л8в-
+2л1г 6лг. 8л8г- 2л1д 6лд. 8л8д- 8л8е- 2л1ж 8л8ж- 2л1з 8л8з- 8л8и- 8л8й- 2л1к
+6лк. 8л8к- 2л1л 6лл. 8л8л- 6л6ль. 3ллю 3лля 3ллє 2л1м 6лм. 8л8м- 6л6мс. 2л1н
+6лн. 8л8н- 8л8о- 2л1п 6лп. 8л8п- 2л1р 8л8р- 2л1с 6лс. 8л8с- 2л1т 6лт. 8л8т-
+8л8у- 2л1ф 8л8ф- 2л1х 8л8х- 6л6хв. 2л1ц 8л8ц- 2л1ч 8л8ч- 8л8ш- 8л8щ- 6ль.
+8л8ь- 2ль1б 6л6ьб. 2ль1в 6л6ьв. 2ль1г 6л6ьг. 2ль1д 6л6ьд. 6л6ь6дс. 2ль1ж 2ль1з
+6л6ьз. 2ль1й 2ль1к 6л6ьк. 2ль1м 6л6ьм. 2ль1н 6л6ьн. 2ль1п 6л6ьп. 2ль1р 2ль1с
+6л6ьс. 6л6ь6ств. 6л6ь6сь. 6л6ь6ськ. 2ль1т 6л6ьт. 6л6ь6тр. 2ль1ф 6л6ьф. 2ль1х
+6л6ьх. 2ль1ц 6л6ьц. 2ль1ч 6л6ьч. 2ль1ш 6л6ьш. 2ль1щ 6л6ьщ. 8л8ю- 8л8я- 8л8є-
+8л8і- 8л8ї- 2л1ґ 8л8ґ- 2м'3 8м8'- 3м'4якш 3м'4ят 2м’3 8м8’- 3м’4якш 3м’4ят
+8м8а- м3а4наліз 2м1б 6мб. 8м8б- 6м6б6ль. 6м6бр. 2м1в 8м8в- 2м1г 6мг. 8м8г-
+2м1д 8м8д- 8м8е- мете4о 2м1ж 6мж. 8м8ж- 2м1з 8м8з- 8м8и- 8м8й- 2м1к 6мк. 8м8к-
+3м4к4не 3м4к4ну 3м4к4ні 2м1л 6мл. 8м8л- 6м6ль. 2м1м 6мм. 8м8м- 2м1н 6мн. 8м8н-
+3м4нож 8м8о- мо4к5рий мона3в4ч 2м1п 6мп. 8м8п- 2м1р 6мр. 8м8р- 3м4ріть 2м1с
+6мс. 8м8с- 6м6с6тв. 6м6сь. 6м6с6ьк. 2м1т 6мт. 8м8т- 8м8у- 2м1ф 6мф. 8м8ф- 2м1х
+6мх. 8м8х- 2м1ц 8м8ц- 2м1ч 8м8ч- 2м1ш 6мш. 8м8ш- 2м1щ 8м8щ- 3м4щен 8м8ь- 8м8ю-
+8м8я- 8м8є- 8м8і- між3а4варі між3а4наліз міжу4соб мі4н5е4ко мі4н5е4нер мі4о
+8м8ї- 8м8ґ- 2н'3 8н8'- 2н’3 8н8’- 8н8а- на3б4лиз на3в'4ю на3в'4я на3в’4ю
+на3в’4я на3в4ряд на3в4ча на3в4чен на3в4чіть на4д'3 на4д’3 над3а4варі на3д4бан
+на4д7з4в наду4роч наді4стор наді4стот на3з4в на4й3а найа4кт на4й3е най3е4фект
+най4ма на4й3масл най4ня на4й7о4бер на4й3обереж най3о4браз на4й7о4гид
+на4й7о4гол на4й7о4гряд на4й7о4пук най3о4станн на4й7о4хай на4й3спри на4й3у4бог
+на4й3якіс най3я4сн най3і4стор найі4стот на3м'4я на3м’4я нао4р на5п4лив напоу4м
+напів3а4варі на3у4ч ная4в наї4вс наї4вш наї4ж наї4з наї4л наї4м наї4с наї4х
+2н1б 8н8б- 2н1в 6нв. 8н8в- 2н1г 6нг. 8н8г- 6н6гл. 6н6г6ль. 6н6гр. 6н6гс. 2н1д
+6нд. 8н8д- 6н6дж. 6н6дз. 6н6дп. 6н6др. 8н8е- неа4би не3в4том неві4д3 неві4д'3
+неві4д’3 не3д4бан недо3у4ч недої4 не3з4важ не3з4вич не4оклас не4окомун
+не4оландш не4олог не4олібер не4оліт не4омальт не4онац нео4пал не4офіт
+непо3в'4я непо3в’4я неу4к не3у4ч нею4н нея4к нея4рок нея4сн неї4ст 2н1ж 6нж.
+8н8ж- 2н1з 6нз. 8н8з- 8н8и- 8н8й- 2н1к 6нк. 8н8к- 6н6кс. 6н6кт. 2н1л 8н8л-
+2н1м 6нм. 8н8м- 2н1н 6нн. 8н8н- 3ння 8н8о- но4к3а4ут но3м'4я но3м’4я 2н1п
+8н8п- 2н1р 6нр. 8н8р- 2н1с 6нс. 8н8с- 6н6ск. 6н6ст. 6н6с6тв. 6н6с6тр. 6н6с6ьк.
+6н6с6ькй. 2н1т 6нт. 8н8т- нт3а4наліз 6н6тк. 6н6тр. 6н6т6ств. 6н6ть. 8н8у- 2н1ф
+6нф. 8н8ф- 2н1х 6нх. 8н8х- 2н1ц 6нц. 8н8ц- 6н6ць. 2н1ч 6нч. 8н8ч- 2н1ш 6нш.
+8н8ш- 2н1щ 8н8щ- 6нь. 8н8ь- 2нь1б 6н6ьб. 2нь1г 6н6ьг. 2нь1з 2нь1й 2нь1к 6н6ьк.
+2нь1л 2нь1м 2нь1с 6н6ь6сь. 2нь1т 2нь1х 2нь1ц 2нь1ч 2нь1ш 8н8ю- 8н8я- 8н8є-
+8н8і- ні4т5рат нія4к 8н8ї- 8н8ґ- 2о1 8о8'- 8о8’- о3а 8о8а- оа4варі оа4дрес
+оа4каці оа4кт оа4на о3а4наліз оа4том об'3 3о4б'єдн 3о4б'єкт об’3 3о4б’єдн
+3о4б’єкт 8о8б- об3в'4я об3в’4я 3о4береж 3о4бира об4л3а4дмін об4лдер4ж об3м'4я
+об3м’4я обой4м 3о4борон об5у4мов обі3в'4є обі3в’4є 3о4бід обі3д4ран обі3й4д
+обій4м обій4ня обі3й4т 3о4біц 8о8в- ови3в4ч о3в4каз ові4д3 8о8г- од'3 од’3
+8о8д- 3о4даль 3о4дяг о3е 8о8е- ое4ко ое4місі ое4стет ое4фект 8о8ж- 8о8з-
+оза3в4ч 3о4збро 8о8и- о2й 8о8й- 8о8к- 3о4крем 8о8л- 8о8м- 8о8н- онаї4д оної4д
+о3о 8о8о- оо4б ооб'3 ооб’3 ооб3м оо4браз ооб3ро оо4біг оо4держ оо4динок оо4к
+о3о4кисл оо4ктан оо4пал оо4пис оо4плачув оо4сві оо4цін оо4чист оо4чищ 8о8п-
+3о4перат 3о4плат 3о4птим 3о4пуст 3о4пуше 3о4пуще 8о8р- 3о4рдинац 3о4ренд 8о8с-
+3о4соб 3о4сяжн 8о8т- о3у 8о8у- оу4год о3у4дар оу4ком оу4с оу4стр оу4сун 8о8ф-
+8о8х- 3о4холо 3о4хорон 3о4хоч 8о8ц- 8о8ч- 3о4чисн 3о4чищ 8о8ш- 8о8щ- 8о8ь- о3ю
+8о8ю- о3я 8о8я- о3є 8о8є- о3і 8о8і- оі4зол о3і4стор оі4стот о3ї 8о8ї- 8о8ґ-
+8п8'- 8п8’- 8п8а- пале4о пан3е4стет па4н3о4тець пар3е4стет 2п1б 8п8б- 8п8в-
+8п8г- 2п1д 6пд. 8п8д- 8п8е- пед3у4чи пере3в4том перег4ній перед3а4варі
+перед5о4бід перед3о4пла перед3о4станн пере4д5см перед5у4мов перед3і4стор
+пере3й4д перей4м перей4ня пере3й4т пере3м'4я пере3м’4я пере5о4р пере5п4лив
+пере3у4ч переї4д переї4ж переї4з переї4л переї4с переї4х 8п8ж- 2п1з 8п8з-
+8п8и- 8п8й- 8п8к- 6пл. 8п8л- 8п8м- 8п8н- 8п8о- п4о5бере по3б4лизу по3в'4ю
+по3в'4я по3в'4є по3в’4ю по3в’4я по3в’4є по3в4тор по3в4ча по3в4чен по3в4чіть
+по3д4во по3д4раз по3д4роб по5ж4ніть позау4роч по5з4бав полі3а4наліз полі4о
+полі4т5екон полі4ц3мейст по3м'4я по3м’4я по5о4р пор4т3н пор4т3рет пор4т3фел
+пост3а4варі по4с4т3декр пос4т3кап пос4т3ком по4с4т5кому по4с4т3контра
+по4с4т3менопауз пос4т3нат по4с4тприват пос4т3проц по4с4т5радян по4с4т3раді
+пос4т3соц по4с4т5соці пос4т3фікс пост3і4стор поу4роч поч4не поч4ни поч4ну
+по4ш4т3мейст поя4в поя4с поя4сн пої4д пої4зд 2п1п 8п8п- 6пр. 8п8р- 3п4ре
+пре4й4с 3п4ри при3в'4я при3в’4я при3й4д прий4м при3й4т при3м'4я при3м’4я
+при4нцип прио4р приу4роч приї4 3п4ро про3б4лем про4ект3н прой4м про4м3май
+пр4о5плат про3с4тирад про4ф3ві4д3 про4ф3с проя4сн прої4 3п4рі 6пс. 8п8с-
+3п4с4ков 3п4сов 3п4сон 2п1сп 2п1ст 6п6с6тв. 3п4сув 6п6сь. 2п1ськ 6пт. 8п8т-
+6п6тр. 8п8у- 6пф. 8п8ф- 8п8х- 6пц. 8п8ц- 8п8ч- 8п8ш- 8п8щ- 8п8ь- 8п8ю- 8п8я-
+8п8є- 8п8і- піва4кт пі5в4ень пів3о4вал пі5в4оні піво4с пів5о4с4тр пів3у4год
+пів3і4стор під3в'4я під3в’4я під5о4дин пі6д5о4р під3у4ч підій4ня піді3м'4я
+піді3м’4я пій4м 8п8ї- 8п8ґ- 2р'3 8р8'- 2р’3 8р8’- 8р8а- раді4о рай3в4но 2р1б
+6рб. 8р8б- 2р1в 6рв. 8р8в- 3р4вав 3р4вати 3р4віть 2р1г 6рг. 8р8г- 2р1д 6рд.
+8р8д- 6р6дв. 6р6дж. 6р6дь. 8р8е- ре3а4наліз 2р1ж 6рж. 8р8ж- 2р1з 6рз. 8р8з-
+6р6зн. 6р6зь. 8р8и- 2р1й 8р8й- 2р1к 6рк. 8р8к- 6р6кс. 6р6кт. 2р1л 6рл. 8р8л-
+6р6ль. 6р6л6ьз. 2р1м 6рм. 8р8м- рмої4д 2р1н 6рн. 8р8н- 6р6нс. 6р6н6ст. 6р6нь.
+8р8о- роз'я4р роз'я4сн ро4з'5єдн роз’я4р роз’я4сн ро4з’5єдн роз5вант ро4з5вер
+роз5вин роз5вит ро4з5гор ро4з3гром ро4з5д4во ро4з5діл ро5з4йом ро4з3лив
+ро4з5мінний розо4ра ро5з4ора. ро5з4о5рам ро5з4орах розо4ре розо4реш ро5з4ори
+ро6з5о4ри. розо4рн ро5з4оро ро5з4ору ро5з4орю ро5з4оря ро5з4орі роз3у4год
+ро5з4ум роз5у4чен ро4з5чеп роз5і4мен 2р1п 6рп. 8р8п- 2р1р 6рр. 8р8р- 2р1с 6рс.
+8р8с- 6р6ср. 6р6ст. 6р6с6тв. 6р6с6ть. 6р6сь. 6р6с6ьк. 2р1т 6рт. 8р8т- 6р6тв.
+6р6тр. 6р6ть. 8р8у- руко5с4тиск 2р1ф 6рф. 8р8ф- 2р1х 6рх. 8р8х- 2р1ц 6рц.
+8р8ц- 6р6ць. 2р1ч 6рч. 8р8ч- 2р1ш 6рш. 8р8ш- 2р1щ 6рщ. 8р8щ- 6р6щ6сь. 6рь.
+8р8ь- 2рь1к 2рь1ц 8р8ю- 8р8я- 8р8є- 8р8і- 8р8ї- 2р1ґ 8р8ґ- 8с8'- 8с8’- 8с8а-
+само3у4ч сан4к4т3 2с1б 8с8б- 8с8в- сво4єкорис сво4єрід сво4єчас 2с1г 8с8г-
+2с1д 8с8д- 6с6дп. 6с6д6рп. 8с8е- серцеї4д сеї4д 8с8ж- 8с8з- 8с8и- 8с8й- 6ск.
+8с8к- 3с4кид 3с4к4лад 3с4к4ле 3с4к4лит 3с4к4ло 3с4кок 3с4коп 3с4кор 3с4короч
+3с4коч 3с4к4рипт 3с4кіль 3с4кіпл 6сл. 8с8л- 6с6ль. 6см. 8с8м- 6сн. 8с8н- 8с8о-
+сор4тн соці4о 6сп. 8с8п- 3с4пад 3с4пект 3с4перм спе4ц3ві4д3 спе4ц3кур
+спе4ц3мон спе4цпр спе4ц3с 3с4пин 2с1пк 3с4п4лав 3с4п4лат 3с4п4лач 3с4повід
+3с4пожив спор4т3вир спор4т3зал спор4т3клуб спор4т3ком спор4т3май спор4т4с3ме
+3с4постер 2с1пп 3с4п4рав 3с4п4ритн 3с4п4рият 3с4п4ромо 3с4промож 2с1пс 2с1пт
+2с1пх 2с1пч 3с4пі співві4д3 3с4піть 3с4піш 8с8р- 2с1с 6сс. 8с8с- 2с1ськ 6ст.
+8с8т- 3с4табіл 3с4тав 3с4тад 3с4таз 3с4тайн 3с4тал 3с4тан 3с4тар 3с4тара
+3с4тат стат5упр 3с4тач 3с4тає 6с6тв. 3с4т4вор 3с4теп 3с4тереж 3с4теріг 3с4тиг
+3с4тиж 3с4тисл 3с4титу 6с6тй. 2с1тк 6с6тм. 3с4товб 3с4той 3с4торон 3с4торін
+3с4тосо 3с4тосу 3с4тою 3с4тоян 2с1тп 6с6тр. 3с4т4ражд 3с4т4рах 3с4т4риб
+3с4т4риж 3с4т4рой 3с4т4рок 3с4т4ром 3с4т4роф 3с4т4роч 3с4т4рою 3с4т4роя
+3с4т4роє 3с4т4рої 3с4т4рукт 3с4т4рукц 6с6т6рь. 3с4т4рій 3с4т4ріл 3с4т4річ
+2с1тс 2с1тсь 2с1тт 3с4туп 2с1тф 2с1тц 6с6ть. 3с4тяг 3с4тіб 3с4тій 3с4тіль
+3с4тір 8с8у- су3м'4я су3м’4я супер3а4варі супер3е4фект супер3о4браз 8с8ф-
+3с4фер 8с8х- 3с4хил 3с4хов 3с4хід 8с8ц- 6с6ць. 8с8ч- 8с8ш- 2с1шт 8с8щ- 6сь.
+8с8ь- 2сь1б 6с6ьб. 2сь1д 6с6ьк. сь4квуг 2сь1кк 2сь1кс 2сь1кт 6с6ьм. 8с8ю-
+8с8я- 8с8є- 8с8і- 8с8ї- 8с8ґ- 8т8'- 8т8’- 8т8а- 2т1б 8т8б- 6тв. 8т8в- 6т6вт.
+2т1г 8т8г- 2т1д 8т8д- 8т8е- те4одоліт те4олог те4ософ те4х3ві4д3 2т1ж 8т8ж-
+2т1з 8т8з- 6т6зт. 8т8и- 8т8й- 8т8к- 3т4кан 3т4к4нен 6тл. 8т8л- 6т6ль. 6тм.
+8т8м- 6т6мр. 8т8н- 8т8о- тор4г3пред 8т8п- 6тр. 8т8р- тран4с3 6тс. 8т8с- 2т1ск
+2т1сп 2т1ст 6т6с6тв. 2т1ськ 6т6с6ьк. 2т1т 6тт. 8т8т- 3ттю 3ття 8т8у- тур4к3мен
+8т8ф- 8т8х- 6тц. 8т8ц- 6тч. 8т8ч- 8т8ш- 2т1шк 8т8щ- 6ть. 8т8ь- 2ть1б 3т4ь4мар
+3т4ь4мяні 6т6ь6сь. 8т8ю- 8т8я- 8т8є- 8т8і- 8т8ї- 8т8ґ- 2у1 8у8'- 8у8’- у3а
+8у8а- 8у8б- убої4д у3в'4я у3в’4я 8у8в- 3у4ваг 3у4важ 8у8г- 3у4гав 8у8д- у3е
+8у8е- 8у8ж- 8у8з- 3у4згод 8у8и- у2й 8у8й- 8у8к- 3у4клад 3у4компл у4к4р
+укр3а4вт укр3а4гр укр3е4кс 3у4крупн укр3і4н4банк 8у8л- 3у4люблен у3м'4я у3м’4я
+8у8м- 3у4мит 3у4міл 8у8н- у3о 8у8о- 8у8п- 3у4перед 3у4п4рав 8у8р- 3у4разлив
+3у4рбан 3у4рочист 3у4ряд 3у4рядов 8у8с- 3у4спіш 3у4станов 3у4стпіш 3у4сувати
+8у8т- 3у4твор 3у4тробн у3у 8у8у- 8у8ф- 8у8х- 8у8ц- 8у8ч- 8у8ш- 8у8щ- 8у8ь- у3ю
+8у8ю- у3я 8у8я- уя4в у3є 8у8є- у3і 8у8і- уі3в'4ю уі3в'4є уі3в’4ю уі3в’4є у3ї
+8у8ї- 8у8ґ- 8ф8'- 8ф8’- 8ф8а- 2ф1б 8ф8б- 8ф8в- 2ф1г 8ф8г- 8ф8д- 8ф8е- 8ф8ж-
+2ф1з 8ф8з- 8ф8и- 8ф8й- 8ф8к- 8ф8л- 6фм. 8ф8м- 8ф8н- 8ф8о- фо4р4с4т3мейст 8ф8п-
+6фр. 8ф8р- 8ф8с- 2ф1сп 2ф1ст 6ф6с6тв. 2ф1ськ 6фт. 8ф8т- 6ф6ть. 8ф8у- 2ф1ф 6фф.
+8ф8ф- 8ф8х- 8ф8ц- 8ф8ч- 8ф8ш- 2ф1шт 8ф8щ- 6фь. 8ф8ь- 8ф8ю- 8ф8я- 8ф8є- 8ф8і-
+фізі4о 8ф8ї- 8ф8ґ- 8х8'- 8х8’- 8х8а- 8х8б- 6хв. 8х8в- хво4є3г4риз 2х1г 8х8г-
+2х1д 8х8д- 8х8е- 8х8ж- 8х8з- 8х8и- 8х8й- 8х8к- 8х8л- 6хм. 8х8м- 6хн. 8х8н-
+8х8о- х3о4кисл хо4р3мейст 8х8п- 6хр. 8х8р- 8х8с- 2х1ст 2х1ськ 6хт. 8х8т- 3х4то
+8х8у- 8х8ф- 2х1х 8х8х- 8х8ц- 8х8ч- 6хш. 8х8ш- 8х8щ- 8х8ь- 8х8ю- 8х8я- 8х8є-
+8х8і- хімі4о 8х8ї- 8х8ґ- 8ц8'- 8ц8’- 8ц8а- ц3а4наліз 2ц1б 8ц8б- 8ц8в- 2ц1г
+8ц8г- 2ц1д 8ц8д- 8ц8е- цен4т4р3енерг 8ц8ж- 2ц1з 8ц8з- 8ц8и- 8ц8й- 8ц8к- 8ц8л-
+8ц8м- 8ц8н- 8ц8о- 8ц8п- 8ц8р- 8ц8с- 2ц1ст 8ц8т- 6ц6тв. 8ц8у- цук3ро 8ц8ф-
+8ц8х- 2ц1ц 8ц8ц- 8ц8ч- 8ц8ш- 2ц1шк 8ц8щ- 6ць. 8ц8ь- 6ц6ьк. 8ц8ю- 8ц8я- 8ц8є-
+8ц8і- 8ц8ї- 8ц8ґ- 8ч8'- 8ч8’- 8ч8а- 2ч1б 6чб. 8ч8б- 8ч8в- 8ч8г- 2ч1д 8ч8д-
+8ч8е- 2ч1ж 8ч8ж- 8ч8з- 8ч8и- 8ч8й- 8ч8к- 8ч8л- 6чм. 8ч8м- 6чн. 8ч8н- 8ч8о-
+чорно3б4рив 8ч8п- 8ч8р- 8ч8с- 6чт. 8ч8т- 8ч8у- 8ч8ф- 8ч8х- 8ч8ц- 2ч1ч 8ч8ч-
+8ч8ш- 8ч8щ- 8ч8ь- 8ч8ю- 8ч8я- 8ч8є- 8ч8і- 8ч8ї- 8ч8ґ- 8ш8'- 8ш8’- 8ш8а-
+шапі4т3мейст 2ш1б 8ш8б- 6шв. 8ш8в- 2ш1г 8ш8г- 8ш8д- 8ш8е- 8ш8ж- 8ш8з- 8ш8и-
+8ш8й- 8ш8к- 3ш4код 3ш4кол 3ш4кідл 3ш4кіл 3ш4кір 8ш8л- 6ш6ль. 6шм. 8ш8м- 6шн.
+8ш8н- 6ш6нл. 8ш8о- 8ш8п- 8ш8р- 8ш8с- 6ш6сь. 6шт. 8ш8т- 3ш4таб шта4л3мейст
+6ш6тв. 2ш1тк 2ш1тсь 3ш4туч 8ш8у- 8ш8ф- 8ш8х- 8ш8ц- 8ш8ч- 2ш1ш 8ш8ш- 8ш8щ-
+8ш8ь- 8ш8ю- 8ш8я- 8ш8є- 8ш8і- 8ш8ї- 8ш8ґ- 8щ8'- 8щ8’- 8щ8а- 8щ8б- 8щ8в- 8щ8г-
+8щ8д- 8щ8е- 8щ8ж- 8щ8з- 8щ8и- 8щ8й- 8щ8к- 8щ8л- 8щ8м- 8щ8н- 8щ8о- 8щ8п- 8щ8р-
+8щ8с- 6щ6сь. 8щ8т- 8щ8у- 8щ8ф- 8щ8х- 8щ8ц- 8щ8ч- 8щ8ш- 2щ1щ 8щ8щ- 8щ8ь- 8щ8ю-
+8щ8я- 8щ8є- 8щ8і- 8щ8ї- 8щ8ґ- 6ь 8ь8'- 8ь8’- 8ь8а- 8ь8б- 8ь8в- 8ь8г- 8ь8д-
+8ь8е- 8ь8ж- 8ь8з- 8ь8и- 8ь8й- 8ь8к- 8ь8л- 8ь8м- 8ь8н- ь6о 8ь8о- 8ь8п- 8ь8р-
+8ь8с- 8ь8т- 8ь8у- 8ь8ф- 8ь8х- 8ь8ц- 8ь8ч- 8ь8ш- 8ь8щ- 8ь8ь- 8ь8ю- 8ь8я- 8ь8є-
+8ь8і- 8ь8ї- 8ь8ґ- 2ю1 8ю8'- 8ю8’- ю3а 8ю8а- 8ю8б- 8ю8в- 8ю8г- 8ю8д- ю3е 8ю8е-
+8ю8ж- 8ю8з- 8ю8и- ю2й 8ю8й- 8ю8к- 8ю8л- 8ю8м- 8ю8н- ю3о 8ю8о- 8ю8п- 8ю8р-
+8ю8с- 8ю8т- ю3у 8ю8у- 8ю8ф- 8ю8х- 8ю8ц- 8ю8ч- 8ю8ш- 8ю8щ- 8ю8ь- ю3ю 8ю8ю- ю3я
+8ю8я- ю3є 8ю8є- ю3і 8ю8і- ю3ї 8ю8ї- 8ю8ґ- 2я1 8я8'- 8я8’- я3а 8я8а- яа4варі
+8я8б- 8я8в- 8я8г- 8я8д- 3я4дерн я3е 8я8е- яе4місі 8я8ж- 8я8з- 3я4зик 8я8и- я2й
+8я8й- 8я8к- 3я4кіс 8я8л- 8я8м- 8я8н- я3о 8я8о- 8я8п- 8я8р- 3я4рус 8я8с-
+3я4скрав ясої4д 8я8т- я3у 8я8у- 8я8ф- 8я8х- 8я8ц- 8я8ч- 8я8ш- 8я8щ- 8я8ь- я3ю
+8я8ю- я3я 8я8я- я3є 8я8є- 8я8і- я3і4стор я3ї 8я8ї- 8я8ґ- 2є1 8є8'- 8є8’- 8є8а-
+8є8б- 8є8в- єв4р3атом 8є8г- єге4р3мейст 8є8д- 3є4дин 3є4д3н 8є8е- 8є8ж- 8є8з-
+8є8и- є2й 8є8й- 8є8к- 8є8л- 8є8м- 8є8н- 8є8о- 8є8п- 3є4писк єпи4с5коп
+єпі4с5коп 8є8р- 3є4рей 8є8с- 8є8т- є3у 8є8у- 8є8ф- 8є8х- 8є8ц- 8є8ч- 8є8ш-
+8є8щ- 8є8ь- є3ю 8є8ю- 8є8я- є3є 8є8є- 8є8і- є3ї 8є8ї- 8є8ґ- 2і1 8і8'- 8і8’-
+і3а 8і8а- іа4дрес 8і8б- 8і8в- 8і8г- 8і8д- і3е 8і8е- іе4тил 8і8ж- 8і8з-
+3і4зотоп і3и 8і8и- і2й 8і8й- ій4ти 8і8к- 8і8л- і4л3е4тил 3і4люстр 8і8м-
+3і4мовір 8і8н- 3і4нтенс інтер3в'4ю інтер3в’4ю 3і4нформ інфор4м3аген 3і4ніціат
+і3о 8і8о- іо4ктан і4он іона3в4ч 8і8п- 8і8р- 8і8с- 3і4снув 8і8т- і3у 8і8у-
+8і8ф- 8і8х- 8і8ц- 8і8ч- 8і8ш- 8і8щ- 8і8ь- і3ю 8і8ю- і3я 8і8я- і3є 8і8є- 8і8і-
+і3і4стор і3ї 8і8ї- 8і8ґ- 2ї1 8ї8'- 8ї8’- 8ї8а- 8ї8б- 8ї8в- 8ї8г- 8ї8д- ї3е
+8ї8е- 8ї8ж- 3ї4ждж 8ї8з- 3ї4зд 8ї8и- ї2й 8ї8й- 8ї8к- 8ї8л- 8ї8м- 8ї8н- ї3о
+8ї8о- 8ї8п- 8ї8р- 8ї8с- 3ї4ст 8ї8т- 8ї8у- 8ї8ф- 8ї8х- 3ї4хав 3ї4хат 8ї8ц-
+8ї8ч- 8ї8ш- 8ї8щ- 8ї8ь- ї3ю 8ї8ю- 8ї8я- 8ї8є- 8ї8і- 8ї8ї- 8ї8ґ- 8ґ8'- 8ґ8’-
+8ґ8а- 8ґ8б- 8ґ8в- 8ґ8г- 8ґ8д- 8ґ8е- 8ґ8ж- 8ґ8з- 8ґ8и- 8ґ8й- 8ґ8к- 8ґ8л- 8ґ8м-
+8ґ8н- 8ґ8о- 8ґ8п- 8ґ8р- 3ґрунт 8ґ8с- 8ґ8т- 8ґ8у- 8ґ8ф- 8ґ8х- 8ґ8ц- 8ґ8ч- 8ґ8ш-
+8ґ8щ- 8ґ8ь- 8ґ8ю- 8ґ8я- 8ґ8є- 8ґ8і- 8ґ8ї- 2ґ1ґ 8ґ8ґ-
+}
+`
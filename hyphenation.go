@@ -0,0 +1,280 @@
+package main
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+// softHyphen задает символ мягкого переноса. Используется сам символ, а не
+// &shy;, т.к. в XHTML эта именованная сущность не определена.
+const softHyphen = "\u00ad"
+
+// Минимальная длина слова и частей слова при переносе.
+const (
+	hyphenMinWord  = 5
+	hyphenMinLeft  = 2
+	hyphenMinRight = 2
+)
+
+// hyphenator расставляет переносы по алгоритму Франклина Лианга, используемому
+// в TeX.
+type hyphenator struct {
+	patterns   map[string][]int // Шаблоны: буквы и веса промежутков между ними
+	maxLen     int              // Максимальная длина шаблона
+	exceptions map[string][]int // Слова-исключения и позиции переносов в них
+	left       int              // Минимальное количество букв перед переносом
+	right      int              // Минимальное количество букв после переноса
+}
+
+// newHyphenator возвращает пустой расстановщик переносов.
+func newHyphenator() *hyphenator {
+	return &hyphenator{
+		patterns:   make(map[string][]int),
+		exceptions: make(map[string][]int),
+		left:       hyphenMinLeft,
+		right:      hyphenMinRight,
+	}
+}
+
+// reTeXComment описывает комментарии в файлах шаблонов TeX.
+var reTeXComment = regexp.MustCompile(`(?m)%.*$`)
+
+// load добавляет шаблоны и исключения из текста в формате TeX:
+// \patterns{...} и \hyphenation{...}. Текст без этих команд считается списком
+// шаблонов.
+func (h *hyphenator) load(text string) {
+	text = reTeXComment.ReplaceAllString(text, "")
+	var patterns, exceptions = texGroup(text, `\patterns`), texGroup(text, `\hyphenation`)
+	if patterns == "" && exceptions == "" {
+		patterns = text
+	}
+	for _, pattern := range strings.Fields(patterns) {
+		h.addPattern(pattern)
+	}
+	for _, word := range strings.Fields(exceptions) {
+		h.addException(word)
+	}
+}
+
+// texGroup возвращает содержимое группы в фигурных скобках после команды TeX.
+func texGroup(text, command string) string {
+	var i = strings.Index(text, command)
+	if i < 0 {
+		return ""
+	}
+	text = text[i+len(command):]
+	var start = strings.IndexByte(text, '{')
+	var end = strings.IndexByte(text, '}')
+	if start < 0 || end < start {
+		return ""
+	}
+	return text[start+1 : end]
+}
+
+// addPattern добавляет шаблон вида "a1b2c" или ".ab1c".
+func (h *hyphenator) addPattern(pattern string) {
+	var letters []rune
+	var points = []int{0}
+	for _, r := range pattern {
+		if r >= '0' && r <= '9' {
+			points[len(points)-1] = int(r - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		points = append(points, 0)
+	}
+	if len(letters) == 0 {
+		return
+	}
+	h.patterns[string(letters)] = points
+	if len(letters) > h.maxLen {
+		h.maxLen = len(letters)
+	}
+}
+
+// addException добавляет слово-исключение с явно указанными переносами:
+// "ta-ble".
+func (h *hyphenator) addException(word string) {
+	var letters []rune
+	var points = []int{0}
+	for _, r := range word {
+		if r == '-' {
+			points[len(points)-1] = 1
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		points = append(points, 0)
+	}
+	h.exceptions[string(letters)] = points
+}
+
+// hyphenate возвращает слово с мягкими переносами.
+func (h *hyphenator) hyphenate(word string) string {
+	var runes = []rune(strings.ToLower(word))
+	if len(runes) < hyphenMinWord {
+		return word
+	}
+	// points[i] содержит вес промежутка перед i-й буквой слова
+	var points, ok = h.exceptions[string(runes)]
+	if !ok {
+		var padded = append(append([]rune{'.'}, runes...), '.')
+		var weights = make([]int, len(padded)+1)
+		for i := range padded {
+			for j := i + 1; j <= len(padded) && j-i <= h.maxLen; j++ {
+				var pattern, ok = h.patterns[string(padded[i:j])]
+				if !ok {
+					continue
+				}
+				for k, weight := range pattern {
+					if weight > weights[i+k] {
+						weights[i+k] = weight
+					}
+				}
+			}
+		}
+		points = weights[1 : len(runes)+2]
+	}
+	var original = []rune(word)
+	var buf strings.Builder
+	for i, r := range original {
+		if i >= h.left && len(runes)-i >= h.right && points[i]%2 == 1 {
+			buf.WriteString(softHyphen)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// hyphenLanguage описывает встроенные шаблоны переноса для языка.
+type hyphenLanguage struct {
+	patterns    string // Шаблоны и исключения в формате TeX
+	left, right int    // Минимальное количество букв до и после переноса
+}
+
+// hyphenLanguages содержит встроенные шаблоны переноса проекта hyph-utf8.
+// Языки с вариантами указываются вместе с регионом, а вариант по умолчанию —
+// только кодом языка.
+var hyphenLanguages = map[string]hyphenLanguage{
+	"en":    {hyphenEnUS, 2, 3},
+	"en-us": {hyphenEnUS, 2, 3},
+	"en-gb": {hyphenEnGB, 2, 3},
+	"de":    {hyphenDE, 2, 2},
+	"fr":    {hyphenFR, 2, 2},
+	"es":    {hyphenES, 2, 2},
+	"ru":    {hyphenRU, 2, 2},
+	"uk":    {hyphenUK, 2, 2},
+	"be":    {hyphenBE, 2, 2},
+}
+
+// hyphenator возвращает расстановщик переносов для языка или nil, если
+// шаблонов для языка нет. Встроенные шаблоны выбираются по языку с регионом,
+// а если для него шаблонов нет — по коду языка. К ним добавляются шаблоны
+// и исключения из файлов, указанных в настройках проекта для кода языка
+// и для языка с регионом.
+func (pub *EPUBCompiler) hyphenator(lang string) (*hyphenator, error) {
+	lang = strings.Replace(strings.ToLower(lang), "_", "-", -1)
+	if h, ok := pub.hyphenators[lang]; ok {
+		return h, nil
+	}
+	var h = newHyphenator()
+	var found bool
+	var builtin, ok = hyphenLanguages[lang]
+	if !ok {
+		builtin, ok = hyphenLanguages[baseLang(lang)]
+	}
+	if ok {
+		h.load(builtin.patterns)
+		h.left, h.right = builtin.left, builtin.right
+		found = true
+	}
+	var files = pub.config.Hyphenation[baseLang(lang)]
+	if lang != baseLang(lang) {
+		files = append(files[:len(files):len(files)], pub.config.Hyphenation[lang]...)
+	}
+	for _, filename := range files {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		h.load(string(data))
+		found = true
+	}
+	if !found {
+		h = nil
+	}
+	if pub.hyphenators == nil {
+		pub.hyphenators = make(map[string]*hyphenator)
+	}
+	pub.hyphenators[lang] = h
+	return h, nil
+}
+
+// hyphenateText расставляет мягкие переносы в тексте страницы, пропуская
+// элементы, соответствующие селекторам из настроек.
+func (h *hyphenator) hyphenateText(parent *html.Node, skip []string) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		switch node.Type {
+		case html.TextNode:
+			node.Data = h.hyphenateWords(node.Data)
+		case html.ElementNode:
+			if !matchSelectors(node, skip) {
+				h.hyphenateText(node, skip)
+			}
+		}
+	}
+}
+
+// hyphenateWords расставляет переносы во всех словах текста.
+func (h *hyphenator) hyphenateWords(text string) string {
+	var buf strings.Builder
+	var word []rune
+	var flush = func() {
+		if len(word) > 0 {
+			buf.WriteString(h.hyphenate(string(word)))
+			word = word[:0]
+		}
+	}
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			word = append(word, r)
+			continue
+		}
+		flush()
+		buf.WriteRune(r)
+	}
+	flush()
+	return buf.String()
+}
+
+// matchSelectors возвращает true, если элемент соответствует одному из простых
+// селекторов CSS: "tag", ".class" или "tag.class".
+func matchSelectors(node *html.Node, selectors []string) bool {
+	for _, selector := range selectors {
+		var tag, class = selector, ""
+		if i := strings.IndexByte(selector, '.'); i >= 0 {
+			tag, class = selector[:i], selector[i+1:]
+		}
+		if tag != "" && tag != node.Data {
+			continue
+		}
+		if class != "" && !hasClass(node, class) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// hasClass возвращает true, если у элемента есть указанный класс.
+func hasClass(node *html.Node, class string) bool {
+	for _, name := range strings.Fields(getAttr(node, "class")) {
+		if name == class {
+			return true
+		}
+	}
+	return false
+}
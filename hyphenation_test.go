@@ -0,0 +1,35 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHyphenate(t *testing.T) {
+	var tests = []struct {
+		lang string
+		word string
+		want string // Переносы отмечены дефисами
+	}{
+		{"en", "hyphenation", "hy-phen-a-tion"},
+		{"en", "computer", "com-puter"},
+		{"en", "cat", "cat"},
+		{"en-GB", "hyphenation", "hy-phen-a-tion"},
+		{"de", "Silbentrennung", "Sil-ben-tren-nung"},
+		{"fr", "dictionnaire", "dic-tion-naire"},
+		{"es", "ordenador", "or-de-na-dor"},
+		{"ru", "перенос", "пе-ре-нос"},
+		{"ru", "электричество", "элек-три-че-ство"},
+	}
+	var pub = &EPUBCompiler{config: DefaultConfig}
+	for _, test := range tests {
+		h, err := pub.hyphenator(test.lang)
+		if err != nil || h == nil {
+			t.Fatalf("hyphenator(%q): %v", test.lang, err)
+		}
+		var got = strings.Replace(h.hyphenate(test.word), softHyphen, "-", -1)
+		if got != test.want {
+			t.Errorf("%s: hyphenate(%q) = %q, want %q", test.lang, test.word, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mdigger/metadata"
+)
+
+// reInclude описывает директиву включения файла, записанную в отдельной строке:
+//
+//	::include[warning.md]
+//	::include[src/main.go]{lines=10-20}
+//	::include[src/main.go]{region=setup lang=go}
+var reInclude = regexp.MustCompile(`^::include\[([^\]]+)\](?:\{([^}]*)\})?\s*$`)
+
+// reIncludeAttr описывает параметр директивы включения: key=value или key="value".
+var reIncludeAttr = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|(\S+))`)
+
+// includeTargets возвращает список файлов, включаемых в указанный файл. Пути
+// возвращаются относительно корня исходных файлов.
+func includeTargets(filename string, data []byte) []string {
+	var result []string
+	scanLines(data, func(line []byte, code bool) {
+		if m := reInclude.FindSubmatch(line); !code && m != nil {
+			result = append(result, filepath.Join(filepath.Dir(filename), string(m[1])))
+		}
+	})
+	return result
+}
+
+// includes заменяет директивы включения содержимым файлов. Пути к файлам
+// указываются относительно включающего их файла. Файлы Markdown включаются
+// целиком без метаданных и обрабатываются рекурсивно, а остальные файлы
+// вставляются как блоки кода. Стек содержит цепочку включающих друг друга
+// файлов и используется для обнаружения циклов.
+func (pub *EPUBCompiler) includes(filename string, data []byte, stack []string) ([]byte, error) {
+	var buf = new(bytes.Buffer)
+	var err error
+	scanLines(data, func(line []byte, code bool) {
+		var m = reInclude.FindSubmatch(line)
+		if err != nil || code || m == nil {
+			buf.Write(line)
+			return
+		}
+		var target = filepath.Join(filepath.Dir(filename), string(m[1]))
+		for _, name := range stack {
+			if name == target {
+				err = fmt.Errorf("%s: include cycle: %s -> %s", filename,
+					strings.Join(stack, " -> "), target)
+				return
+			}
+		}
+		var attrs = make(map[string]string)
+		for _, attr := range reIncludeAttr.FindAllSubmatch(m[2], -1) {
+			attrs[string(attr[1])] = string(attr[2]) + string(attr[3])
+		}
+		var content []byte
+		if isFilename(filepath.Ext(target), pub.config.Markdown) {
+			if _, content, err = metadata.ReadFile(target); err != nil {
+				return
+			}
+			content = pub.config.conditions(content)
+			if content, err = pub.includes(target, content, append(stack, target)); err != nil {
+				return
+			}
+		} else if content, err = includeCode(target, attrs); err != nil {
+			return
+		}
+		buf.Write(content)
+		if len(content) > 0 && content[len(content)-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reAnchor описывает строку, отмечающую начало или конец именованного
+// фрагмента исходного кода: "// ANCHOR: name" и "// ANCHOR_END: name".
+var reAnchor = regexp.MustCompile(`ANCHOR(_END)?:\s*([\w-]+)`)
+
+// includeCode возвращает фрагмент файла с исходным кодом в виде блока кода.
+// Параметр lines задает диапазон строк (10-20, 10- или 10), region — название
+// фрагмента, а lang — язык, если он отличается от определяемого по расширению.
+func includeCode(filename string, attrs map[string]string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var lines = strings.SplitAfter(string(data), "\n")
+	if region := attrs["region"]; region != "" {
+		var start, end = -1, -1
+		for i, line := range lines {
+			if m := reAnchor.FindStringSubmatch(line); m != nil && m[2] == region {
+				if m[1] == "" && start < 0 {
+					start = i + 1
+				} else if m[1] != "" && start >= 0 {
+					end = i
+					break
+				}
+			}
+		}
+		if start < 0 {
+			return nil, fmt.Errorf("%s: region %q not found", filename, region)
+		}
+		if end < 0 {
+			end = len(lines)
+		}
+		lines = lines[start:end]
+	}
+	if spec := attrs["lines"]; spec != "" {
+		var from, to = spec, spec
+		if i := strings.IndexByte(spec, '-'); i >= 0 {
+			from, to = spec[:i], spec[i+1:]
+		}
+		start, err := strconv.Atoi(from)
+		if err != nil || start < 1 {
+			return nil, fmt.Errorf("%s: bad lines range %q", filename, spec)
+		}
+		var end = len(lines)
+		if to != "" {
+			if end, err = strconv.Atoi(to); err != nil || end < start {
+				return nil, fmt.Errorf("%s: bad lines range %q", filename, spec)
+			}
+		}
+		if start > len(lines) {
+			start = len(lines) + 1
+		}
+		if end > len(lines) {
+			end = len(lines)
+		}
+		lines = lines[start-1 : end]
+	}
+	// Убираем строки с отметками фрагментов
+	var code strings.Builder
+	for _, line := range lines {
+		if !reAnchor.MatchString(line) {
+			code.WriteString(line)
+		}
+	}
+	var lang = attrs["lang"]
+	if lang == "" {
+		lang = codeLanguage(filename)
+	}
+	// Ограничитель блока должен быть длиннее любой последовательности обратных
+	// кавычек внутри кода
+	var fence = "```"
+	for strings.Contains(code.String(), fence) {
+		fence += "`"
+	}
+	var result = fence + lang + "\n" + strings.TrimRight(code.String(), "\n") + "\n" + fence + "\n"
+	return []byte(result), nil
+}
+
+// codeLanguage возвращает язык программирования по расширению файла.
+func codeLanguage(filename string) string {
+	var ext = strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), "."))
+	switch ext {
+	case "py":
+		return "python"
+	case "js", "mjs":
+		return "javascript"
+	case "ts":
+		return "typescript"
+	case "rs":
+		return "rust"
+	case "hpp", "cc", "cxx":
+		return "cpp"
+	case "yml":
+		return "yaml"
+	}
+	return ext
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// messages содержит локализованные строки, используемые при формировании
+// публикации. Строки сгруппированы по языкам, язык определяется по основному
+// тегу без уточнения региона.
+var messages = map[string]map[string]string{
+	"en": {
+		"figure":         "Figure",
+		"note":           "Note",
+		"tip":            "Tip",
+		"important":      "Important",
+		"warning":        "Warning",
+		"caution":        "Caution",
+		"danger":         "Danger",
+		"draft":          "DRAFT",
+		"titlepage":      "Title Page",
+		"copyright-page": "Copyright",
+		"publisher":      "Publisher",
+		"published":      "Published",
+		"isbn":           "ISBN",
+		"contributor":    "Contributors",
+		"translator":     "Translated by",
+		"editor":         "Edited by",
+		"illustrator":    "Illustrations",
+		"designer":       "Design",
+		"buildtime":      "Build",
+		"cover":          "Cover",
+		"label-chapter":  "Chapter %s",
+		"label-part":     "Part %s",
+		"label-appendix": "Appendix %s",
+	},
+	"ru": {
+		"figure":         "Рисунок",
+		"note":           "Примечание",
+		"tip":            "Совет",
+		"important":      "Важно",
+		"warning":        "Внимание",
+		"caution":        "Осторожно",
+		"danger":         "Опасно",
+		"draft":          "ЧЕРНОВИК",
+		"titlepage":      "Титульный лист",
+		"copyright-page": "Выходные данные",
+		"publisher":      "Издательство",
+		"published":      "Дата издания",
+		"isbn":           "ISBN",
+		"contributor":    "Участники",
+		"translator":     "Перевод",
+		"editor":         "Редактор",
+		"illustrator":    "Иллюстрации",
+		"designer":       "Оформление",
+		"buildtime":      "Сборка",
+		"cover":          "Обложка",
+		"label-chapter":  "Глава %s",
+		"label-part":     "Часть %s",
+		"label-appendix": "Приложение %s",
+	},
+	"uk": {
+		"figure":         "Рисунок",
+		"note":           "Примітка",
+		"tip":            "Порада",
+		"important":      "Важливо",
+		"warning":        "Увага",
+		"caution":        "Обережно",
+		"danger":         "Небезпечно",
+		"draft":          "ЧЕРНЕТКА",
+		"titlepage":      "Титульна сторінка",
+		"copyright-page": "Вихідні дані",
+		"publisher":      "Видавництво",
+		"published":      "Дата видання",
+		"isbn":           "ISBN",
+		"contributor":    "Учасники",
+		"translator":     "Переклад",
+		"editor":         "Редактор",
+		"illustrator":    "Ілюстрації",
+		"designer":       "Оформлення",
+		"buildtime":      "Збірка",
+		"cover":          "Обкладинка",
+		"label-chapter":  "Глава %s",
+		"label-part":     "Частина %s",
+		"label-appendix": "Додаток %s",
+	},
+	"de": {
+		"figure":         "Abbildung",
+		"note":           "Hinweis",
+		"tip":            "Tipp",
+		"important":      "Wichtig",
+		"warning":        "Warnung",
+		"caution":        "Vorsicht",
+		"danger":         "Gefahr",
+		"draft":          "ENTWURF",
+		"titlepage":      "Titelseite",
+		"copyright-page": "Impressum",
+		"publisher":      "Verlag",
+		"published":      "Erschienen",
+		"isbn":           "ISBN",
+		"contributor":    "Mitwirkende",
+		"translator":     "Übersetzung",
+		"editor":         "Lektorat",
+		"illustrator":    "Illustrationen",
+		"designer":       "Gestaltung",
+		"buildtime":      "Build",
+		"cover":          "Umschlag",
+		"label-chapter":  "Kapitel %s",
+		"label-part":     "Teil %s",
+		"label-appendix": "Anhang %s",
+	},
+	"fr": {
+		"figure":         "Figure",
+		"note":           "Remarque",
+		"tip":            "Astuce",
+		"important":      "Important",
+		"warning":        "Avertissement",
+		"caution":        "Attention",
+		"danger":         "Danger",
+		"draft":          "BROUILLON",
+		"titlepage":      "Page de titre",
+		"copyright-page": "Mentions légales",
+		"publisher":      "Éditeur",
+		"published":      "Date de publication",
+		"isbn":           "ISBN",
+		"contributor":    "Contributeurs",
+		"translator":     "Traduction",
+		"editor":         "Édition",
+		"illustrator":    "Illustrations",
+		"designer":       "Conception graphique",
+		"buildtime":      "Compilation",
+		"cover":          "Couverture",
+		"label-chapter":  "Chapitre %s",
+		"label-part":     "Partie %s",
+		"label-appendix": "Annexe %s",
+	},
+	"es": {
+		"figure":         "Figura",
+		"note":           "Nota",
+		"tip":            "Consejo",
+		"important":      "Importante",
+		"warning":        "Advertencia",
+		"caution":        "Precaución",
+		"danger":         "Peligro",
+		"draft":          "BORRADOR",
+		"titlepage":      "Página de título",
+		"copyright-page": "Créditos",
+		"publisher":      "Editorial",
+		"published":      "Fecha de publicación",
+		"isbn":           "ISBN",
+		"contributor":    "Colaboradores",
+		"translator":     "Traducción",
+		"editor":         "Edición a cargo de",
+		"illustrator":    "Ilustraciones",
+		"designer":       "Diseño",
+		"buildtime":      "Compilación",
+		"cover":          "Portada",
+		"label-chapter":  "Capítulo %s",
+		"label-part":     "Parte %s",
+		"label-appendix": "Apéndice %s",
+	},
+}
+
+// baseLang возвращает основной тег языка: для "ru-RU" вернется "ru".
+func baseLang(lang string) string {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+// localize возвращает строку на указанном языке. Если перевода нет, то
+// возвращается английский вариант, а если нет и его — сам ключ.
+func localize(lang, key string) string {
+	if msg, ok := messages[baseLang(lang)][key]; ok {
+		return msg
+	}
+	if msg, ok := messages["en"][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// months содержит названия месяцев по языкам в форме, используемой в датах.
+var months = map[string][12]string{
+	"en": {"January", "February", "March", "April", "May", "June", "July", "August",
+		"September", "October", "November", "December"},
+	"ru": {"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа",
+		"сентября", "октября", "ноября", "декабря"},
+	"uk": {"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня",
+		"вересня", "жовтня", "листопада", "грудня"},
+	"de": {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August",
+		"September", "Oktober", "November", "Dezember"},
+	"fr": {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août",
+		"septembre", "octobre", "novembre", "décembre"},
+	"es": {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto",
+		"septiembre", "octubre", "noviembre", "diciembre"},
+}
+
+// dateFormats содержит формат полной даты по языкам: %d — день, %m — название
+// месяца, %y — год.
+var dateFormats = map[string]string{
+	"en": "%m %d, %y",
+	"ru": "%d %m %y г.",
+	"uk": "%d %m %y р.",
+	"de": "%d. %m %y",
+	"fr": "%d %m %y",
+	"es": "%d de %m de %y",
+}
+
+// formatDate возвращает дату в принятом для языка виде. Для неподдерживаемых
+// языков используется английский вариант.
+func formatDate(t time.Time, lang string) string {
+	lang = baseLang(lang)
+	if _, ok := months[lang]; !ok {
+		lang = "en"
+	}
+	return strings.NewReplacer(
+		"%d", strconv.Itoa(t.Day()),
+		"%m", months[lang][t.Month()-1],
+		"%y", strconv.Itoa(t.Year()),
+	).Replace(dateFormats[lang])
+}
@@ -4,11 +4,25 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 )
 
 func main() {
 	// Разбираем входящие параметры
+	var config = *DefaultConfig
+	flag.StringVar(&config.CriticMarkup, "critic", config.CriticMarkup,
+		"CriticMarkup changes: accept, reject or show")
+	flag.BoolVar(&config.Draft, "draft", config.Draft,
+		"draft build: include draft chapters and TODO markers")
+	flag.StringVar(&config.Target, "target", config.Target,
+		"build target for conditional content: kindle, kobo, print")
+	flag.Var((*listFlag)(&config.Flags), "flag",
+		"flag for conditional content (may be repeated)")
 	flag.Parse()
+	// Запоминаем явно заданные параметры, чтобы их не переопределили
+	// настройки проекта
+	config.Explicit = make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { config.Explicit[f.Name] = true })
 	if flag.NArg() < 1 {
 		flag.Usage()
 		os.Exit(1)
@@ -27,8 +41,19 @@ func main() {
 		outputFilename = sourcePath + ".epub"
 	}
 	// Запускаем компиляцию исходников
-	if err := Compile(sourcePath, outputFilename, DefaultConfig); err != nil {
+	if err := Compile(sourcePath, outputFilename, &config); err != nil {
 		fmt.Println(err)
 		os.Exit(2)
 	}
 }
+
+// listFlag описывает параметр командной строки, который можно указать
+// несколько раз или перечислить значения через запятую.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, strings.Split(value, ",")...)
+	return nil
+}
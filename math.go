@@ -0,0 +1,577 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// math заменяет в исходном тексте формулы в формате TeX ($...$ и $$...$$) на
+// директивы, чтобы Markdown не испортил их разметку. Формулы внутри блоков кода,
+// в том числе выделенных отступом, и строчного кода не обрабатываются.
+func (d *directives) math(data []byte) []byte {
+	var buf = new(bytes.Buffer)
+	var text []byte // Накопленный текст вне блоков кода
+	var flush = func() {
+		buf.Write(d.inlineMath(text))
+		text = text[:0]
+	}
+	scanCodeLines(data, func(line []byte, code bool) {
+		if code {
+			flush()
+			buf.Write(line)
+			return
+		}
+		text = append(text, line...)
+	})
+	flush()
+	return buf.Bytes()
+}
+
+// inlineMath заменяет формулы в тексте, не содержащем блоков кода.
+func (d *directives) inlineMath(data []byte) []byte {
+	var buf = new(bytes.Buffer)
+	for i := 0; i < len(data); {
+		switch ch := data[i]; {
+		case ch == '\\' && i+1 < len(data):
+			// Экранированный символ
+			buf.Write(data[i : i+2])
+			i += 2
+		case ch == '`':
+			// Строчный код копируем без изменений
+			var n = 1
+			for i+n < len(data) && data[i+n] == '`' {
+				n++
+			}
+			var fence = data[i : i+n]
+			var end = bytes.Index(data[i+n:], fence)
+			if end < 0 {
+				buf.Write(fence)
+				i += n
+				break
+			}
+			end += i + n*2
+			buf.Write(data[i:end])
+			i = end
+		case ch == '$' && bytes.HasPrefix(data[i:], []byte("$$")):
+			var end = bytes.Index(data[i+2:], []byte("$$"))
+			if end < 0 {
+				buf.WriteString("$$")
+				i += 2
+				break
+			}
+			var tex = strings.TrimSpace(string(data[i+2 : i+2+end]))
+			buf.WriteString(d.inline(d.add(displayMathDirective, tex, false)))
+			i += end + 4
+		case ch == '$':
+			// Открывающий символ не может быть перед пробелом, закрывающий —
+			// после пробела и перед цифрой.
+			var end = -1
+			if i+1 < len(data) && !isSpace(data[i+1]) {
+				for j := i + 1; j < len(data); j++ {
+					if data[j] == '\\' {
+						j++
+						continue
+					}
+					if data[j] == '\n' && j+1 < len(data) && data[j+1] == '\n' {
+						break // Формула не может выходить за пределы абзаца
+					}
+					if data[j] == '$' {
+						// Неэкранированный символ внутри формулы означает, что
+						// это не формула, а, например, цены: $5 и $10
+						if !isSpace(data[j-1]) &&
+							(j+1 == len(data) || data[j+1] < '0' || data[j+1] > '9') {
+							end = j
+						}
+						break
+					}
+				}
+			}
+			if end < 0 {
+				buf.WriteByte('$')
+				i++
+				break
+			}
+			buf.WriteString(d.inline(d.add(mathDirective, string(data[i+1:end]), false)))
+			i = end + 1
+		default:
+			buf.WriteByte(ch)
+			i++
+		}
+	}
+	return buf.Bytes()
+}
+
+// isSpace возвращает true для пробельных символов.
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+// has возвращает true, если в списке есть директива с указанным именем.
+func (d directives) has(names ...string) bool {
+	for _, dir := range d {
+		for _, name := range names {
+			if dir.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mathML преобразует формулу в формате TeX в элемент MathML. Исходный текст
+// формулы сохраняется в атрибуте alttext и в аннотации, которые используются
+// читалками без поддержки MathML.
+func mathML(tex string, display bool) *html.Node {
+	var p = new(texParser)
+	p.tokens, p.spaces = texTokens(tex)
+	var math = newElement("math", "xmlns", "http://www.w3.org/1998/Math/MathML",
+		"alttext", tex)
+	if display {
+		setAttr(math, "display", "block")
+	}
+	var list []*html.Node
+	for p.peek() != "" {
+		list = append(list, p.parseList("")...)
+		p.next() // Пропускаем непарные закрывающие лексемы
+	}
+	var annotation = newElement("annotation", "encoding", "application/x-tex")
+	annotation.AppendChild(&html.Node{Type: html.TextNode, Data: tex})
+	math.AppendChild(mathElem("semantics", mathRow(list), annotation))
+	return math
+}
+
+// texTokens разбивает формулу на лексемы: команды, числа, отдельные символы.
+// Пробелы между лексемами отбрасываются, но для каждой лексемы отмечается,
+// был ли перед ней пробел: в тексте команды \text он сохраняется.
+func texTokens(tex string) (tokens []string, spaces []bool) {
+	var space = false
+	for i := 0; i < len(tex); {
+		r, size := utf8.DecodeRuneInString(tex[i:])
+		if unicode.IsSpace(r) {
+			space = true
+			i += size
+			continue
+		}
+		spaces = append(spaces, space)
+		space = false
+		switch {
+		case r == '\\' && i+1 < len(tex):
+			var j = i + 1
+			for j < len(tex) && (tex[j] >= 'a' && tex[j] <= 'z' || tex[j] >= 'A' && tex[j] <= 'Z') {
+				j++
+			}
+			if j == i+1 {
+				j++ // Команда из одного символа: \, \{ \\
+			}
+			tokens = append(tokens, tex[i:j])
+			i = j
+		case r >= '0' && r <= '9':
+			var j = i + 1
+			for j < len(tex) && (tex[j] >= '0' && tex[j] <= '9' ||
+				tex[j] == '.' && j+1 < len(tex) && tex[j+1] >= '0' && tex[j+1] <= '9') {
+				j++
+			}
+			tokens = append(tokens, tex[i:j])
+			i = j
+		default:
+			tokens = append(tokens, tex[i:i+size])
+			i += size
+		}
+	}
+	return tokens, spaces
+}
+
+// texParser преобразует лексемы формулы TeX в дерево MathML.
+type texParser struct {
+	tokens []string
+	spaces []bool // Отметки пробелов перед лексемами
+	pos    int
+}
+
+// next возвращает следующую лексему и сдвигает позицию.
+func (p *texParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	p.pos++
+	return p.tokens[p.pos-1]
+}
+
+// peek возвращает следующую лексему без сдвига позиции.
+func (p *texParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+// parseList разбирает последовательность элементов до указанной лексемы или
+// до конца группы.
+func (p *texParser) parseList(until string) []*html.Node {
+	var list []*html.Node
+	for {
+		switch tok := p.peek(); {
+		case tok == "", tok == "}", tok == until, tok == `\right`,
+			tok == "&", tok == `\\`, tok == `\end`:
+			return list
+		}
+		list = append(list, p.parseScripts(p.parseAtom()))
+	}
+}
+
+// parseGroup разбирает аргумент команды: группу в фигурных скобках или
+// отдельный элемент.
+func (p *texParser) parseGroup() *html.Node {
+	if p.peek() == "{" {
+		p.next()
+		var list = p.parseList("")
+		if p.peek() == "}" {
+			p.next()
+		}
+		return mathRow(list)
+	}
+	if p.peek() == "" {
+		return mathElem("mrow")
+	}
+	return p.parseAtom()
+}
+
+// parseText возвращает текст аргумента команды без разбора.
+func (p *texParser) parseText() string {
+	if p.peek() != "{" {
+		return p.next()
+	}
+	p.next()
+	var text []string
+	for depth := 0; p.peek() != ""; {
+		if p.spaces[p.pos] {
+			text = append(text, " ")
+		}
+		var tok = p.next()
+		switch tok {
+		case "{":
+			depth++
+		case "}":
+			if depth == 0 {
+				return textSpaces(strings.Join(text, ""))
+			}
+			depth--
+		}
+		if strings.HasPrefix(tok, `\`) && len(tok) == 2 {
+			tok = tok[1:] // \{ \} \_
+		}
+		text = append(text, tok)
+	}
+	return textSpaces(strings.Join(text, ""))
+}
+
+// textSpaces заменяет пробелы в начале и в конце текста неразрывными: иначе
+// читалка отбросит их, как того требует MathML.
+func textSpaces(text string) string {
+	var trimmed = strings.TrimLeft(text, " ")
+	text = strings.Repeat(nbsp, len(text)-len(trimmed)) + trimmed
+	trimmed = strings.TrimRight(text, " ")
+	return trimmed + strings.Repeat(nbsp, len(text)-len(trimmed))
+}
+
+// parseScripts добавляет к элементу верхний и нижний индексы, если они есть.
+func (p *texParser) parseScripts(base *html.Node) *html.Node {
+	var sub, sup *html.Node
+	for {
+		switch p.peek() {
+		case "_":
+			p.next()
+			sub = p.parseGroup()
+			continue
+		case "^":
+			p.next()
+			sup = p.parseGroup()
+			continue
+		case "'":
+			p.next()
+			sup = mathText("mo", "′")
+			continue
+		}
+		break
+	}
+	// Пределы у больших операторов располагаются над и под ними
+	var under = base.Data == "mo" && getAttr(base, "movablelimits") == "true"
+	switch {
+	case sub != nil && sup != nil && under:
+		return mathElem("munderover", base, sub, sup)
+	case sub != nil && sup != nil:
+		return mathElem("msubsup", base, sub, sup)
+	case sub != nil && under:
+		return mathElem("munder", base, sub)
+	case sub != nil:
+		return mathElem("msub", base, sub)
+	case sup != nil && under:
+		return mathElem("mover", base, sup)
+	case sup != nil:
+		return mathElem("msup", base, sup)
+	}
+	return base
+}
+
+// parseAtom разбирает отдельный элемент формулы.
+func (p *texParser) parseAtom() *html.Node {
+	var tok = p.next()
+	switch {
+	case tok == "{":
+		p.pos--
+		return p.parseGroup()
+	case tok[0] >= '0' && tok[0] <= '9':
+		return mathText("mn", tok)
+	case tok[0] != '\\':
+		r, _ := utf8.DecodeRuneInString(tok)
+		if unicode.IsLetter(r) {
+			return mathText("mi", tok)
+		}
+		if tok == "-" {
+			tok = "−"
+		}
+		return mathText("mo", tok)
+	}
+	switch tok {
+	case `\frac`, `\dfrac`, `\tfrac`:
+		var num = p.parseGroup()
+		return mathElem("mfrac", num, p.parseGroup())
+	case `\sqrt`:
+		if p.peek() == "[" {
+			p.next()
+			var index = mathRow(p.parseList("]"))
+			p.next()
+			return mathElem("mroot", p.parseGroup(), index)
+		}
+		return mathElem("msqrt", p.parseGroup())
+	case `\left`:
+		return p.parseFenced()
+	case `\text`, `\mbox`, `\textrm`:
+		return mathText("mtext", p.parseText())
+	case `\mathrm`, `\operatorname`, `\mathbf`, `\mathit`, `\mathbb`, `\mathcal`, `\mathsf`, `\mathtt`:
+		var elem = mathText("mi", p.parseText())
+		setAttr(elem, "mathvariant", mathVariants[tok])
+		return elem
+	case `\begin`:
+		return p.parseEnvironment(p.parseText())
+	}
+	if accent, ok := mathAccents[tok]; ok {
+		var base = p.parseGroup()
+		if tok == `\underline` {
+			return mathElem("munder", base, mathText("mo", accent))
+		}
+		var elem = mathElem("mover", base, mathText("mo", accent))
+		setAttr(elem, "accent", "true")
+		return elem
+	}
+	if width, ok := mathSpaces[tok]; ok {
+		return newElement("mspace", "width", width)
+	}
+	if sym, ok := mathSymbols[tok]; ok {
+		var elem = mathText(sym.tag, sym.text)
+		switch {
+		case sym.largeop:
+			setAttr(elem, "movablelimits", "true")
+		case sym.tag == "mi" && utf8.RuneCountInString(sym.text) == 1 &&
+			unicode.IsUpper([]rune(sym.text)[0]):
+			setAttr(elem, "mathvariant", "normal")
+		}
+		return elem
+	}
+	if len(tok) == 2 {
+		return mathText("mo", tok[1:]) // Экранированный символ: \{ \% \$
+	}
+	// Неизвестные команды выводим как есть
+	return mathText("mtext", tok)
+}
+
+// parseFenced разбирает выражение в скобках \left( ... \right).
+func (p *texParser) parseFenced() *html.Node {
+	var list []*html.Node
+	if open := fenceDelimiter(p.next()); open != "" {
+		list = append(list, fence(open))
+	}
+	list = append(list, p.parseList("")...)
+	if p.peek() == `\right` {
+		p.next()
+		if close := fenceDelimiter(p.next()); close != "" {
+			list = append(list, fence(close))
+		}
+	}
+	return mathRow(list)
+}
+
+// parseEnvironment разбирает окружения matrix, pmatrix, bmatrix, cases,
+// aligned в виде таблицы MathML.
+func (p *texParser) parseEnvironment(name string) *html.Node {
+	var table = mathElem("mtable")
+	var row = mathElem("mtr")
+	for {
+		var cell = mathElem("mtd")
+		for _, node := range p.parseList("") {
+			cell.AppendChild(node)
+		}
+		row.AppendChild(cell)
+		switch p.next() {
+		case "&":
+			continue
+		case `\\`:
+			table.AppendChild(row)
+			row = mathElem("mtr")
+			continue
+		case `\end`:
+			p.parseText()
+		}
+		break
+	}
+	if row.FirstChild != nil {
+		table.AppendChild(row)
+	}
+	switch name {
+	case "pmatrix":
+		return mathElem("mrow", fence("("), table, fence(")"))
+	case "bmatrix":
+		return mathElem("mrow", fence("["), table, fence("]"))
+	case "vmatrix":
+		return mathElem("mrow", fence("|"), table, fence("|"))
+	case "cases":
+		setAttr(table, "columnalign", "left")
+		return mathElem("mrow", fence("{"), table)
+	case "aligned", "align", "align*":
+		setAttr(table, "columnalign", "right left")
+	}
+	return table
+}
+
+// fenceDelimiter возвращает символ скобки для \left и \right.
+func fenceDelimiter(tok string) string {
+	switch tok {
+	case ".", "":
+		return ""
+	case `\{`, `\lbrace`:
+		return "{"
+	case `\}`, `\rbrace`:
+		return "}"
+	case `\langle`:
+		return "⟨"
+	case `\rangle`:
+		return "⟩"
+	case `\|`:
+		return "‖"
+	}
+	return tok
+}
+
+// fence возвращает элемент растягиваемой скобки.
+func fence(text string) *html.Node {
+	var elem = mathText("mo", text)
+	setAttr(elem, "fence", "true")
+	return elem
+}
+
+// mathElem возвращает элемент MathML с указанными дочерними элементами.
+func mathElem(tag string, children ...*html.Node) *html.Node {
+	var elem = newElement(tag)
+	for _, child := range children {
+		elem.AppendChild(child)
+	}
+	return elem
+}
+
+// mathRow объединяет несколько элементов в mrow. Единственный элемент
+// возвращается без изменений.
+func mathRow(list []*html.Node) *html.Node {
+	if len(list) == 1 {
+		return list[0]
+	}
+	return mathElem("mrow", list...)
+}
+
+// mathText возвращает элемент MathML с текстом.
+func mathText(tag, text string) *html.Node {
+	return mathElem(tag, &html.Node{Type: html.TextNode, Data: text})
+}
+
+// mathSymbol описывает символ, задаваемый командой TeX.
+type mathSymbol struct {
+	tag     string // Элемент MathML: mi или mo
+	text    string // Символ
+	largeop bool   // Большой оператор с пределами
+}
+
+// mathSymbols содержит поддерживаемые команды TeX, обозначающие символы.
+var mathSymbols = map[string]mathSymbol{
+	`\alpha`: {"mi", "α", false}, `\beta`: {"mi", "β", false}, `\gamma`: {"mi", "γ", false},
+	`\delta`: {"mi", "δ", false}, `\epsilon`: {"mi", "ϵ", false}, `\varepsilon`: {"mi", "ε", false},
+	`\zeta`: {"mi", "ζ", false}, `\eta`: {"mi", "η", false}, `\theta`: {"mi", "θ", false},
+	`\vartheta`: {"mi", "ϑ", false}, `\iota`: {"mi", "ι", false}, `\kappa`: {"mi", "κ", false},
+	`\lambda`: {"mi", "λ", false}, `\mu`: {"mi", "μ", false}, `\nu`: {"mi", "ν", false},
+	`\xi`: {"mi", "ξ", false}, `\pi`: {"mi", "π", false}, `\varpi`: {"mi", "ϖ", false},
+	`\rho`: {"mi", "ρ", false}, `\sigma`: {"mi", "σ", false}, `\varsigma`: {"mi", "ς", false},
+	`\tau`: {"mi", "τ", false}, `\upsilon`: {"mi", "υ", false}, `\phi`: {"mi", "ϕ", false},
+	`\varphi`: {"mi", "φ", false}, `\chi`: {"mi", "χ", false}, `\psi`: {"mi", "ψ", false},
+	`\omega`: {"mi", "ω", false}, `\Gamma`: {"mi", "Γ", false}, `\Delta`: {"mi", "Δ", false},
+	`\Theta`: {"mi", "Θ", false}, `\Lambda`: {"mi", "Λ", false}, `\Xi`: {"mi", "Ξ", false},
+	`\Pi`: {"mi", "Π", false}, `\Sigma`: {"mi", "Σ", false}, `\Upsilon`: {"mi", "Υ", false},
+	`\Phi`: {"mi", "Φ", false}, `\Psi`: {"mi", "Ψ", false}, `\Omega`: {"mi", "Ω", false},
+	`\infty`: {"mi", "∞", false}, `\partial`: {"mi", "∂", false}, `\nabla`: {"mi", "∇", false},
+	`\emptyset`: {"mi", "∅", false}, `\ell`: {"mi", "ℓ", false}, `\hbar`: {"mi", "ℏ", false},
+	`\sin`: {"mi", "sin", false}, `\cos`: {"mi", "cos", false}, `\tan`: {"mi", "tan", false},
+	`\cot`: {"mi", "cot", false}, `\arcsin`: {"mi", "arcsin", false}, `\arccos`: {"mi", "arccos", false},
+	`\arctan`: {"mi", "arctan", false}, `\sinh`: {"mi", "sinh", false}, `\cosh`: {"mi", "cosh", false},
+	`\tanh`: {"mi", "tanh", false}, `\log`: {"mi", "log", false}, `\ln`: {"mi", "ln", false},
+	`\lg`: {"mi", "lg", false}, `\exp`: {"mi", "exp", false}, `\det`: {"mi", "det", false},
+	`\dim`: {"mi", "dim", false}, `\deg`: {"mi", "deg", false}, `\gcd`: {"mi", "gcd", false},
+	`\lim`: {"mo", "lim", true}, `\max`: {"mo", "max", true}, `\min`: {"mo", "min", true},
+	`\sup`: {"mo", "sup", true}, `\inf`: {"mo", "inf", true},
+	`\sum`: {"mo", "∑", true}, `\prod`: {"mo", "∏", true}, `\coprod`: {"mo", "∐", true},
+	`\bigcup`: {"mo", "⋃", true}, `\bigcap`: {"mo", "⋂", true},
+	`\int`: {"mo", "∫", false}, `\iint`: {"mo", "∬", false}, `\iiint`: {"mo", "∭", false},
+	`\oint`: {"mo", "∮", false},
+	`\pm`:   {"mo", "±", false}, `\mp`: {"mo", "∓", false}, `\times`: {"mo", "×", false},
+	`\div`: {"mo", "÷", false}, `\cdot`: {"mo", "⋅", false}, `\ast`: {"mo", "∗", false},
+	`\circ`: {"mo", "∘", false}, `\bullet`: {"mo", "∙", false}, `\cdots`: {"mo", "⋯", false},
+	`\ldots`: {"mo", "…", false}, `\dots`: {"mo", "…", false}, `\vdots`: {"mo", "⋮", false},
+	`\ddots`: {"mo", "⋱", false}, `\leq`: {"mo", "≤", false}, `\le`: {"mo", "≤", false},
+	`\geq`: {"mo", "≥", false}, `\ge`: {"mo", "≥", false}, `\neq`: {"mo", "≠", false},
+	`\ne`: {"mo", "≠", false}, `\approx`: {"mo", "≈", false}, `\equiv`: {"mo", "≡", false},
+	`\sim`: {"mo", "∼", false}, `\simeq`: {"mo", "≃", false}, `\cong`: {"mo", "≅", false},
+	`\propto`: {"mo", "∝", false}, `\ll`: {"mo", "≪", false}, `\gg`: {"mo", "≫", false},
+	`\in`: {"mo", "∈", false}, `\notin`: {"mo", "∉", false}, `\ni`: {"mo", "∋", false},
+	`\subset`: {"mo", "⊂", false}, `\supset`: {"mo", "⊃", false}, `\subseteq`: {"mo", "⊆", false},
+	`\supseteq`: {"mo", "⊇", false}, `\cup`: {"mo", "∪", false}, `\cap`: {"mo", "∩", false},
+	`\setminus`: {"mo", "∖", false}, `\forall`: {"mo", "∀", false}, `\exists`: {"mo", "∃", false},
+	`\neg`: {"mo", "¬", false}, `\lnot`: {"mo", "¬", false}, `\wedge`: {"mo", "∧", false},
+	`\land`: {"mo", "∧", false}, `\vee`: {"mo", "∨", false}, `\lor`: {"mo", "∨", false},
+	`\to`: {"mo", "→", false}, `\rightarrow`: {"mo", "→", false}, `\leftarrow`: {"mo", "←", false},
+	`\gets`: {"mo", "←", false}, `\leftrightarrow`: {"mo", "↔", false}, `\Rightarrow`: {"mo", "⇒", false},
+	`\Leftarrow`: {"mo", "⇐", false}, `\Leftrightarrow`: {"mo", "⇔", false}, `\implies`: {"mo", "⟹", false},
+	`\iff`: {"mo", "⟺", false}, `\mapsto`: {"mo", "↦", false}, `\perp`: {"mo", "⊥", false},
+	`\parallel`: {"mo", "∥", false}, `\angle`: {"mo", "∠", false}, `\degree`: {"mo", "°", false},
+	`\langle`: {"mo", "⟨", false}, `\rangle`: {"mo", "⟩", false}, `\lfloor`: {"mo", "⌊", false},
+	`\rfloor`: {"mo", "⌋", false}, `\lceil`: {"mo", "⌈", false}, `\rceil`: {"mo", "⌉", false},
+	`\mid`: {"mo", "∣", false}, `\|`: {"mo", "‖", false},
+}
+
+// mathAccents содержит команды надстрочных и подстрочных знаков.
+var mathAccents = map[string]string{
+	`\hat`: "^", `\widehat`: "^", `\bar`: "¯", `\overline`: "¯", `\underline`: "_",
+	`\vec`: "→", `\dot`: "˙", `\ddot`: "¨", `\tilde`: "~", `\widetilde`: "~",
+}
+
+// mathSpaces содержит команды пробелов и соответствующую им ширину.
+var mathSpaces = map[string]string{
+	`\,`: "0.167em", `\:`: "0.222em", `\;`: "0.278em", `\!`: "-0.167em",
+	`\ `: "0.333em", `\quad`: "1em", `\qquad`: "2em",
+}
+
+// mathVariants содержит команды выбора начертания.
+var mathVariants = map[string]string{
+	`\mathrm`: "normal", `\operatorname`: "normal", `\mathbf`: "bold",
+	`\mathit`: "italic", `\mathbb`: "double-struck", `\mathcal`: "script",
+	`\mathsf`: "sans-serif", `\mathtt`: "monospace",
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestMathML(t *testing.T) {
+	var tests = []struct {
+		tex  string
+		want string // Содержимое semantics без аннотации
+	}{
+		{`x^2`, `<msup><mi>x</mi><mn>2</mn></msup>`},
+		{`a_i^2`, `<msubsup><mi>a</mi><mi>i</mi><mn>2</mn></msubsup>`},
+		{`\frac{a}{b}`, `<mfrac><mi>a</mi><mi>b</mi></mfrac>`},
+		{`\sqrt{x}`, `<msqrt><mi>x</mi></msqrt>`},
+		{`\alpha + 1.5`, `<mrow><mi>α</mi><mo>+</mo><mn>1.5</mn></mrow>`},
+		{`\left( x \right)`, `<mrow><mo fence="true">(</mo><mi>x</mi><mo fence="true">)</mo></mrow>`},
+		{`\mathrm{d}x`, `<mrow><mi mathvariant="normal">d</mi><mi>x</mi></mrow>`},
+		{`\text{if } x`, "<mrow><mtext>if </mtext><mi>x</mi></mrow>"},
+		{`\begin{matrix} a & b \\ c & d \end{matrix}`,
+			`<mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr>` +
+				`<mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr></mtable>`},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := html.Render(&buf, mathML(test.tex, false)); err != nil {
+			t.Fatal(err)
+		}
+		var got = buf.String()
+		got = got[strings.Index(got, "<semantics>")+len("<semantics>") : strings.Index(got, "<annotation")]
+		if got != test.want {
+			t.Errorf("mathML(%q) = %s, want %s", test.tex, got, test.want)
+		}
+	}
+}
+
+func TestMathDirectives(t *testing.T) {
+	var tests = []struct {
+		text string
+		want string
+	}{
+		{"Формула $x$.\n", "Формула <!--md2epub:0-->.\n"},
+		{"$$\nx\n$$\n", "<!--md2epub:0-->\n"},
+		// Формулы в коде не обрабатываются
+		{"Код `$x$` и $5.\n", "Код `$x$` и $5.\n"},
+		{"```\n$x$\n```\n", "```\n$x$\n```\n"},
+		{"Текст\n\n    $x$\n", "Текст\n\n    $x$\n"},
+	}
+	for _, test := range tests {
+		var dirs directives
+		if got := string(dirs.math([]byte(test.text))); got != test.want {
+			t.Errorf("math(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"hash/crc32"
 	"io"
 	"strconv"
+	"strings"
 
+	"golang.org/x/net/html"
 	"gopkg.in/russross/blackfriday.v2"
 )
 
@@ -15,8 +17,8 @@ blackfriday.NoEmptyLineBeforeBlock)*/
 var render = blackfriday.WithRenderer(&htmlRender{
 	blackfriday.NewHTMLRenderer(
 		blackfriday.HTMLRendererParameters{
-			Flags: blackfriday.CommonHTMLFlags |
-				blackfriday.SmartypantsAngledQuotes,
+			// Типографика применяется позже с учетом языка файла
+			Flags: blackfriday.UseXHTML,
 		}),
 })
 
@@ -36,8 +38,8 @@ func slug(text []byte) string {
 	return strconv.FormatUint(uint64(crc32.ChecksumIEEE(text)), 36)
 }
 
-// RenderNode переопределяет формирование сносок. Все остальное обрабатывается
-// стандартным способом.
+// RenderNode переопределяет формирование сносок и блоков кода. Все остальное
+// обрабатывается стандартным способом.
 func (r *htmlRender) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
 	switch node.Type {
 	case blackfriday.Link:
@@ -70,6 +72,19 @@ func (r *htmlRender) RenderNode(w io.Writer, node *blackfriday.Node, entering bo
 			}
 			return blackfriday.GoToNext
 		}
+	case blackfriday.CodeBlock:
+		// Сохраняем в классах все слова из описания блока кода, а не только язык:
+		// они используются для управления подсветкой синтаксиса.
+		var info = strings.Fields(string(node.Info))
+		io.WriteString(w, "\n<pre><code")
+		if len(info) > 0 {
+			info[0] = "language-" + info[0]
+			fmt.Fprintf(w, " class=\"%s\"", html.EscapeString(strings.Join(info, " ")))
+		}
+		io.WriteString(w, ">")
+		io.WriteString(w, html.EscapeString(string(node.Literal)))
+		io.WriteString(w, "</code></pre>\n")
+		return blackfriday.GoToNext
 	}
 	return r.HTMLRenderer.RenderNode(w, node, entering)
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/mdigger/epub3"
@@ -10,14 +11,16 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-// loadMetadata загружает или создает описание публикации.
-func loadMetadata(config *Config) (*epub.Metadata, error) {
+// loadMetadata загружает или создает описание публикации. Кроме описания
+// в формате EPUB возвращаются и исходные метаданные из файла.
+func loadMetadata(config *Config) (*epub.Metadata, metadata.Metadata, error) {
 	// Инициализируем описание метаданных
 	var pubmeta = &epub.Metadata{
 		DC:   "http://purl.org/dc/elements/1.1/",
 		Meta: make([]*epub.Meta, 0),
 	}
 	// Загружаем описание метаданных публикации
+	var bookmeta = make(metadata.Metadata)
 	for _, name := range config.Metadata {
 		fi, err := os.Stat(name)
 		if err != nil || fi.IsDir() {
@@ -26,15 +29,14 @@ func loadMetadata(config *Config) (*epub.Metadata, error) {
 		// Читаем файл с описанием метаданных публикации
 		data, err := ioutil.ReadFile(name)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		// Разбираем метаданные
-		var metadata = make(metadata.Metadata)
-		if err := yaml.Unmarshal(data, metadata); err != nil {
-			return nil, err
+		if err := yaml.Unmarshal(data, bookmeta); err != nil {
+			return nil, nil, err
 		}
 		// Переводим описание метаданных в метаданные публикации
-		convertMetadata(metadata, pubmeta)
+		convertMetadata(bookmeta, pubmeta)
 		break
 	}
 	// Устанавливаем язык, если его нет
@@ -49,7 +51,19 @@ func loadMetadata(config *Config) (*epub.Metadata, error) {
 	if len(pubmeta.Identifier) == 0 {
 		pubmeta.Identifier.Add("uuid", "urn:uuid:"+epub.NewUUID())
 	}
-	return pubmeta, nil
+	return pubmeta, bookmeta, nil
+}
+
+// contributorRoles связывает ключи метаданных, перечисляющие участников
+// издания, с кодами их ролей по классификатору MARC.
+var contributorRoles = []struct {
+	Key  string // Ключ метаданных
+	Code string // Код роли
+}{
+	{"translator", "trl"},
+	{"editor", "edt"},
+	{"illustrator", "ill"},
+	{"designer", "bkd"},
 }
 
 // convertMetadata конвертирует описание метаданных в формат метаданных публикации.
@@ -135,6 +149,19 @@ func convertMetadata(metadata metadata.Metadata, pubmeta *epub.Metadata) {
 	for _, author := range metadata.GetList("contributor") {
 		pubmeta.Contributor.Add("", author)
 	}
+	// Добавляем участников с указанием их ролей
+	for _, role := range contributorRoles {
+		for i, name := range metadata.GetList(role.Key) {
+			var id = role.Code + strconv.Itoa(i+1)
+			pubmeta.Contributor.Add(id, name)
+			pubmeta.Meta = append(pubmeta.Meta, &epub.Meta{
+				Refines:  "#" + id,
+				Property: "role",
+				Scheme:   "marc:relators",
+				Value:    role.Code,
+			})
+		}
+	}
 	// Добавляем информацию об издателях
 	for _, author := range metadata.GetList("publisher") {
 		pubmeta.Publisher.Add("", author)
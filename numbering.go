@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+)
+
+// Способы нумерации глав.
+const (
+	NumberArabic  = "arabic"  // 1, 2, 3
+	NumberRoman   = "roman"   // I, II, III
+	NumberWords   = "words"   // One, Two, Three
+	NumberOrdinal = "ordinal" // Первая, вторая, третья
+	NumberLetters = "letters" // A, B, C
+)
+
+// Numbering описывает нумерацию глав одного типа, заданного в метаданных
+// главы ключом type.
+type Numbering struct {
+	Scheme string   `yaml:"scheme"` // Способ нумерации: arabic, roman, words, ordinal, letters
+	Label  string   `yaml:"label"`  // Формат подписи с %s или ключ ее перевода
+	Reset  []string `yaml:"reset"`  // Типы глав, с которых нумерация начинается заново
+}
+
+// chapterType возвращает тип главы из ее метаданных. По умолчанию это chapter.
+func chapterType(meta metadata.Metadata) string {
+	if typ := meta.Get("type"); typ != "" {
+		return typ
+	}
+	return "chapter"
+}
+
+// number присваивает главе номер, если нумерация включена в настройках или в
+// метаданных главы ключом numbered. Номер и подпись с ним сохраняются в
+// метаданных под ключами number и label. Способ нумерации можно изменить для
+// отдельной главы ключом numbering.
+//
+// Номер главы используется и в номерах иллюстраций. Если нумерация глав не
+// включена, то для этого главы основного текста считаются по порядку. Главы,
+// исключенные из нумерации ключом numbered: false, например предисловие, не
+// учитываются, а их иллюстрации нумеруются без номера главы. Если номера глав
+// повторяются в каждой части, то номер главы в номерах иллюстраций включает
+// номер части: «Рисунок II.1.3».
+func (pub *EPUBCompiler) number(meta metadata.Metadata, ct epub.ContentType) error {
+	pub.chapter = ""
+	var numbered = pub.config.Numbered
+	value, ok := meta["numbered"].(bool)
+	if ok {
+		numbered = value
+	}
+	if !numbered {
+		if !ok && ct == epub.Primary {
+			pub.chapters++
+			pub.chapter = strconv.Itoa(pub.chapters)
+		}
+		return nil
+	}
+	var typ = chapterType(meta)
+	var numbering = pub.config.Numbering[typ]
+	if numbering == nil {
+		numbering = &Numbering{Scheme: NumberArabic, Label: "label-" + typ}
+	}
+	var scheme = numbering.Scheme
+	if value := meta.Get("numbering"); value != "" {
+		scheme = value
+	}
+	if pub.counters == nil {
+		pub.counters = make(map[string]int)
+	}
+	pub.counters[typ]++
+	// Сбрасываем счетчики глав, нумерация которых зависит от этого типа
+	for other, numbering := range pub.config.Numbering {
+		for _, reset := range numbering.Reset {
+			if reset == typ {
+				pub.counters[other] = 0
+			}
+		}
+	}
+	var lang = meta.Lang()
+	if lang == "" {
+		lang = pub.lang
+	}
+	number, err := formatNumber(pub.counters[typ], scheme, lang)
+	if err != nil {
+		return err
+	}
+	var label = numbering.Label
+	if label == "" {
+		label = "label-" + typ
+	}
+	if !strings.Contains(label, "%s") {
+		if msg := localize(lang, label); msg != label {
+			label = msg
+		} else if strings.HasPrefix(label, "label-") {
+			label = "%s" // Перевода подписи нет: остается только номер
+		}
+	}
+	meta["number"] = number
+	// В номерах иллюстраций номер главы записывается цифрами или буквами
+	pub.chapter = number
+	if scheme == NumberWords || scheme == NumberOrdinal {
+		pub.chapter = strconv.Itoa(pub.counters[typ])
+	}
+	// Если нумерация начинается заново в каждой части, то к номеру главы
+	// добавляется номер части, иначе номера иллюстраций повторялись бы
+	for _, reset := range numbering.Reset {
+		if prefix := pub.prefixes[reset]; prefix != "" {
+			pub.chapter = prefix + "." + pub.chapter
+			break
+		}
+	}
+	if pub.prefixes == nil {
+		pub.prefixes = make(map[string]string)
+	}
+	pub.prefixes[typ] = pub.chapter
+	if strings.Contains(label, "%s") {
+		meta["label"] = fmt.Sprintf(label, number)
+	} else {
+		meta["label"] = label + " " + number
+	}
+	return nil
+}
+
+// formatNumber возвращает номер, записанный указанным способом. Номера
+// словами в английском языке пишутся с заглавной буквы, как это принято в
+// названиях глав.
+func formatNumber(n int, scheme, lang string) (string, error) {
+	var result string
+	switch scheme {
+	case NumberArabic, "":
+		return fmt.Sprint(n), nil
+	case NumberRoman:
+		return roman(n), nil
+	case NumberLetters:
+		return letters(n), nil
+	case NumberWords:
+		result = words(n, lang)
+	case NumberOrdinal:
+		result = ordinal(n, lang)
+	default:
+		return "", fmt.Errorf("unknown numbering scheme %q", scheme)
+	}
+	if baseLang(lang) == "en" {
+		var r, size = utf8.DecodeRuneInString(result)
+		result = string(unicode.ToUpper(r)) + result[size:]
+	}
+	return result, nil
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// romanDigits описывает значения римских цифр в порядке убывания.
+var romanDigits = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// roman возвращает число, записанное римскими цифрами. Числа, которые нельзя
+// так записать, возвращаются арабскими цифрами.
+func roman(n int) string {
+	if n <= 0 || n >= 4000 {
+		return strconv.Itoa(n)
+	}
+	var result strings.Builder
+	for _, digit := range romanDigits {
+		for n >= digit.value {
+			result.WriteString(digit.symbol)
+			n -= digit.value
+		}
+	}
+	return result.String()
+}
+
+// numberWords содержит функции записи чисел словами по языкам. Функции
+// поддерживают числа от 0 до 999 и возвращают пустую строку для остальных.
+var numberWords = map[string]func(n int) string{
+	"en": wordsEnglish,
+	"ru": wordsSlavic(
+		[]string{"ноль", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять",
+			"десять", "одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать",
+			"шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"},
+		[]string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят",
+			"восемьдесят", "девяносто"},
+		[]string{"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот",
+			"восемьсот", "девятьсот"}),
+	"uk": wordsSlavic(
+		[]string{"нуль", "один", "два", "три", "чотири", "п’ять", "шість", "сім", "вісім", "дев’ять",
+			"десять", "одинадцять", "дванадцять", "тринадцять", "чотирнадцять", "п’ятнадцять",
+			"шістнадцять", "сімнадцять", "вісімнадцять", "дев’ятнадцять"},
+		[]string{"", "", "двадцять", "тридцять", "сорок", "п’ятдесят", "шістдесят", "сімдесят",
+			"вісімдесят", "дев’яносто"},
+		[]string{"", "сто", "двісті", "триста", "чотириста", "п’ятсот", "шістсот", "сімсот",
+			"вісімсот", "дев’ятсот"}),
+	"de": wordsGerman,
+	"fr": wordsFrench,
+	"es": wordsSpanish,
+}
+
+// words возвращает число, записанное словами на указанном языке. Если язык не
+// поддерживается или число слишком велико, то возвращается запись цифрами.
+func words(n int, lang string) string {
+	if fn, ok := numberWords[baseLang(lang)]; ok {
+		if result := fn(n); result != "" {
+			return result
+		}
+	}
+	return strconv.Itoa(n)
+}
+
+func wordsEnglish(n int) string {
+	var ones = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+		"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen"}
+	var tens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+		"eighty", "ninety"}
+	if n < 0 || n > 999 {
+		return ""
+	}
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, ones[n/100]+" hundred")
+		if n %= 100; n == 0 {
+			return parts[0]
+		}
+	}
+	switch {
+	case n < 20:
+		parts = append(parts, ones[n])
+	case n%10 == 0:
+		parts = append(parts, tens[n/10])
+	default:
+		parts = append(parts, tens[n/10]+"-"+ones[n%10])
+	}
+	return strings.Join(parts, " ")
+}
+
+// wordsSlavic возвращает функцию записи чисел словами для русского и
+// украинского языков, которые различаются только самими словами.
+func wordsSlavic(ones, tens, hundreds []string) func(n int) string {
+	return func(n int) string {
+		if n < 0 || n > 999 {
+			return ""
+		}
+		if n == 0 {
+			return ones[0]
+		}
+		var parts []string
+		if n >= 100 {
+			parts = append(parts, hundreds[n/100])
+			n %= 100
+		}
+		if n >= 20 {
+			parts = append(parts, tens[n/10])
+			n %= 10
+		}
+		if n > 0 {
+			parts = append(parts, ones[n])
+		}
+		return strings.Join(parts, " ")
+	}
+}
+
+func wordsGerman(n int) string {
+	var ones = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht",
+		"neun", "zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn",
+		"siebzehn", "achtzehn", "neunzehn"}
+	var tens = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig",
+		"achtzig", "neunzig"}
+	if n < 0 || n > 999 {
+		return ""
+	}
+	if n == 0 {
+		return ones[0]
+	}
+	var result string
+	if n >= 100 {
+		var prefix = ones[n/100]
+		if n/100 == 1 {
+			prefix = "ein"
+		}
+		result = prefix + "hundert"
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		result += ones[n]
+	case n%10 == 0:
+		result += tens[n/10]
+	default:
+		var unit = ones[n%10]
+		if n%10 == 1 {
+			unit = "ein"
+		}
+		result += unit + "und" + tens[n/10]
+	}
+	return result
+}
+
+func wordsFrench(n int) string {
+	var ones = []string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit",
+		"neuf", "dix", "onze", "douze", "treize", "quatorze", "quinze", "seize"}
+	var tens = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+	if n < 0 || n > 999 {
+		return ""
+	}
+	var below100 func(n int) string
+	below100 = func(n int) string {
+		switch {
+		case n <= 16:
+			return ones[n]
+		case n < 20:
+			return "dix-" + ones[n-10]
+		case n < 70:
+			switch n % 10 {
+			case 0:
+				return tens[n/10]
+			case 1:
+				return tens[n/10] + " et un"
+			}
+			return tens[n/10] + "-" + ones[n%10]
+		case n < 80:
+			if n == 71 {
+				return "soixante et onze"
+			}
+			return "soixante-" + below100(n-60)
+		case n == 80:
+			return "quatre-vingts"
+		}
+		return "quatre-vingt-" + below100(n-80)
+	}
+	if n < 100 {
+		return below100(n)
+	}
+	var result = "cent"
+	if n/100 > 1 {
+		result = ones[n/100] + " cent"
+		if n%100 == 0 {
+			result += "s"
+		}
+	}
+	if n %= 100; n > 0 {
+		result += " " + below100(n)
+	}
+	return result
+}
+
+func wordsSpanish(n int) string {
+	var ones = []string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho",
+		"nueve", "diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete",
+		"dieciocho", "diecinueve", "veinte", "veintiuno", "veintidós", "veintitrés",
+		"veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"}
+	var tens = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta",
+		"ochenta", "noventa"}
+	var hundreds = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos",
+		"quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+	if n < 0 || n > 999 {
+		return ""
+	}
+	if n == 100 {
+		return "cien"
+	}
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, hundreds[n/100])
+		if n %= 100; n == 0 {
+			return parts[0]
+		}
+	}
+	switch {
+	case n < 30:
+		parts = append(parts, ones[n])
+	case n%10 == 0:
+		parts = append(parts, tens[n/10])
+	default:
+		parts = append(parts, tens[n/10]+" y "+ones[n%10])
+	}
+	return strings.Join(parts, " ")
+}
+
+// ordinalWords содержит функции записи порядковых числительных словами по
+// языкам. Для русского и украинского используется женский род, в котором
+// согласуются «глава» и «часть». Функции поддерживают числа от 1 до 99.
+var ordinalWords = map[string]func(n int) string{
+	"en": ordinalEnglish,
+	"ru": ordinalSlavic(
+		[]string{"", "первая", "вторая", "третья", "четвёртая", "пятая", "шестая", "седьмая",
+			"восьмая", "девятая", "десятая", "одиннадцатая", "двенадцатая", "тринадцатая",
+			"четырнадцатая", "пятнадцатая", "шестнадцатая", "семнадцатая", "восемнадцатая",
+			"девятнадцатая"},
+		[]string{"", "", "двадцатая", "тридцатая", "сороковая", "пятидесятая", "шестидесятая",
+			"семидесятая", "восьмидесятая", "девяностая"},
+		numberWords["ru"]),
+	"uk": ordinalSlavic(
+		[]string{"", "перша", "друга", "третя", "четверта", "п’ята", "шоста", "сьома", "восьма",
+			"дев’ята", "десята", "одинадцята", "дванадцята", "тринадцята", "чотирнадцята",
+			"п’ятнадцята", "шістнадцята", "сімнадцята", "вісімнадцята", "дев’ятнадцята"},
+		[]string{"", "", "двадцята", "тридцята", "сорокова", "п’ятдесята", "шістдесята",
+			"сімдесята", "вісімдесята", "дев’яноста"},
+		numberWords["uk"]),
+}
+
+// ordinal возвращает порядковое числительное, записанное словами. Для
+// неподдерживаемых языков и чисел используется запись количественным
+// числительным.
+func ordinal(n int, lang string) string {
+	if fn, ok := ordinalWords[baseLang(lang)]; ok {
+		if result := fn(n); result != "" {
+			return result
+		}
+	}
+	return words(n, lang)
+}
+
+func ordinalEnglish(n int) string {
+	var special = map[int]string{1: "first", 2: "second", 3: "third", 5: "fifth", 8: "eighth",
+		9: "ninth", 12: "twelfth"}
+	if n < 1 || n > 99 {
+		return ""
+	}
+	if n >= 20 && n%10 != 0 {
+		return wordsEnglish(n-n%10) + "-" + ordinalEnglish(n%10)
+	}
+	if result, ok := special[n]; ok {
+		return result
+	}
+	var result = wordsEnglish(n)
+	if strings.HasSuffix(result, "y") {
+		return result[:len(result)-1] + "ieth"
+	}
+	return result + "th"
+}
+
+// ordinalSlavic возвращает функцию записи порядковых числительных для русского
+// и украинского языков: в составных числительных порядковым становится только
+// последнее слово.
+func ordinalSlavic(ones, tens []string, cardinal func(n int) string) func(n int) string {
+	return func(n int) string {
+		switch {
+		case n < 1 || n > 99:
+			return ""
+		case n < 20:
+			return ones[n]
+		case n%10 == 0:
+			return tens[n/10]
+		}
+		return cardinal(n-n%10) + " " + ones[n%10]
+	}
+}
+
+// letters возвращает номер, записанный латинскими буквами: A, B, ..., Z, AA.
+func letters(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	var result []byte
+	for ; n > 0; n = (n - 1) / 26 {
+		result = append([]byte{byte('A' + (n-1)%26)}, result...)
+	}
+	return string(result)
+}
@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestFormatNumber(t *testing.T) {
+	var tests = []struct {
+		n      int
+		scheme string
+		lang   string
+		want   string
+	}{
+		{7, NumberArabic, "en", "7"},
+		{4, NumberRoman, "en", "IV"},
+		{1994, NumberRoman, "ru", "MCMXCIV"},
+		{3, NumberLetters, "en", "C"},
+		{28, NumberLetters, "en", "AB"},
+		{21, NumberWords, "en", "Twenty-one"},
+		{3, NumberOrdinal, "en", "Third"},
+		{21, NumberWords, "ru", "двадцать один"},
+		{3, NumberOrdinal, "ru", "третья"},
+		{12, NumberOrdinal, "ru", "двенадцатая"},
+		{2, NumberOrdinal, "uk", "друга"},
+		{21, NumberWords, "de", "einundzwanzig"},
+		{71, NumberWords, "fr", "soixante et onze"},
+		{80, NumberWords, "fr", "quatre-vingts"},
+		{16, NumberWords, "es", "dieciséis"},
+	}
+	for _, test := range tests {
+		got, err := formatNumber(test.n, test.scheme, test.lang)
+		if err != nil {
+			t.Errorf("formatNumber(%d, %q, %q): %v", test.n, test.scheme, test.lang, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("formatNumber(%d, %q, %q) = %q, want %q",
+				test.n, test.scheme, test.lang, got, test.want)
+		}
+	}
+	if _, err := formatNumber(1, "unknown", "en"); err == nil {
+		t.Error("formatNumber with unknown scheme: expected error")
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// reShortcode описывает открывающий или закрывающий шорткод:
+//
+//	{{< video src="intro.mp4" >}}
+//	{{< boxed title="Внимание" >}} ... {{< /boxed >}}
+var reShortcode = regexp.MustCompile(`\{\{<\s*(/?)([\w-]+)((?:\s+[\w-]+=(?:"[^"]*"|[^\s">]+))*)\s*/?>\}\}`)
+
+// reShortcodeParam описывает параметр шорткода: key=value или key="value".
+var reShortcodeParam = regexp.MustCompile(`([\w-]+)=(?:"([^"]*)"|([^\s">]+))`)
+
+// shortcodeToken описывает найденный в тексте шорткод.
+type shortcodeToken struct {
+	line    int    // Номер строки
+	loc     []int  // Положение в строке и подвыражений
+	closing bool   // Закрывающий шорткод
+	name    string // Название шорткода
+	pair    int    // Номер парного шорткода или -1
+	alone   bool   // Шорткод занимает всю строку
+}
+
+// shortcodes заменяет в исходном тексте шорткоды на директивы. Парные
+// шорткоды, записанные в отдельных строках, становятся блочными директивами,
+// и их содержимое обрабатывается как Markdown. Шорткоды в блоках кода, в том
+// числе выделенных отступом, и в строчном коде не обрабатываются.
+func (d *directives) shortcodes(data []byte) []byte {
+	var (
+		lines  [][]byte
+		code   []bool // Строки блоков кода
+		tokens []*shortcodeToken
+		stack  []int // Номера открытых шорткодов
+	)
+	scanCodeLines(data, func(line []byte, isCode bool) {
+		lines = append(lines, line)
+		code = append(code, isCode)
+	})
+	for first := 0; first < len(lines); {
+		if code[first] {
+			first++
+			continue
+		}
+		// Строчный код ищется во всем тексте между блоками кода, так как он
+		// может занимать несколько строк
+		var text []byte
+		var last = first
+		for ; last < len(lines) && !code[last]; last++ {
+			text = append(text, lines[last]...)
+		}
+		var spans = codeSpans(text)
+		var offset = 0
+		for n := first; n < last; n++ {
+			var line = lines[n]
+			for _, loc := range reShortcode.FindAllSubmatchIndex(line, -1) {
+				if inSpans(offset+loc[0], offset+loc[1], spans) {
+					continue // Пример шорткода в строчном коде
+				}
+				var token = &shortcodeToken{
+					line:    n,
+					loc:     loc,
+					closing: loc[3] > loc[2],
+					name:    string(line[loc[4]:loc[5]]),
+					pair:    -1,
+					alone:   len(bytes.TrimSpace(line)) == loc[1]-loc[0],
+				}
+				tokens = append(tokens, token)
+				if !token.closing {
+					stack = append(stack, len(tokens)-1)
+					continue
+				}
+				// Связываем закрывающий шорткод с ближайшим открытым с тем же
+				// названием; пропущенные открытые шорткоды считаются одиночными
+				for i := len(stack) - 1; i >= 0; i-- {
+					if tokens[stack[i]].name == token.name {
+						token.pair = stack[i]
+						tokens[stack[i]].pair = len(tokens) - 1
+						stack = stack[:i]
+						break
+					}
+				}
+			}
+			offset += len(line)
+		}
+		first = last
+	}
+	if len(tokens) == 0 {
+		return data
+	}
+	var (
+		buf = new(bytes.Buffer)
+		ids = make(map[int]int) // Номера директив для открывающих шорткодов
+	)
+	var next = 0
+	for n, line := range lines {
+		var last = 0
+		for ; next < len(tokens) && tokens[next].line == n; next++ {
+			var token = tokens[next]
+			buf.Write(line[last:token.loc[0]])
+			last = token.loc[1]
+			var block = token.alone && token.pair >= 0 && tokens[token.pair].alone
+			switch {
+			case token.closing && token.pair < 0:
+				// Закрывающий шорткод без пары оставляем как есть
+				buf.Write(line[token.loc[0]:token.loc[1]])
+			case token.closing && block:
+				buf.WriteString(d.end(ids[token.pair]))
+			case token.closing:
+				buf.WriteString("<!--" + directivePrefix + "/" + strconv.Itoa(ids[token.pair]) + "-->")
+			default:
+				var id = d.add(shortcodeDirective,
+					token.name+string(line[token.loc[6]:token.loc[7]]), token.pair >= 0)
+				ids[next] = id
+				if block {
+					buf.WriteString(d.begin(id))
+				} else {
+					buf.WriteString(d.inline(id))
+				}
+			}
+		}
+		if last == 0 {
+			buf.Write(line)
+			continue
+		}
+		var rest = line[last:]
+		if len(bytes.TrimSpace(rest)) > 0 || !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.Write(rest)
+		}
+	}
+	return buf.Bytes()
+}
+
+// Shortcode описывает данные, передаваемые в шаблон шорткода.
+type Shortcode struct {
+	Name   string            // Название шорткода
+	Params map[string]string // Параметры
+	Inner  template.HTML     // Содержимое парного шорткода
+	Lang   string            // Язык публикации
+}
+
+// shortcode возвращает результат обработки шорткода шаблоном с тем же именем
+// из каталога шорткодов проекта. Результат разбирается как HTML и проходит
+// дальнейшую нормализацию вместе с остальным текстом.
+func (pub *EPUBCompiler) shortcode(args string, content []*html.Node) ([]*html.Node, error) {
+	var name = args
+	if i := strings.IndexAny(args, " \t"); i >= 0 {
+		name = args[:i]
+	}
+	tmpl, err := pub.shortcodeTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+	var data = &Shortcode{
+		Name:   name,
+		Params: make(map[string]string),
+		Lang:   pub.lang,
+	}
+	for _, param := range reShortcodeParam.FindAllStringSubmatch(args[len(name):], -1) {
+		data.Params[param[1]] = param[2] + param[3]
+	}
+	var inner = new(bytes.Buffer)
+	for _, child := range content {
+		if err := html.Render(inner, child); err != nil {
+			return nil, err
+		}
+	}
+	data.Inner = template.HTML(inner.String())
+	var buf = new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	// Перевод строки в конце файла шаблона не должен попасть в текст
+	return html.ParseFragment(bytes.NewReader(bytes.TrimSpace(buf.Bytes())),
+		&html.Node{Type: html.ElementNode})
+}
+
+// shortcodeTemplate возвращает шаблон шорткода. Шаблоны загружаются из
+// каталога шорткодов один раз при первом обращении.
+func (pub *EPUBCompiler) shortcodeTemplate(name string) (*template.Template, error) {
+	if tmpl, ok := pub.shortcodes[name]; ok {
+		return tmpl, nil
+	}
+	var filename = filepath.Join(pub.config.Shortcodes, name+".html")
+	if _, err := os.Stat(filename); err != nil {
+		return nil, fmt.Errorf("unknown shortcode %q: %s not found", name, filename)
+	}
+	tmpl, err := template.New(filepath.Base(filename)).Funcs(pub.funcs()).ParseFiles(filename)
+	if err != nil {
+		return nil, err
+	}
+	if pub.shortcodes == nil {
+		pub.shortcodes = make(map[string]*template.Template)
+	}
+	pub.shortcodes[name] = tmpl
+	return tmpl, nil
+}
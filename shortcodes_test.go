@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestShortcodeDirectives(t *testing.T) {
+	var tests = []struct {
+		text string
+		want string
+	}{
+		{"Видео {{< video src=\"a.mp4\" >}} здесь\n", "Видео <!--md2epub:0--> здесь\n"},
+		// Шорткоды в коде не обрабатываются
+		{"Код `{{< video >}}` здесь\n", "Код `{{< video >}}` здесь\n"},
+		{"Код `многострочный\n{{< video >}}` здесь\n", "Код `многострочный\n{{< video >}}` здесь\n"},
+		{"```\n{{< video >}}\n```\n", "```\n{{< video >}}\n```\n"},
+		{"Текст\n\n    {{< video >}}\n", "Текст\n\n    {{< video >}}\n"},
+	}
+	for _, test := range tests {
+		var dirs directives
+		if got := string(dirs.shortcodes([]byte(test.text))); got != test.want {
+			t.Errorf("shortcodes(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
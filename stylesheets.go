@@ -0,0 +1,124 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/mdigger/metadata"
+	"gopkg.in/yaml.v2"
+)
+
+// Stylesheet описывает стилевой файл, подключаемый к страницам. Для вариантов
+// стилей под печать или устройства с электронными чернилами указывается
+// медиазапрос:
+//
+//	css:
+//	  - style.css
+//	  - file: eink.css
+//	    media: (monochrome)
+type Stylesheet struct {
+	File  string `yaml:"file"`  // Имя файла
+	Media string `yaml:"media"` // Медиазапрос
+}
+
+// UnmarshalYAML позволяет указывать стилевой файл просто именем.
+func (s *Stylesheet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&s.File); err == nil {
+		return nil
+	}
+	type plain Stylesheet
+	return unmarshal((*plain)(s))
+}
+
+// Stylesheets описывает список стилевых файлов.
+type Stylesheets []Stylesheet
+
+// UnmarshalYAML позволяет указывать вместо списка один стилевой файл.
+func (s *Stylesheets) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []Stylesheet
+	if err := unmarshal(&list); err == nil {
+		*s = list
+		return nil
+	}
+	var single Stylesheet
+	if err := unmarshal(&single); err != nil {
+		return err
+	}
+	*s = Stylesheets{single}
+	return nil
+}
+
+// stylesheetsKey задает ключ метаданных страницы со списком подключаемых к
+// ней стилевых файлов.
+const stylesheetsKey = "_stylesheets_"
+
+// globalStyles добавляет к странице ссылки на стили темы и стилевые файлы
+// публикации. Стилевые файлы подключаются после темы, поэтому могут дополнять
+// и переопределять ее.
+func (pub *EPUBCompiler) globalStyles(meta metadata.Metadata, filename string) {
+	if pub.theme != nil {
+		addStylesheet(meta, filename, Stylesheet{File: themeCSSFile})
+	}
+	for i, stylesheet := range pub.stylesheets {
+		if i == 0 {
+			// Ссылка для шаблонов проектов, написанных до появления списка
+			// стилевых файлов
+			meta["_globalcssfile_"] = relativePath(filename, stylesheet.File)
+		}
+		addStylesheet(meta, filename, stylesheet)
+	}
+}
+
+// addStylesheet добавляет к странице ссылку на стилевой файл. Путь к файлу
+// указывается относительно корня публикации и преобразуется в путь
+// относительно страницы.
+func addStylesheet(meta metadata.Metadata, filename string, stylesheet Stylesheet) {
+	var list, _ = meta[stylesheetsKey].([]Stylesheet)
+	stylesheet.File = relativePath(filename, stylesheet.File)
+	meta[stylesheetsKey] = append(list, stylesheet)
+}
+
+// chapterStyles добавляет к странице стилевые файлы, перечисленные в ее
+// метаданных ключом css. Пути к ним указываются относительно файла главы.
+func (pub *EPUBCompiler) chapterStyles(meta metadata.Metadata, filename string) error {
+	var value, ok = meta["css"]
+	if !ok {
+		return nil
+	}
+	// Разбираем значение так же, как список стилей в настройках проекта
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var list Stylesheets
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	for _, stylesheet := range list {
+		stylesheet.File = filepath.Join(filepath.Dir(filename), stylesheet.File)
+		if _, err := os.Stat(stylesheet.File); err != nil {
+			pub.warnf("%s: stylesheet %s not found", filename, stylesheet.File)
+		}
+		addStylesheet(meta, filename, stylesheet)
+	}
+	return nil
+}
+
+// existingStylesheets возвращает стилевые файлы публикации из настроек, которые
+// есть в исходном каталоге. Об отсутствующих файлах выводится предупреждение,
+// кроме стилевого файла по умолчанию: его в проекте может и не быть.
+func (pub *EPUBCompiler) existingStylesheets() Stylesheets {
+	var list = pub.config.CSS
+	var implicit = len(list) == 1 && list[0] == DefaultConfig.CSS[0]
+	var result Stylesheets
+	for _, stylesheet := range list {
+		if _, err := os.Stat(stylesheet.File); err != nil {
+			if !implicit {
+				pub.warnf("stylesheet %s not found", stylesheet.File)
+			}
+			continue
+		}
+		result = append(result, stylesheet)
+	}
+	return result
+}
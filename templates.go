@@ -1,35 +1,200 @@
 package main
 
 import (
+	"fmt"
 	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
-// Шаблоны, используемые для преобразования информации в публикацию.
-var templates = template.Must(template.New("").Parse(`
+// Шаблоны, используемые для преобразования информации в публикацию. Функции
+// объявляются здесь только для разбора шаблонов, а при загрузке заменяются на
+// функции конкретной публикации.
+var templates = template.Must(template.New("").Funcs(new(EPUBCompiler).funcs()).Parse(`
 {{ define "header"}}<!DOCTYPE html>
 <html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ if .lang }}{{ .lang }}{{ else }}en{{ end }}">
 <head>
 <meta charset="UTF-8" />
-<title>{{ .title }}</title>{{ if ._globalcssfile_ }}
-<link rel="stylesheet" href="{{ ._globalcssfile_ }}" />{{ end }}
+<title>{{ .title }}</title>{{ range ._stylesheets_ }}
+<link rel="stylesheet" href="{{ .File }}"{{ if .Media }} media="{{ .Media }}"{{ end }} />{{ end }}
 </head>
 <body{{ if .class }} class="{{ .class }}"{{ end }}>{{ end }}
 
 {{ define "footer" }}</body>
 </html>{{ end }}
 
-{{ define "page" }}{{ template "header" . }}{{ .content }}{{ template "footer" }}{{ end }}
+{{ define "page" }}{{ template "header" . }}{{ if .label }}
+<p class="chapter-label">{{ .label }}</p>{{ end }}{{ .content }}{{ template "footer" }}{{ end }}
+
+{{ define "landmarks" }}{{ with .landmarks }}
+<nav epub:type="landmarks" hidden="hidden">
+<ol>{{ range . }}
+	<li><a epub:type="{{ .Type }}" href="{{ .Filename }}">{{ .Title }}</a></li>{{ end }}
+</ol>
+</nav>{{ end }}{{ end }}
+
+{{ define "chapter-opener" }}{{ template "header" . }}
+<section epub:type="chapter" class="chapter-opener">
+<header>{{ if .label }}
+<p class="chapter-label">{{ .label }}</p>{{ end }}{{ if .subtitle }}
+<p class="chapter-subtitle">{{ .subtitle }}</p>{{ end }}
+</header>
+{{ .content }}
+</section>
+{{ template "footer" }}{{ end }}
+
+{{ define "part-divider" }}{{ template "header" . }}
+<section epub:type="part" class="part-divider">{{ if .label }}
+<p class="part-label">{{ .label }}</p>{{ end }}
+{{ .content }}
+</section>
+{{ template "footer" }}{{ end }}
 
 {{ define "toc" }}{{ template "header" . }}
 <nav epub:type="toc">
 <ol>{{ range .toc }}
-	<li><a href="{{ .Filename }}">{{ if .Title }}{{ .Title }}{{ else }}* * *{{ end }}</a></li>{{ end }}
+	<li><a href="{{ .Filename }}">{{ if .Label }}<span class="label">{{ .Label }}</span> {{ end }}{{ if .Title }}{{ .Title }}{{ else }}* * *{{ end }}</a></li>{{ end }}
 </ol>
-</nav>
+</nav>{{ template "landmarks" . }}
+{{ template "footer" }}{{ end }}
+
+{{ define "cover" }}{{ template "header" . }}
+<section epub:type="cover" class="cover">
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" version="1.1" width="100%" height="100%" viewBox="0 0 {{ .width }} {{ .height }}" preserveAspectRatio="xMidYMid meet">
+<image width="{{ .width }}" height="{{ .height }}" xlink:href="{{ .image }}" />
+</svg>
+</section>
+{{ template "footer" }}{{ end }}
+
+{{ define "cover-lines" }}{{ $x := .x }}{{ range $i, $line := .lines }}<tspan x="{{ $x }}" dy="{{ if $i }}1.15em{{ else }}0{{ end }}">{{ $line }}</tspan>{{ end }}{{ end }}
+
+{{ define "cover-classic" }}<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="{{ .width }}" height="{{ .height }}" viewBox="0 0 {{ .width }} {{ .height }}" xml:lang="{{ .lang }}">
+<rect width="100%" height="100%" fill="{{ .background }}" />
+<rect x="60" y="60" width="1080" height="1680" fill="none" stroke="{{ .accent }}" stroke-width="6" />
+<rect x="84" y="84" width="1032" height="1632" fill="none" stroke="{{ .accent }}" stroke-width="2" />
+<g fill="{{ .light }}" font-family="Georgia, 'Times New Roman', serif" text-anchor="middle">{{ if .authors }}
+<text x="600" y="320" font-size="56" letter-spacing="4">{{ .authors }}</text>{{ end }}
+<text x="600" y="640" font-size="{{ .titleSize }}" font-weight="bold">{{ template "cover-lines" dict "x" 600 "lines" .title }}</text>{{ if .subtitle }}
+<text x="600" y="1220" font-size="52" font-style="italic" fill="{{ .accent }}">{{ template "cover-lines" dict "x" 600 "lines" .subtitle }}</text>{{ end }}{{ if .series }}
+<text x="600" y="1600" font-size="44">{{ .series }}</text>{{ end }}
+</g>
+</svg>{{ end }}
+
+{{ define "cover-modern" }}<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="{{ .width }}" height="{{ .height }}" viewBox="0 0 {{ .width }} {{ .height }}" xml:lang="{{ .lang }}">
+<rect width="100%" height="100%" fill="{{ .light }}" />
+<rect width="1200" height="1140" fill="{{ .background }}" />
+<rect y="1140" width="1200" height="28" fill="{{ .accent }}" />
+<g font-family="'Helvetica Neue', Arial, sans-serif">{{ if .series }}
+<text x="100" y="180" font-size="40" fill="{{ .accent }}" letter-spacing="6">{{ .series }}</text>{{ end }}
+<text x="100" y="520" font-size="{{ .titleSize }}" font-weight="bold" fill="{{ .light }}">{{ template "cover-lines" dict "x" 100 "lines" .title }}</text>{{ if .subtitle }}
+<text x="100" y="980" font-size="48" fill="{{ .accent }}">{{ template "cover-lines" dict "x" 100 "lines" .subtitle }}</text>{{ end }}{{ if .authors }}
+<text x="100" y="1420" font-size="64" fill="{{ .dark }}">{{ .authors }}</text>{{ end }}
+</g>
+</svg>{{ end }}
+
+{{ define "cover-minimal" }}<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="{{ .width }}" height="{{ .height }}" viewBox="0 0 {{ .width }} {{ .height }}" xml:lang="{{ .lang }}">
+<rect width="100%" height="100%" fill="{{ .light }}" />
+<g fill="{{ .dark }}" font-family="Georgia, 'Times New Roman', serif" text-anchor="middle">
+<text x="600" y="640" font-size="{{ .titleSize }}">{{ template "cover-lines" dict "x" 600 "lines" .title }}</text>
+<rect x="500" y="1000" width="200" height="4" fill="{{ .accent }}" />{{ if .subtitle }}
+<text x="600" y="1120" font-size="48" font-style="italic">{{ template "cover-lines" dict "x" 600 "lines" .subtitle }}</text>{{ end }}{{ if .authors }}
+<text x="600" y="1400" font-size="56">{{ .authors }}</text>{{ end }}{{ if .series }}
+<text x="600" y="1680" font-size="36" fill="{{ .background }}">{{ .series }}</text>{{ end }}
+</g>
+</svg>{{ end }}
+
+{{ define "titlepage" }}{{ template "header" . }}
+<section epub:type="titlepage" class="titlepage">{{ with .authors }}
+<p class="author">{{ range $i, $author := . }}{{ if $i }}, {{ end }}{{ $author }}{{ end }}</p>{{ end }}
+<h1 class="title">{{ .title }}</h1>{{ if .subtitle }}
+<p class="subtitle">{{ .subtitle }}</p>{{ end }}{{ if .collection }}
+<p class="series">{{ .collection }}{{ if .sequence }}, {{ .sequence }}{{ end }}</p>{{ end }}{{ if or .publisher .logo }}
+<footer class="publisher">{{ if .logo }}
+<img src="{{ .logo }}" alt="{{ range $i, $name := .publisher }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}" />{{ end }}{{ range .publisher }}
+<p>{{ . }}</p>{{ end }}
+</footer>{{ end }}
+</section>
+{{ template "footer" }}{{ end }}
+
+{{ define "draft" }}{{ template "header" . }}
+<section class="draft-banner">
+<h1>{{ .title }}</h1>
+<p class="buildtime">{{ ._buildtime_ }}</p>
+</section>
+{{ template "footer" }}{{ end }}
+
+{{ define "copyright" }}{{ template "header" . }}
+<section epub:type="copyright-page" class="copyright">
+<p class="booktitle">{{ range .authors }}{{ . }}. {{ end }}{{ .booktitle }}{{ if .edition }}. {{ .edition }}{{ end }}</p>{{ range .contributors }}
+<p class="contributors">{{ .Role }}: {{ range $i, $name := .Names }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}</p>{{ end }}{{ if .publisher }}
+<p class="publisher">{{ l10n "publisher" .lang }}: {{ range $i, $name := .publisher }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}</p>{{ end }}{{ if .date }}
+<p class="date">{{ l10n "published" .lang }}: {{ .date }}</p>{{ end }}{{ if .isbn }}
+<p class="isbn">{{ l10n "isbn" .lang }} {{ .isbn }}</p>{{ end }}{{ if .copyright }}
+<p class="rights">{{ .copyright }}</p>{{ end }}{{ if .rights }}
+<p class="rights">{{ .rights }}</p>{{ end }}{{ if ._buildtime_ }}
+<p class="buildtime">{{ l10n "buildtime" .lang }}: {{ ._buildtime_ }}</p>{{ end }}
+</section>
 {{ template "footer" }}{{ end }}
 
 {{ define "nav" }}{{ template "header" . }}
 <nav epub:type="toc">
 {{ .content }}
-</nav>
+</nav>{{ template "landmarks" . }}
 {{ template "footer" }}{{ end }}`))
+
+// pageLayouts перечисляет встроенные шаблоны, которые можно указать как
+// шаблон страницы. Остальные встроенные шаблоны являются частями страниц или
+// служебными страницами и для файлов публикации не подходят.
+var pageLayouts = []string{"page", "chapter-opener", "part-divider", "nav"}
+
+// loadTemplates возвращает копию встроенных шаблонов с библиотекой функций,
+// дополненную шаблонами из каталога проекта. Каждый файл каталога заменяет
+// встроенный шаблон с тем же именем без расширения: page.html заменяет шаблон
+// "page". Шаблоны, для которых нет файла, остаются встроенными.
+//
+// Также возвращается список шаблонов страниц: встроенные шаблоны из
+// pageLayouts и новые шаблоны из файлов проекта. Шаблоны, объявленные внутри
+// файлов, файлы, заменяющие части страниц, и оформления обложек cover-<name>
+// в него не входят.
+func loadTemplates(config *Config, funcs template.FuncMap) (*template.Template, map[string]bool, error) {
+	result, err := templates.Clone()
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Funcs(funcs)
+	var layouts = make(map[string]bool, len(pageLayouts))
+	for _, name := range pageLayouts {
+		layouts[name] = true
+	}
+	if config.Templates == "" {
+		return result, layouts, nil
+	}
+	files, err := ioutil.ReadDir(config.Templates)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, layouts, nil
+		}
+		return nil, nil, err
+	}
+	for _, fi := range files {
+		var name = fi.Name()
+		if fi.IsDir() || name[0] == '.' {
+			continue
+		}
+		var filename = filepath.Join(config.Templates, name)
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+		if templates.Lookup(name) == nil && !strings.HasPrefix(name, "cover-") {
+			layouts[name] = true
+		}
+		if _, err = result.New(name).Parse(string(data)); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+	return result, layouts, nil
+}
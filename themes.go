@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mdigger/epub3"
+)
+
+// themeCSSFile задает имя файла со стилями встроенной темы оформления.
+const themeCSSFile = "_theme.css"
+
+// themeNone отключает тему оформления.
+const themeNone = "none"
+
+// themeDefault используется, если тема не указана, а в проекте нет своего
+// стилевого файла.
+const themeDefault = "classic"
+
+// theme описывает встроенную тему оформления: стили и используемые в них
+// файлы, которые добавляются в публикацию вместе со стилями. Пути к файлам
+// указываются относительно файла стилей.
+type theme struct {
+	CSS    string            // Стили
+	Assets map[string]string // Дополнительные файлы темы и их содержимое
+}
+
+// themes содержит встроенные темы оформления.
+var themes = map[string]*theme{
+	"classic":   {CSS: themeBaseCSS + themeClassicCSS, Assets: themeOrnament},
+	"technical": {CSS: themeBaseCSS + themeTechnicalCSS},
+	"poetry":    {CSS: themeBaseCSS + themePoetryCSS, Assets: themeOrnament},
+	"eink":      {CSS: themeBaseCSS + themeEinkCSS},
+}
+
+// selectTheme возвращает тему оформления, указанную в конфигурации. Если тема
+// не указана, то тема по умолчанию используется только для проектов без
+// собственного стилевого файла, стили которого иначе дополняют тему.
+func (pub *EPUBCompiler) selectTheme() (*theme, error) {
+	var name = pub.config.Theme
+	switch {
+	case name == themeNone:
+		return nil, nil
+	case name == "" && len(pub.stylesheets) > 0:
+		return nil, nil
+	case name == "":
+		name = themeDefault
+	}
+	if theme, ok := themes[name]; ok {
+		return theme, nil
+	}
+	var names = make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown theme %q: use one of %s or %s",
+		name, strings.Join(names, ", "), themeNone)
+}
+
+// addTheme добавляет в публикацию стили темы оформления и ее файлы.
+func (pub *EPUBCompiler) addTheme() error {
+	if pub.theme == nil {
+		return nil
+	}
+	var names = make([]string, 0, len(pub.theme.Assets))
+	for name := range pub.theme.Assets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var data = strings.NewReader(pub.theme.Assets[name])
+		if err := pub.writer.Add(name, epub.Media, data); err != nil {
+			return err
+		}
+	}
+	return pub.writer.Add(themeCSSFile, epub.Media, strings.NewReader(pub.theme.CSS))
+}
+
+// themeOrnament содержит украшение, используемое для разделителей сцен.
+var themeOrnament = map[string]string{
+	"_theme/ornament.svg": `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="120" height="24" viewBox="0 0 120 24">
+<path d="M0 12 H44 M76 12 H120" stroke="#000" stroke-width="1.5" fill="none" />
+<path d="M60 2 C66 8 66 16 60 22 C54 16 54 8 60 2 Z" fill="#000" />
+<circle cx="50" cy="12" r="2.5" fill="#000" />
+<circle cx="70" cy="12" r="2.5" fill="#000" />
+</svg>
+`,
+}
+
+// themeBaseCSS содержит общие для всех тем стили.
+const themeBaseCSS = `html, body { margin: 0; padding: 0; }
+body { line-height: 1.4; widows: 2; orphans: 2; }
+h1, h2, h3, h4, h5, h6 {
+  line-height: 1.2;
+  page-break-after: avoid;
+  break-after: avoid;
+  hyphens: none;
+  -webkit-hyphens: none;
+}
+img, svg { max-width: 100%; }
+figure { margin: 1em 0; text-align: center; page-break-inside: avoid; break-inside: avoid; }
+figcaption { font-size: 0.9em; margin-top: 0.3em; }
+pre, code, kbd, samp { font-family: Menlo, Consolas, "DejaVu Sans Mono", monospace; font-size: 0.9em; }
+pre { white-space: pre-wrap; page-break-inside: avoid; break-inside: avoid; }
+table { border-collapse: collapse; margin: 1em auto; }
+th, td { padding: 0.2em 0.5em; border: 1px solid #999; }
+a { color: inherit; }
+sup, sub { line-height: 0; }
+body.cover, section.cover { margin: 0; padding: 0; text-align: center; }
+section.titlepage { text-align: center; margin-top: 20%; }
+section.titlepage .title { font-size: 2em; margin: 1em 0 0.5em; }
+section.titlepage .subtitle { font-style: italic; }
+section.titlepage .publisher { margin-top: 4em; }
+section.titlepage .publisher img { max-height: 3em; }
+section.copyright { font-size: 0.85em; margin-top: 40%; }
+section.copyright p { text-indent: 0; margin: 0.3em 0; text-align: left; }
+p.chapter-label, p.part-label { text-indent: 0; text-align: center; letter-spacing: 0.1em; margin: 2em 0 0; }
+section.part-divider { text-align: center; margin-top: 30%; page-break-before: always; break-before: page; }
+section.part-divider p.part-label { font-size: 1.2em; }
+`
+
+// themeClassicCSS содержит стили художественной литературы.
+const themeClassicCSS = `body { font-family: Georgia, "Times New Roman", serif; }
+p { margin: 0; text-indent: 1.5em; text-align: justify; hyphens: auto; -webkit-hyphens: auto; }
+h1 + p, h2 + p, h3 + p, hr + p, blockquote + p, figure + p, p.dialogue:first-child { text-indent: 0; }
+h1, h2, h3 { text-align: center; font-weight: normal; }
+h1 { font-size: 1.6em; margin: 3em 0 2em; }
+h2 { font-size: 1.3em; margin: 2em 0 1em; }
+h3 { font-size: 1.1em; margin: 1.5em 0 0.5em; font-style: italic; }
+hr {
+  height: 24px;
+  margin: 1.5em auto;
+  border: none;
+  background: url(_theme/ornament.svg) no-repeat center;
+  background-size: contain;
+}
+blockquote { margin: 1em 2em; font-size: 0.95em; }
+blockquote.epigraph { margin: 2em 0 2em 40%; font-style: italic; }
+blockquote.epigraph footer { text-align: right; font-style: normal; }
+section.dedication { margin: 30% 10% 0; text-align: center; font-style: italic; }
+`
+
+// themeTechnicalCSS содержит стили технической литературы.
+const themeTechnicalCSS = `body { font-family: "Helvetica Neue", Arial, sans-serif; }
+p { margin: 0.5em 0; text-align: left; }
+h1, h2, h3, h4 { font-weight: bold; }
+h1 { font-size: 1.8em; margin: 1.5em 0 0.8em; border-bottom: 2px solid #333; padding-bottom: 0.2em; }
+h2 { font-size: 1.4em; margin: 1.3em 0 0.6em; }
+h3 { font-size: 1.15em; margin: 1.2em 0 0.5em; }
+ul, ol { margin: 0.5em 0; padding-left: 1.5em; }
+pre { background: #f5f5f5; border: 1px solid #ddd; padding: 0.6em 0.8em; line-height: 1.3; }
+code { background: #f5f5f5; padding: 0 0.2em; }
+pre code { background: none; padding: 0; }
+th { background: #eee; font-weight: bold; }
+blockquote { margin: 1em 0; padding-left: 1em; border-left: 0.25em solid #ccc; color: #444; }
+aside { margin: 1em 0; padding: 0.5em 1em; border: 1px solid #ccc; }
+hr { border: none; border-top: 1px solid #999; margin: 1.5em 0; }
+`
+
+// themePoetryCSS содержит стили поэтических сборников.
+const themePoetryCSS = `body { font-family: Georgia, "Times New Roman", serif; }
+p { margin: 0; text-indent: 0; text-align: left; }
+h1, h2, h3 { text-align: center; font-weight: normal; }
+h1 { font-size: 1.5em; margin: 3em 0 2em; }
+h2 { font-size: 1.2em; margin: 2em 0 1em; font-style: italic; }
+section.verse { margin: 1.5em auto; padding: 0 1em; max-width: 30em; }
+section.verse div.stanza {
+  margin: 0 0 1em;
+  text-indent: 0;
+  text-align: left;
+  hyphens: none;
+  -webkit-hyphens: none;
+  page-break-inside: avoid;
+  break-inside: avoid;
+}
+section.verse .indent-1 { padding-left: 2em; }
+section.verse .indent-2 { padding-left: 4em; }
+section.verse .indent-3 { padding-left: 6em; }
+section.verse p.date { text-align: right; font-style: italic; font-size: 0.9em; }
+hr {
+  height: 24px;
+  margin: 2em auto;
+  border: none;
+  background: url(_theme/ornament.svg) no-repeat center;
+  background-size: contain;
+}
+blockquote.epigraph { margin: 2em 0 2em 40%; font-style: italic; font-size: 0.9em; }
+blockquote.epigraph footer { text-align: right; font-style: normal; }
+`
+
+// themeEinkCSS содержит контрастные стили для устройств с электронными
+// чернилами: только черный цвет, без фона и полутонов.
+const themeEinkCSS = `body { font-family: Georgia, "Times New Roman", serif; color: #000; background: #fff; line-height: 1.5; }
+p { margin: 0; text-indent: 1.2em; text-align: left; }
+h1 + p, h2 + p, h3 + p, hr + p { text-indent: 0; }
+h1, h2, h3, h4 { font-weight: bold; color: #000; }
+h1 { font-size: 1.7em; margin: 2em 0 1em; }
+h2 { font-size: 1.35em; margin: 1.5em 0 0.8em; }
+a { text-decoration: underline; }
+pre, code { background: none; color: #000; }
+pre { border: 2px solid #000; padding: 0.5em; }
+th, td { border: 1px solid #000; }
+blockquote { margin: 1em 0; padding-left: 1em; border-left: 3px solid #000; }
+aside { border: 2px solid #000; padding: 0.5em 1em; margin: 1em 0; background: none; }
+hr { border: none; border-top: 2px solid #000; margin: 1.5em 20%; }
+`
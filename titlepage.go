@@ -0,0 +1,94 @@
+package main
+
+import (
+	"github.com/mdigger/epub3"
+	"github.com/mdigger/metadata"
+)
+
+// titlePageFile задает имя файла титульного листа.
+const titlePageFile = "titlepage.xhtml"
+
+// landmark описывает ориентир публикации для навигации epub:type="landmarks".
+type landmark struct {
+	Type     string // Значение epub:type
+	Title    string // Название
+	Filename string // Имя файла
+}
+
+// addLandmark добавляет ориентир в навигацию публикации.
+func (pub *EPUBCompiler) addLandmark(typ, filename string) {
+	pub.landmarks = append(pub.landmarks, &landmark{
+		Type:     typ,
+		Title:    localize(pub.lang, typ),
+		Filename: filename,
+	})
+}
+
+// landmarksFor возвращает ориентиры со ссылками относительно указанного файла.
+func (pub *EPUBCompiler) landmarksFor(filename string) []*landmark {
+	var result = make([]*landmark, len(pub.landmarks))
+	for i, item := range pub.landmarks {
+		result[i] = &landmark{
+			Type:     item.Type,
+			Title:    item.Title,
+			Filename: relativePath(filename, item.Filename),
+		}
+	}
+	return result
+}
+
+// titlePage возвращает титульный лист, сформированный из метаданных публикации:
+// название, подзаголовок, авторы, серия с номером и издательство с логотипом.
+// Логотип издательства задается в метаданных ключом logo.
+func (pub *EPUBCompiler) titlePage() *page {
+	var pubmeta = pub.writer.Metadata
+	var meta = metadata.Metadata{
+		"lang":       pub.lang,
+		"title":      elementValue(pubmeta.Title, "title"),
+		"subtitle":   elementValue(pubmeta.Title, "subtitle"),
+		"collection": elementValue(pubmeta.Title, "collection"),
+		"sequence":   refinedValue(pubmeta, "#collection", "group-position"),
+		"authors":    elementValues(pubmeta.Creator),
+		"publisher":  elementValues(pubmeta.Publisher),
+		"logo":       pub.bookmeta.Get("logo"),
+	}
+	pub.globalStyles(meta, titlePageFile)
+	pub.addLandmark("titlepage", titlePageFile)
+	return &page{
+		Filename:    titlePageFile,
+		Template:    "titlepage",
+		ContentType: epub.Primary,
+		Meta:        meta,
+		Body:        newElement("body"),
+	}
+}
+
+// elementValue возвращает значение элемента метаданных с указанным
+// идентификатором.
+func elementValue(elements epub.Elements, id string) string {
+	for _, elem := range elements {
+		if elem.ID == id {
+			return elem.Value
+		}
+	}
+	return ""
+}
+
+// elementValues возвращает значения всех элементов метаданных.
+func elementValues(elements epub.Elements) []string {
+	var result = make([]string, 0, len(elements))
+	for _, elem := range elements {
+		result = append(result, elem.Value)
+	}
+	return result
+}
+
+// refinedValue возвращает значение свойства, уточняющего элемент метаданных.
+func refinedValue(pubmeta *epub.Metadata, refines, property string) string {
+	for _, meta := range pubmeta.Meta {
+		if meta.Refines == refines && meta.Property == property {
+			return meta.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,350 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Typography описывает правила типографики для языка.
+type Typography struct {
+	Quotes        []string `yaml:"quotes"`        // Пары кавычек для каждого уровня вложенности
+	QuoteSpace    string   `yaml:"quotespace"`    // Пробел внутри кавычек
+	Dash          string   `yaml:"dash"`          // Тире, заменяющее " - "
+	DashSpace     string   `yaml:"dashspace"`     // Пробел перед тире
+	NoBreakAfter  []string `yaml:"nobreakafter"`  // Короткие слова, после которых пробел неразрывный
+	NoBreakBefore string   `yaml:"nobreakbefore"` // Знаки препинания, перед которыми пробел неразрывный
+	PunctSpace    string   `yaml:"punctspace"`    // Пробел перед такими знаками препинания
+	Units         []string `yaml:"units"`         // Единицы измерения, отделяемые от чисел неразрывным пробелом
+
+	reNoBreakAfter  *regexp.Regexp
+	reNoBreakBefore *regexp.Regexp
+	rePunctAfter    *regexp.Regexp
+	reQuoteOpen     *regexp.Regexp
+	reQuoteClose    *regexp.Regexp
+	reUnits         *regexp.Regexp
+}
+
+const (
+	nbsp  = "\u00a0" // Неразрывный пробел
+	nnbsp = "\u202f" // Узкий неразрывный пробел
+)
+
+// typography содержит правила типографики по умолчанию.
+var typography = map[string]*Typography{
+	"en": {
+		Quotes: []string{"“", "”", "‘", "’"},
+		Dash:   "—",
+		Units:  strings.Fields("% mm cm m km g kg mg t ml l s ms min h °C °F KB MB GB TB"),
+	},
+	"ru": {
+		Quotes:    []string{"«", "»", "„", "“"},
+		Dash:      "—",
+		DashSpace: nbsp,
+		NoBreakAfter: strings.Fields(`а в во без для до за и из или к ко на над не ни но о об
+			от по под при про с со у что я`),
+		Units: strings.Fields("% мм см м км г кг мг т мл л с мс мин ч руб. коп. тыс. млн млрд ₽ °C"),
+	},
+	"uk": {
+		Quotes:       []string{"«", "»", "„", "“"},
+		Dash:         "—",
+		DashSpace:    nbsp,
+		NoBreakAfter: strings.Fields(`а в до з за і й к на не ні о об от по при та у що`),
+		Units:        strings.Fields("% мм см м км г кг т мл л с хв год грн °C"),
+	},
+	"de": {
+		Quotes:    []string{"„", "“", "‚", "‘"},
+		Dash:      "–",
+		DashSpace: nbsp,
+		Units:     strings.Fields("% mm cm m km g kg mg t ml l s ms min h € °C"),
+	},
+	"es": {
+		Quotes:       []string{"«", "»", "“", "”", "‘", "’"},
+		Dash:         "—",
+		NoBreakAfter: strings.Fields(`a e o u y`),
+		Units:        strings.Fields("% mm cm m km g kg mg t ml l s ms min h € °C"),
+	},
+	"be": {
+		Quotes:    []string{"«", "»", "„", "“"},
+		Dash:      "—",
+		DashSpace: nbsp,
+		NoBreakAfter: strings.Fields(`а і й з у ў да за на не ні па пра пры ад пад над
+			для без што`),
+		Units: strings.Fields("% мм см м км г кг т мл л с хв гадз руб. °C"),
+	},
+	"fr": {
+		Quotes:        []string{"«", "»", "“", "”"},
+		QuoteSpace:    nnbsp,
+		Dash:          "—",
+		DashSpace:     nbsp,
+		NoBreakBefore: ";:!?",
+		PunctSpace:    nnbsp,
+		Units:         strings.Fields("% mm cm m km g kg mg t ml l s ms min h € °C"),
+	},
+}
+
+// typographyRules возвращает правила типографики для языка с учетом
+// переопределений из настроек проекта. Заданные в проекте поля заменяют
+// соответствующие поля правил по умолчанию.
+func (pub *EPUBCompiler) typographyRules(lang string) *Typography {
+	lang = baseLang(lang)
+	if rules, ok := pub.typography[lang]; ok {
+		return rules
+	}
+	var rules = new(Typography)
+	if base, ok := typography[lang]; ok {
+		*rules = *base
+	} else {
+		*rules = *typography["en"]
+	}
+	if custom := pub.config.Typography[lang]; custom != nil {
+		if custom.Quotes != nil {
+			rules.Quotes = custom.Quotes
+		}
+		if custom.QuoteSpace != "" {
+			rules.QuoteSpace = custom.QuoteSpace
+		}
+		if custom.Dash != "" {
+			rules.Dash = custom.Dash
+		}
+		if custom.DashSpace != "" {
+			rules.DashSpace = custom.DashSpace
+		}
+		if custom.NoBreakAfter != nil {
+			rules.NoBreakAfter = custom.NoBreakAfter
+		}
+		if custom.NoBreakBefore != "" {
+			rules.NoBreakBefore = custom.NoBreakBefore
+		}
+		if custom.PunctSpace != "" {
+			rules.PunctSpace = custom.PunctSpace
+		}
+		if custom.Units != nil {
+			rules.Units = custom.Units
+		}
+	}
+	rules.compile()
+	if pub.typography == nil {
+		pub.typography = make(map[string]*Typography)
+	}
+	pub.typography[lang] = rules
+	return rules
+}
+
+// compile подготавливает регулярные выражения для правил.
+func (t *Typography) compile() {
+	var quote = func(list []string) string {
+		var items = make([]string, len(list))
+		for i, item := range list {
+			items[i] = regexp.QuoteMeta(item)
+		}
+		return strings.Join(items, "|")
+	}
+	t.reNoBreakAfter, t.reNoBreakBefore, t.rePunctAfter = nil, nil, nil
+	t.reQuoteOpen, t.reQuoteClose, t.reUnits = nil, nil, nil
+	if len(t.NoBreakAfter) > 0 {
+		t.reNoBreakAfter = regexp.MustCompile(`(?i)(^|[\s\x{a0}(«„“"—])(` +
+			quote(t.NoBreakAfter) + `) `)
+	}
+	if t.NoBreakBefore != "" {
+		var punct = regexp.QuoteMeta(t.NoBreakBefore)
+		t.reNoBreakBefore = regexp.MustCompile(`[ \x{a0}]+([` + punct + `])`)
+		// Пробел добавляется только перед знаками, за которыми следует пробел
+		// или конец текста, чтобы не испортить время 12:30 или адрес http://
+		t.rePunctAfter = regexp.MustCompile(`([\p{L}\p{N})\]»”’…])([` + punct +
+			`]+)(\s|$|[)»”])`)
+	}
+	if t.QuoteSpace != "" && len(t.Quotes) >= 2 {
+		// Кавычки, набранные автором сразу нужными символами
+		t.reQuoteOpen = regexp.MustCompile(`(` + regexp.QuoteMeta(t.Quotes[0]) +
+			`)[ \x{a0}\x{202f}]*`)
+		t.reQuoteClose = regexp.MustCompile(`[ \x{a0}\x{202f}]*(` +
+			regexp.QuoteMeta(t.Quotes[1]) + `)`)
+	}
+	if len(t.Units) > 0 {
+		t.reUnits = regexp.MustCompile(`(\d) +(` + quote(t.Units) + `)([^\p{L}]|$)`)
+	}
+}
+
+// typesetState хранит состояние обработки текста внутри одного блока.
+type typesetState struct {
+	prev  rune // Последний обработанный символ
+	depth int  // Уровень вложенности кавычек
+}
+
+// typeset применяет правила типографики к тексту страницы. Содержимое кода,
+// формул и скриптов не изменяется. Состояние кавычек сбрасывается в начале
+// каждого блока.
+func (t *Typography) typeset(parent *html.Node, state *typesetState) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		switch node.Type {
+		case html.TextNode:
+			node.Data = t.text(node.Data, state)
+		case html.ElementNode:
+			switch node.Data {
+			case "pre", "code", "kbd", "samp", "var", "math", "script", "style":
+				state.prev = 'x'
+			case "p", "li", "h1", "h2", "h3", "h4", "h5", "h6", "td", "th", "dt", "dd",
+				"figcaption", "blockquote", "div", "section", "aside", "footer", "cite":
+				t.typeset(node, new(typesetState))
+			default:
+				t.typeset(node, state)
+			}
+		}
+	}
+}
+
+// symbols заменяет последовательности символов, которые раньше заменял
+// Smartypants: три дефиса — на длинное тире, два — на короткое, как в LaTeX,
+// чтобы не изменились диапазоны чисел 10--20, а также многоточие и знаки
+// авторского права и товарного знака.
+var symbols = strings.NewReplacer("...", "…", "---", "—", "--", "–",
+	"(c)", "©", "(r)", "®", "(tm)", "™")
+
+// text применяет правила типографики к тексту.
+func (t *Typography) text(text string, state *typesetState) string {
+	text = symbols.Replace(text)
+	text = strings.Replace(text, " - ", " "+t.Dash+" ", -1)
+	if strings.HasPrefix(text, "- ") && state.prev == 0 {
+		text = t.Dash + text[1:]
+	}
+	if t.DashSpace != "" && t.Dash != "" {
+		text = strings.Replace(text, " "+t.Dash+" ", t.DashSpace+t.Dash+" ", -1)
+	}
+	// Кавычки
+	if strings.ContainsAny(text, `"'`) {
+		var buf strings.Builder
+		var prev = state.prev
+		for i, r := range text {
+			switch {
+			case r == '"' && len(t.Quotes) >= 2:
+				var level = state.depth
+				if isQuoteOpening(prev) {
+					state.depth++
+					buf.WriteString(t.quote(level, 0))
+					buf.WriteString(t.QuoteSpace)
+				} else {
+					if level > 0 {
+						level--
+						state.depth--
+					}
+					buf.WriteString(t.QuoteSpace)
+					buf.WriteString(t.quote(level, 1))
+				}
+			case r == '\'' && unicode.IsLetter(prev):
+				buf.WriteString("’") // Апостроф
+			case r == '\'' && isQuoteOpening(prev) && len(t.Quotes) >= 4:
+				buf.WriteString(t.Quotes[2]) // Одинарные кавычки
+			default:
+				buf.WriteString(text[i : i+utf8.RuneLen(r)])
+			}
+			prev = r
+		}
+		text = buf.String()
+	}
+	if r, _ := utf8.DecodeLastRuneInString(text); r != utf8.RuneError {
+		state.prev = r
+	}
+	// Неразрывные пробелы
+	if t.reNoBreakAfter != nil {
+		// Повторяем, т.к. предлоги могут идти подряд: "и в доме"
+		for i := 0; i < 2; i++ {
+			text = t.reNoBreakAfter.ReplaceAllString(text, "$1$2"+nbsp)
+		}
+	}
+	if t.reNoBreakBefore != nil {
+		text = t.reNoBreakBefore.ReplaceAllString(text, t.PunctSpace+"$1")
+		text = t.rePunctAfter.ReplaceAllString(text, "$1"+t.PunctSpace+"$2$3")
+	}
+	if t.reQuoteOpen != nil {
+		text = t.reQuoteOpen.ReplaceAllString(text, "$1"+t.QuoteSpace)
+		text = t.reQuoteClose.ReplaceAllString(text, t.QuoteSpace+"$1")
+	}
+	text = fractions(text)
+	if t.reUnits != nil {
+		text = t.reUnits.ReplaceAllString(text, "$1"+nbsp+"$2$3")
+	}
+	return text
+}
+
+// quote возвращает открывающую (side = 0) или закрывающую (side = 1) кавычку для
+// указанного уровня вложенности.
+func (t *Typography) quote(level, side int) string {
+	var pairs = len(t.Quotes) / 2
+	return t.Quotes[(level%pairs)*2+side]
+}
+
+// isQuoteOpening возвращает true, если после символа кавычка должна быть
+// открывающей.
+func isQuoteOpening(prev rune) bool {
+	return prev == 0 || unicode.IsSpace(prev) || strings.ContainsRune("([{«„“‘—–-/", prev)
+}
+
+// vulgarFractions содержит дроби, для которых в Unicode есть готовые символы.
+var vulgarFractions = map[string]string{
+	"1/2": "½", "1/3": "⅓", "2/3": "⅔", "1/4": "¼", "3/4": "¾", "1/5": "⅕", "2/5": "⅖",
+	"3/5": "⅗", "4/5": "⅘", "1/6": "⅙", "5/6": "⅚", "1/8": "⅛", "3/8": "⅜", "5/8": "⅝",
+	"7/8": "⅞",
+}
+
+// reFraction описывает дробь, записанную через косую черту: 3/16.
+var reFraction = regexp.MustCompile(`\d+/\d+`)
+
+// fractions заменяет дроби, записанные через косую черту, на готовые символы
+// дробей или на числитель и знаменатель из надстрочных и подстрочных цифр с
+// дробной чертой, как это делал раньше Smartypants. Даты и пути вида 12/05/2020
+// не изменяются.
+func fractions(text string) string {
+	var matches = reFraction.FindAllStringIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+	var (
+		buf  strings.Builder
+		last = 0
+	)
+	for _, m := range matches {
+		var before, _ = utf8.DecodeLastRuneInString(text[:m[0]])
+		var after, _ = utf8.DecodeRuneInString(text[m[1]:])
+		if after == '.' || after == ',' {
+			// Точка или запятая после дроби не относятся к ней, если за ними
+			// не следует цифра
+			if next, _ := utf8.DecodeRuneInString(text[m[1]+1:]); !unicode.IsDigit(next) {
+				after = ' '
+			}
+		}
+		if isFractionBound(before) || isFractionBound(after) {
+			continue
+		}
+		var fraction = text[m[0]:m[1]]
+		var result, ok = vulgarFractions[fraction]
+		if !ok {
+			var slash = strings.IndexByte(fraction, '/')
+			result = strings.Map(superscriptDigit, fraction[:slash]) + "⁄" +
+				strings.Map(subscriptDigit, fraction[slash+1:])
+		}
+		buf.WriteString(text[last:m[0]])
+		buf.WriteString(result)
+		last = m[1]
+	}
+	buf.WriteString(text[last:])
+	return buf.String()
+}
+
+// isFractionBound возвращает true, если символ рядом с дробью показывает, что
+// это часть слова, десятичного числа, даты или пути.
+func isFractionBound(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r) ||
+		strings.ContainsRune("/.,_", r))
+}
+
+func superscriptDigit(r rune) rune {
+	return []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")[r-'0']
+}
+
+func subscriptDigit(r rune) rune {
+	return []rune("₀₁₂₃₄₅₆₇₈₉")[r-'0']
+}
@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestTypographyText(t *testing.T) {
+	var tests = []struct {
+		lang string
+		text string
+		want string
+	}{
+		// Два дефиса, как в LaTeX, дают короткое тире, три — длинное
+		{"en", "pages 10--20", "pages 10–20"},
+		{"en", "yes---no", "yes—no"},
+		{"en", "Wait...", "Wait…"},
+		{"en", "(c) 2020 Acme(r) Widget(tm)", "© 2020 Acme® Widget™"},
+		{"en", `He said "hi" and 'bye'`, "He said “hi” and ‘bye’"},
+		{"en", "don't", "don’t"},
+		{"en", "5 kg", "5\u00a0kg"},
+		{"ru", `Он сказал "привет"`, "Он сказал «привет»"},
+		{"ru", `"Книга "Мастер""`, "«Книга „Мастер“»"},
+		{"ru", "Да - нет", "Да\u00a0— нет"},
+		{"ru", "и в доме", "и\u00a0в\u00a0доме"},
+		{"ru", "1--2 дня", "1–2 дня"},
+		{"de", `"Haus"`, "„Haus“"},
+		{"fr", `Quoi? "Oui"`, "Quoi\u202f? «\u202fOui\u202f»"},
+		{"fr", "à 12:30", "à 12:30"},
+		{"es", `"Hola"`, "«Hola»"},
+		{"be", `"Так"`, "«Так»"},
+		{"en", "1/2 cup", "½ cup"},
+	}
+	var pub = &EPUBCompiler{config: DefaultConfig}
+	for _, test := range tests {
+		var got = pub.typographyRules(test.lang).text(test.text, new(typesetState))
+		if got != test.want {
+			t.Errorf("%s: text(%q) = %q, want %q", test.lang, test.text, got, test.want)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"path/filepath"
 	"strings"
+
+	"golang.org/x/net/html"
 )
 
 // isFilename возвращает true, если имя файла совпадает с одним из указанных
@@ -15,3 +18,55 @@ func isFilename(name string, list []string) bool {
 	}
 	return false
 }
+
+// newElement возвращает новый элемент HTML с указанными атрибутами, которые
+// передаются парами имя-значение.
+func newElement(tag string, attrs ...string) *html.Node {
+	var node = &html.Node{Type: html.ElementNode, Data: tag}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		setAttr(node, attrs[i], attrs[i+1])
+	}
+	return node
+}
+
+// getAttr возвращает значение атрибута элемента.
+func getAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// setAttr устанавливает значение атрибута элемента.
+func setAttr(node *html.Node, key, value string) {
+	for i, attr := range node.Attr {
+		if attr.Key == key {
+			node.Attr[i].Val = value
+			return
+		}
+	}
+	node.Attr = append(node.Attr, html.Attribute{Key: key, Val: value})
+}
+
+// children возвращает список дочерних элементов.
+func children(node *html.Node) []*html.Node {
+	var result []*html.Node
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		result = append(result, child)
+	}
+	return result
+}
+
+// isDirname возвращает true, если путь к каталогу совпадает с одним из
+// перечисленных путей.
+func isDirname(dirname string, names ...string) bool {
+	dirname = filepath.Clean(dirname)
+	for _, name := range names {
+		if name != "" && filepath.Clean(name) == dirname {
+			return true
+		}
+	}
+	return false
+}
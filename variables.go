@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/mdigger/metadata"
+	"golang.org/x/net/html"
+)
+
+// variables подставляет в исходный текст значения переменных вида {{ .title }}
+// из метаданных публикации и файла. Метаданные файла имеют приоритет. Значения
+// экранируются, чтобы не менять разметку текста, а обращение к неописанной
+// переменной считается ошибкой. Блоки кода, в том числе выделенные отступом,
+// и строчный код не обрабатываются.
+func (pub *EPUBCompiler) variables(filename string, data []byte, meta metadata.Metadata) ([]byte, error) {
+	var values = make(map[string]interface{}, len(pub.bookmeta)+len(meta))
+	for key, value := range pub.bookmeta {
+		values[key] = escapeValue(value)
+	}
+	for key, value := range meta {
+		values[key] = escapeValue(value)
+	}
+	var (
+		buf   = new(bytes.Buffer)
+		text  []byte // Накопленный текст вне блоков кода
+		lines int    // Количество строк перед накопленным текстом
+		err   error
+	)
+	var flush = func() {
+		if err != nil || len(text) == 0 {
+			return
+		}
+		// Строчный код заменяется на время подстановки метками с его номером,
+		// сохраняющими переводы строк
+		var spans = codeSpans(text)
+		var source strings.Builder
+		var last = 0
+		for i, span := range spans {
+			source.Write(text[last:span[0]])
+			source.WriteString("\x00" + strconv.Itoa(i))
+			source.WriteString(strings.Repeat("\n", bytes.Count(text[span[0]:span[1]], []byte{'\n'})))
+			source.WriteString("\x00")
+			last = span[1]
+		}
+		source.Write(text[last:])
+		// Пустые строки в начале сохраняют номера строк в сообщениях об ошибках
+		var (
+			result = new(bytes.Buffer)
+			tmpl   *template.Template
+		)
+		tmpl, err = template.New(filename).Option("missingkey=error").
+			Parse(strings.Repeat("\n", lines) + source.String())
+		if err == nil {
+			err = tmpl.Execute(result, values)
+		}
+		if err == nil {
+			buf.Write(reCodeSpanMark.ReplaceAllFunc(result.Bytes()[lines:], func(mark []byte) []byte {
+				var i, _ = strconv.Atoi(string(reCodeSpanMark.FindSubmatch(mark)[1]))
+				return text[spans[i][0]:spans[i][1]]
+			}))
+		}
+		lines += bytes.Count(text, []byte{'\n'})
+		text = text[:0]
+	}
+	scanCodeLines(data, func(line []byte, code bool) {
+		if code {
+			flush()
+			buf.Write(line)
+			lines++
+			return
+		}
+		text = append(text, line...)
+	})
+	flush()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reCodeSpanMark описывает метку, заменяющую строчный код при подстановке
+// переменных.
+var reCodeSpanMark = regexp.MustCompile(`\x00(\d+)\n*\x00`)
+
+// markdownEscaper экранирует символы, которые Markdown считает разметкой.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`{`, `\{`, `}`, `\}`, `#`, `\#`, `|`, `\|`, `~`, `\~`, `!`, `\!`)
+
+// escapeValue возвращает значение метаданных с экранированными строками.
+// Списки и словари обрабатываются рекурсивно.
+func escapeValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		return markdownEscaper.Replace(html.EscapeString(value))
+	case []interface{}:
+		var result = make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = escapeValue(item)
+		}
+		return result
+	case []string:
+		var result = make([]string, len(value))
+		for i, item := range value {
+			result[i] = markdownEscaper.Replace(html.EscapeString(item))
+		}
+		return result
+	case map[interface{}]interface{}:
+		var result = make(map[string]interface{}, len(value))
+		for key, item := range value {
+			result[fmt.Sprint(key)] = escapeValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		var result = make(map[string]interface{}, len(value))
+		for key, item := range value {
+			result[key] = escapeValue(item)
+		}
+		return result
+	case metadata.Metadata:
+		return escapeValue(map[string]interface{}(value))
+	}
+	return value
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// verses накапливает строки стихов, чтобы решить, нужен ли после строки
+// принудительный перенос: его не должно быть перед пустой строкой и заголовком.
+type verses struct {
+	pending []byte // Последняя еще не записанная строка
+}
+
+// reVerseMarker описывает начало строки, которое Markdown принял бы за начало
+// списка.
+var reVerseMarker = regexp.MustCompile(`^\d*([-+*.)])\s`)
+
+// add подготавливает очередную строку стиха: убирает отступ, заменяя его
+// директивой, и экранирует символы, которые Markdown принял бы за разметку
+// списка.
+func (v *verses) add(buf *bytes.Buffer, line []byte, d *directives) {
+	var text = bytes.TrimRight(line, "\r\n")
+	var trimmed = bytes.TrimLeft(text, " \t")
+	var blank = len(trimmed) == 0
+	var heading = !blank && trimmed[0] == '#'
+	if v.pending != nil {
+		buf.Write(v.pending)
+		if !blank && !heading {
+			buf.WriteByte('\\') // Перенос строки в Markdown
+		}
+		buf.WriteByte('\n')
+		v.pending = nil
+	}
+	if blank || heading {
+		buf.Write(trimmed)
+		buf.WriteByte('\n')
+		return
+	}
+	var indent = 0
+	for _, ch := range text[:len(text)-len(trimmed)] {
+		if ch == '\t' {
+			indent += 4
+		} else {
+			indent++
+		}
+	}
+	var result []byte
+	if indent > 0 {
+		result = append(result, d.inline(d.add(indentDirective, strconv.Itoa((indent+1)/2), false))...)
+	}
+	if m := reVerseMarker.FindSubmatchIndex(trimmed); m != nil {
+		result = append(result, trimmed[:m[2]]...)
+		result = append(result, '\\')
+		trimmed = trimmed[m[2]:]
+	}
+	v.pending = append(result, trimmed...)
+}
+
+// flush записывает последнюю строку стиха.
+func (v *verses) flush(buf *bytes.Buffer) {
+	if v.pending != nil {
+		buf.Write(v.pending)
+		buf.WriteByte('\n')
+		v.pending = nil
+	}
+}
+
+// reVerseDate описывает дату под стихотворением: "1957", "1956–1957",
+// "12.03.1960", "март 1961 г.".
+var reVerseDate = regexp.MustCompile(`^[\p{L} .,]*\d{4}(?:\s*[-–—]\s*\d{4})?(?:\s*г\.)?$|^\d{1,2}\.\d{1,2}\.\d{2,4}$`)
+
+// reYear описывает год в дате.
+var reYear = regexp.MustCompile(`\d{4}`)
+
+// verse оформляет стихи: абзацы становятся строфами, строки — отдельными
+// элементами с классом line, заголовки и даты получают семантическую разметку.
+func verse(elem *html.Node) {
+	var last *html.Node // Последняя строфа
+	for node := elem.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		switch node.Data {
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			setAttr(node, "epub:type", "title")
+		case "p":
+			var stanza = stanza(node)
+			elem.InsertBefore(stanza, node)
+			elem.RemoveChild(node)
+			node, last = stanza, stanza
+		}
+	}
+	// Дата под стихотворением
+	var stanza = last
+	if stanza == nil {
+		return
+	}
+	for node := stanza; node != nil; node = node.NextSibling {
+		if node.Type == html.ElementNode && node != stanza {
+			return // Последний абзац не завершает стихотворение
+		}
+	}
+	var lines = children(stanza)
+	if len(lines) != 1 {
+		return
+	}
+	var text = strings.TrimSpace(textContent(lines[0]))
+	if !reVerseDate.MatchString(text) {
+		return
+	}
+	var date = newElement("p", "class", "date", "epub:type", "z3998:dateline")
+	var timeElem = newElement("time")
+	if year := reYear.FindString(text); year != "" && len(reYear.FindAllString(text, -1)) == 1 {
+		setAttr(timeElem, "datetime", year)
+	}
+	timeElem.AppendChild(&html.Node{Type: html.TextNode, Data: text})
+	date.AppendChild(timeElem)
+	elem.InsertBefore(date, stanza)
+	elem.RemoveChild(stanza)
+}
+
+// stanza преобразует абзац в строфу, разбивая его на строки по элементам <br>.
+func stanza(para *html.Node) *html.Node {
+	var stanza = newElement("div", "class", "stanza")
+	var line *html.Node
+	for _, child := range children(para) {
+		para.RemoveChild(child)
+		if child.Type == html.ElementNode && child.Data == "br" {
+			if line != nil {
+				stanza.AppendChild(line)
+				stanza.AppendChild(newElement("br"))
+			}
+			line = nil
+			continue
+		}
+		if line == nil {
+			if child.Type == html.TextNode {
+				child.Data = strings.TrimLeft(child.Data, "\n")
+				if child.Data == "" {
+					continue
+				}
+			}
+			line = newElement("span", "class", "line")
+		}
+		// Отступ строки
+		if child.Type == html.ElementNode && child.Data == "span" &&
+			strings.HasPrefix(getAttr(child, "class"), "indent-") && child.FirstChild == nil {
+			setAttr(line, "class", "line "+getAttr(child, "class"))
+			continue
+		}
+		line.AppendChild(child)
+	}
+	if line != nil {
+		stanza.AppendChild(line)
+	}
+	return stanza
+}
+
+// textContent возвращает текстовое содержимое элемента.
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var buf strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		buf.WriteString(textContent(child))
+	}
+	return buf.String()
+}